@@ -0,0 +1,24 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package openapi provides the OpenAPI document of the RESTful JSON API of Monoskope.
+package openapi
+
+import _ "embed"
+
+// Document is the OpenAPI document generated from the HTTP annotations of the
+// public gRPC APIs of Monoskope by running `make go-protobuf`.
+//
+//go:embed monoskope.swagger.json
+var Document []byte
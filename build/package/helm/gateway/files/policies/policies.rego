@@ -1,5 +1,6 @@
 package m8.authz
 
+import future.keywords.every
 import future.keywords.in
 
 default authorized = false
@@ -14,6 +15,7 @@ allowed_paths := [
 	"/domain.User",
 	"/domain.Tenant",
 	"/domain.Cluster",
+	"/domain.Role",
 ]
 
 scoped_paths := [{"scope": "WRITE_SCIM", "paths": [
@@ -22,17 +24,69 @@ scoped_paths := [{"scope": "WRITE_SCIM", "paths": [
 	"/domain.User/",
 ]}]
 
+# paths which can be read with fine-grained "read:<resource>" scopes
+read_scope_paths := {
+	"users": ["/domain.User/"],
+	"tenants": ["/domain.Tenant/"],
+	"clusters": ["/domain.Cluster/", "/domain.ClusterAccess/"],
+	"auditlog": ["/domain.AuditLog/"],
+	"roles": ["/domain.Role/"],
+}
+
+# paths which can be read with fine-grained "read:tenant/<id>" scopes if the request is for the same tenant
+read_tenant_scope_paths := [
+	"/domain.Tenant/GetById",
+	"/domain.Tenant/GetUsers",
+	"/domain.ClusterAccess/GetTenantClusterMappingsByTenantId",
+]
+
+# paths which are allowed for any token with fine-grained scopes
+api_scope_base_paths := [
+	"/gateway.Gateway",
+	"/common.ServiceInformationService/GetServiceInformation",
+]
+
+api_token_path := "/gateway.APIToken/RequestAPIToken"
+
 command_path := "/eventsourcing.CommandHandler/Execute"
 
+batch_command_path := "/eventsourcing.CommandHandler/ExecuteBatch"
+
+validate_command_path := "/eventsourcing.CommandHandler/Validate"
+
+scope_api = "API"
+
+access_read = "read"
+
+access_write = "write"
+
+resource_tenant = "tenant"
+
 scope_system = "system"
 
 scope_tenant = "tenant"
 
 role_admin = "admin"
 
+# check if token is restricted to fine-grained scopes
+is_restricted_token {
+	count(input.Authentication.APIScopes) > 0
+	not scope_api in input.Authentication.Scopes
+}
+
+# check that it is a command, validating a command requires the same permissions as executing it
+is_command_path {
+	startswith(input.Path, command_path)
+}
+
+is_command_path {
+	input.Path == validate_command_path
+}
+
 # check if system admin
 is_system_admin {
 	print("entering is_system_admin")
+	not is_restricted_token
 	some role in input.User.Roles
 	role.Scope == scope_system
 	role.Name == role_admin
@@ -42,9 +96,10 @@ is_system_admin {
 # check if user is tenant admin and adjusts rolebindings of other users of the tenant
 tenant_admin_rolebindings {
 	print("entering tenant_admin_rolebindings")
+	not is_restricted_token
 
 	# check that it is a command
-	startswith(input.Path, command_path)
+	is_command_path
 	req := json.unmarshal(input.Request)
 
 	# check that it is related to user role bindings
@@ -63,6 +118,105 @@ tenant_admin_rolebindings {
 	print(input.User.Name, "is tenant admin and allowed to execute", req.type, "for tenant", req.data.resource)
 }
 
+# check if user is tenant admin and requests an API token, the issued scopes are validated on issuing
+tenant_admin_api_token {
+	print("entering tenant_admin_api_token")
+	not is_restricted_token
+	input.Path == api_token_path
+
+	some role in input.User.Roles
+	role.Scope == scope_tenant
+	role.Name == role_admin
+
+	print(input.User.Name, "is tenant admin and allowed to request api tokens")
+}
+
+# check if token has a fine-grained scope allowing to read the requested path
+api_scope_read {
+	print("entering api_scope_read")
+	some scope in input.Authentication.APIScopes
+	scope.Access == access_read
+	some path in read_scope_paths[scope.Resource]
+	startswith(input.Path, path)
+	print("api scope", scope.Resource, "allows to read", path)
+}
+
+# check if token has a fine-grained scope allowing to read the requested path of a single tenant
+api_scope_read {
+	print("entering api_scope_read for tenant")
+	some scope in input.Authentication.APIScopes
+	scope.Access == access_read
+	scope.Resource == resource_tenant
+	some path in read_tenant_scope_paths
+	input.Path == path
+	json.unmarshal(input.Request) == scope.Id
+	print("api scope allows to read", path, "of tenant", scope.Id)
+}
+
+# check if token has a fine-grained scope allowing to execute the requested command
+api_scope_write {
+	print("entering api_scope_write")
+	is_command_path
+	req := json.unmarshal(input.Request)
+
+	some scope in input.Authentication.APIScopes
+	scope.Access == access_write
+	scope.Resource != resource_tenant
+	req.type in scope.CommandTypes
+	print("api scope", scope.Resource, "allows to execute", req.type)
+}
+
+# check if token has a fine-grained scope allowing to execute the requested command for a single tenant
+api_scope_write {
+	print("entering api_scope_write for tenant")
+	is_command_path
+	req := json.unmarshal(input.Request)
+
+	some scope in input.Authentication.APIScopes
+	scope.Access == access_write
+	scope.Resource == resource_tenant
+	req.type in scope.CommandTypes
+	command_targets_tenant(req, scope.Id)
+	print("api scope allows to execute", req.type, "for tenant", scope.Id)
+}
+
+# command is an update or delete of the tenant itself
+command_targets_tenant(req, id) {
+	req.id == id
+}
+
+# command is a tenant scoped role binding
+command_targets_tenant(req, id) {
+	req.data.scope == scope_tenant
+	req.data.resource == id
+}
+
+# command is a tenant cluster binding
+command_targets_tenant(req, id) {
+	req.data.tenantId == id
+}
+
+# check if a single command is allowed by the rules for commands
+single_command_authorized {
+	tenant_admin_rolebindings
+}
+
+single_command_authorized {
+	api_scope_write
+}
+
+# check if every command of a batch would be allowed when executed on its own
+batch_commands_authorized {
+	print("entering batch_commands_authorized")
+	input.Path == batch_command_path
+	req := json.unmarshal(input.Request)
+	count(req.commands) > 0
+	every command in req.commands {
+		single_command_authorized with input.Path as command_path with input.Request as json.marshal(command)
+	}
+	print("all", count(req.commands), "commands of the batch are allowed")
+}
+
 # authorized because system admin
 authorized {
 	is_system_admin
@@ -73,9 +227,37 @@ authorized {
 	tenant_admin_rolebindings
 }
 
+# authorized because tenant admin requests api token
+authorized {
+	tenant_admin_api_token
+}
+
+# authorized via fine-grained api scopes
+authorized {
+	api_scope_read
+}
+
+# authorized via fine-grained api scopes
+authorized {
+	api_scope_write
+}
+
+# authorized because every command of the batch is authorized
+authorized {
+	batch_commands_authorized
+}
+
+# authorized via base paths for tokens with fine-grained api scopes
+authorized {
+	is_restricted_token
+	some path in api_scope_base_paths
+	startswith(input.Path, path)
+}
+
 # authorized via allowed_paths
 authorized {
 	print("entering allowed_paths")
+	not is_restricted_token
 	some path in allowed_paths
 	startswith(input.Path, path)
 	print(path, "is allowed to everyone")
@@ -26,6 +26,43 @@ scim_scope = {
 	"Authentication": {"Scopes": ["WRITE_SCIM"]},
 }
 
+tenant_id = "00000000-0000-0001-0000-000000000000"
+
+other_tenant_id = "00000000-0000-0002-0000-000000000000"
+
+bob_api_token = {
+	"User": {
+		"Id": "12345", "Name": "bob",
+		"Roles": [{"Name": "admin", "Scope": "tenant", "Resource": "1234"}],
+	},
+	"Path": "/gateway.APIToken/RequestAPIToken",
+	"Authentication": {"Scopes": ["API"]},
+}
+
+read_clusters_scope = {
+	"User": {
+		"Id": "1234", "Name": "alice",
+		"Roles": [{"Name": "admin", "Scope": "system"}],
+	},
+	"Path": "/domain.Cluster/GetAll",
+	"Authentication": {
+		"Scopes": ["read:clusters"],
+		"APIScopes": [{"Access": "read", "Resource": "clusters", "Id": "", "CommandTypes": null}],
+	},
+}
+
+write_tenant_scope(path, request) = {
+	"Path": path,
+	"Request": request,
+	"Authentication": {
+		"Scopes": [concat("", ["write:tenant/", tenant_id])],
+		"APIScopes": [{
+			"Access": "write", "Resource": "tenant", "Id": tenant_id,
+			"CommandTypes": ["UpdateTenant", "DeleteTenant", "CreateUserRoleBinding", "CreateTenantClusterBinding"],
+		}],
+	},
+}
+
 test_system_admin {
 	is_system_admin with input as alice_admin
 	not is_system_admin with input as bob_tenant_admin
@@ -40,3 +77,61 @@ test_authorized {
 test_tenant_admin_rolebindings {
 	authorized with input as bob_tenant_admin
 }
+
+test_tenant_admin_api_token {
+	authorized with input as bob_api_token
+	not authorized with input as object.union(jane, {"Path": "/gateway.APIToken/RequestAPIToken"})
+}
+
+test_api_scope_read {
+	authorized with input as read_clusters_scope
+	not authorized with input as object.union(read_clusters_scope, {"Path": "/domain.User/GetAll"})
+	not authorized with input as object.union(read_clusters_scope, {"Path": "/eventsourcing.CommandHandler/Execute", "Request": "{\"type\": \"CreateTenant\"}"})
+}
+
+test_api_scope_write_tenant {
+	authorized with input as write_tenant_scope("/eventsourcing.CommandHandler/Execute", json.marshal({"type": "UpdateTenant", "id": tenant_id}))
+	authorized with input as write_tenant_scope("/eventsourcing.CommandHandler/Execute", json.marshal({"type": "CreateUserRoleBinding", "data": {"scope": "tenant", "resource": tenant_id}}))
+	authorized with input as write_tenant_scope("/eventsourcing.CommandHandler/Execute", json.marshal({"type": "CreateTenantClusterBinding", "data": {"tenantId": tenant_id}}))
+	not authorized with input as write_tenant_scope("/eventsourcing.CommandHandler/Execute", json.marshal({"type": "UpdateTenant", "id": other_tenant_id}))
+	not authorized with input as write_tenant_scope("/eventsourcing.CommandHandler/Execute", json.marshal({"type": "CreateCluster"}))
+	not authorized with input as write_tenant_scope("/domain.Tenant/GetAll", "")
+}
+
+test_batch_commands {
+	authorized with input as object.union(bob_tenant_admin, {
+		"Path": "/eventsourcing.CommandHandler/ExecuteBatch",
+		"Request": json.marshal({"commands": [
+			{"type": "CreateUserRoleBinding", "data": {"scope": "tenant", "resource": "1234"}},
+			{"type": "CreateUserRoleBinding", "data": {"scope": "tenant", "resource": "1234"}},
+		]}),
+	})
+	not authorized with input as object.union(bob_tenant_admin, {
+		"Path": "/eventsourcing.CommandHandler/ExecuteBatch",
+		"Request": json.marshal({"commands": [
+			{"type": "CreateUserRoleBinding", "data": {"scope": "tenant", "resource": "1234"}},
+			{"type": "CreateUserRoleBinding", "data": {"scope": "tenant", "resource": "5678"}},
+		]}),
+	})
+	authorized with input as write_tenant_scope("/eventsourcing.CommandHandler/ExecuteBatch", json.marshal({"commands": [
+		{"type": "UpdateTenant", "id": tenant_id},
+		{"type": "CreateTenantClusterBinding", "data": {"tenantId": tenant_id}},
+	]}))
+	not authorized with input as write_tenant_scope("/eventsourcing.CommandHandler/ExecuteBatch", json.marshal({"commands": [
+		{"type": "UpdateTenant", "id": tenant_id},
+		{"type": "CreateCluster"},
+	]}))
+	not authorized with input as write_tenant_scope("/eventsourcing.CommandHandler/ExecuteBatch", json.marshal({"commands": []}))
+	authorized with input as object.union(alice_admin, {"Path": "/eventsourcing.CommandHandler/ExecuteBatch"})
+}
+
+test_validate_command {
+	authorized with input as object.union(bob_tenant_admin, {"Path": "/eventsourcing.CommandHandler/Validate"})
+	not authorized with input as object.union(bob_tenant_admin, {
+		"Path": "/eventsourcing.CommandHandler/Validate",
+		"Request": "{\"type\": \"CreateUserRoleBinding\",\"data\": {\"scope\": \"tenant\", \"resource\": \"5678\"}}",
+	})
+	authorized with input as write_tenant_scope("/eventsourcing.CommandHandler/Validate", json.marshal({"type": "UpdateTenant", "id": tenant_id}))
+	not authorized with input as write_tenant_scope("/eventsourcing.CommandHandler/Validate", json.marshal({"type": "CreateCluster"}))
+	not authorized with input as object.union(jane, {"Path": "/eventsourcing.CommandHandler/Validate", "Request": json.marshal({"type": "CreateTenant"})})
+}
@@ -15,12 +15,10 @@
 package main
 
 import (
-	"os"
 	"sort"
 
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/google/uuid"
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
@@ -49,29 +47,7 @@ func NewReportCommands() *cobra.Command {
 				})
 			}
 
-			table := tablewriter.NewWriter(os.Stdout)
-			table.SetHeader([]string{"Command", "Aggregate"})
-
-			if formatMarkdown {
-				table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
-				table.SetAutoMergeCellsByColumnIndex([]int{0})
-				table.SetCenterSeparator("|")
-			} else {
-				table.SetAutoWrapText(false)
-				table.SetAutoFormatHeaders(true)
-				table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
-				table.SetAlignment(tablewriter.ALIGN_LEFT)
-				table.SetCenterSeparator("")
-				table.SetColumnSeparator("")
-				table.SetRowSeparator("")
-				table.SetHeaderLine(false)
-				table.SetBorder(false)
-				table.SetTablePadding("\t") // pad with tabs
-				table.SetNoWhiteSpace(true)
-			}
-
-			table.AppendBulk(data) // Add Bulk Data
-			table.Render()
+			renderReport([]string{"Command", "Aggregate"}, data)
 
 			return nil
 		},
@@ -0,0 +1,62 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sort"
+	"strings"
+
+	_ "github.com/finleap-connect/monoskope/pkg/domain/eventschemas"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/spf13/cobra"
+)
+
+func NewReportEvents() *cobra.Command {
+	reportEventsCmd := &cobra.Command{
+		Use:   "events",
+		Short: "Prints a list of events.",
+		Long:  `Prints a list of events along with the protobuf message of their data and the services consuming them.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data := [][]string{}
+
+			schemas := es.DefaultEventSchemaRegistry.GetEventSchemas()
+			sort.SliceStable(schemas, func(i, j int) bool {
+				return schemas[i].AggregateType < schemas[j].AggregateType
+			})
+
+			for _, schema := range schemas {
+				dataType := "-"
+				if schema.Data != nil {
+					dataType = string(schema.Data.FullName())
+				}
+				data = append(data, []string{
+					schema.AggregateType.String(),
+					schema.EventType.String(),
+					dataType,
+					strings.Join(schema.Consumers, ", "),
+				})
+			}
+
+			renderReport([]string{"Aggregate", "Event", "Data", "Consumers"}, data)
+
+			return nil
+		},
+	}
+
+	flags := reportEventsCmd.Flags()
+	flags.BoolVarP(&formatMarkdown, "markdown", "m", false, "Print table in markdown format.")
+
+	return reportEventsCmd
+}
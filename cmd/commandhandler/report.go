@@ -15,6 +15,9 @@
 package main
 
 import (
+	"os"
+
+	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
@@ -31,3 +34,30 @@ func NewReportCmd() *cobra.Command {
 		Long:                  `Reporting of implementation details`,
 	}
 }
+
+// renderReport prints the given data as table or in markdown format
+func renderReport(header []string, data [][]string) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.SetAutoWrapText(false)
+
+	if formatMarkdown {
+		table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+		table.SetAutoMergeCellsByColumnIndex([]int{0})
+		table.SetCenterSeparator("|")
+	} else {
+		table.SetAutoFormatHeaders(true)
+		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.SetCenterSeparator("")
+		table.SetColumnSeparator("")
+		table.SetRowSeparator("")
+		table.SetHeaderLine(false)
+		table.SetBorder(false)
+		table.SetTablePadding("\t") // pad with tabs
+		table.SetNoWhiteSpace(true)
+	}
+
+	table.AppendBulk(data) // Add Bulk Data
+	table.Render()
+}
@@ -41,6 +41,7 @@ func main() {
 
 	report := NewReportCmd()
 	report.AddCommand(NewReportCommands())
+	report.AddCommand(NewReportEvents())
 	rootCmd.AddCommand(report)
 
 	if err := rootCmd.Execute(); err != nil {
@@ -15,6 +15,8 @@
 package main
 
 import (
+	"time"
+
 	"github.com/finleap-connect/monoskope/internal/gateway"
 	"github.com/finleap-connect/monoskope/internal/telemetry"
 	api_domain "github.com/finleap-connect/monoskope/pkg/api/domain"
@@ -25,6 +27,7 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/grpc"
 	"github.com/finleap-connect/monoskope/pkg/grpc/middleware/auth"
 	"github.com/finleap-connect/monoskope/pkg/logger"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	"github.com/finleap-connect/monoskope/pkg/util"
 	ggrpc "google.golang.org/grpc"
 
@@ -36,11 +39,15 @@ import (
 )
 
 var (
-	apiAddr        string
-	metricsAddr    string
-	keepAlive      bool
-	eventStoreAddr string
-	gatewayAddr    string
+	apiAddr          string
+	metricsAddr      string
+	keepAlive        bool
+	eventStoreAddr   string
+	gatewayAddr      string
+	mtlsCertsPath    string
+	mtlsAllowedPeers []string
+
+	idempotencyWindow time.Duration
 )
 
 var serverCmd = &cobra.Command{
@@ -62,9 +69,22 @@ var serverCmd = &cobra.Command{
 			defer util.PanicOnErrorFunc(shutdownTelemetry)
 		}
 
+		// Use and require mutual TLS for internal connections optionally
+		var tlsLoader *m8tls.TLSConfigLoader
+		var serverOpts []ggrpc.ServerOption
+		if mtlsCertsPath != "" {
+			log.Info("Setting up mutual TLS...", "allowedPeers", mtlsAllowedPeers)
+			tlsLoader, err = m8tls.NewMutualTLSConfigLoader(mtlsCertsPath)
+			if err != nil {
+				return err
+			}
+			defer tlsLoader.Stop()
+			serverOpts = append(serverOpts, grpc.MutualTLSServerOption(tlsLoader, mtlsAllowedPeers...))
+		}
+
 		// Create EventStore client
 		log.Info("Connecting event store...", "eventStoreAddr", eventStoreAddr)
-		conn, esClient, err := eventstore.NewEventStoreClient(ctx, eventStoreAddr)
+		conn, esClient, err := eventstore.NewEventStoreClient(ctx, eventStoreAddr, tlsLoader)
 		if err != nil {
 			return err
 		}
@@ -72,14 +92,14 @@ var serverCmd = &cobra.Command{
 
 		// Setup domain
 		log.Info("Seting up es/cqrs...")
-		err = domain.SetupCommandHandlerDomain(ctx, esClient)
+		aggregateStore, err := domain.SetupCommandHandlerDomain(ctx, esClient)
 		if err != nil {
 			return err
 		}
 
 		// Create Gateway Auth client
 		log.Info("Connecting gateway...", "gatewayAddr", gatewayAddr)
-		conn, gatewaySvcClient, err := gateway.NewInsecureAuthServerClient(ctx, gatewayAddr)
+		conn, gatewaySvcClient, err := gateway.NewAuthServerClient(ctx, gatewayAddr, tlsLoader)
 		if err != nil {
 			return err
 		}
@@ -94,9 +114,10 @@ var serverCmd = &cobra.Command{
 			}, []ggrpc.StreamServerInterceptor{
 				authMiddleware.StreamServerInterceptor(),
 			},
+			serverOpts...,
 		)
 
-		commandHandlerApiServer := commandhandler.NewApiServer(es.DefaultCommandRegistry)
+		commandHandlerApiServer := commandhandler.NewApiServer(es.DefaultCommandRegistry, aggregateStore).WithIdempotencyWindow(idempotencyWindow)
 		grpcServer.RegisterService(func(s ggrpc.ServiceRegistrar) {
 			api.RegisterCommandHandlerServer(s, commandHandlerApiServer)
 			api_domain.RegisterCommandHandlerExtensionsServer(s, commandHandlerApiServer)
@@ -118,4 +139,7 @@ func init() {
 	flags.StringVar(&metricsAddr, "metrics-addr", ":9102", "Address the metrics http service will listen on")
 	flags.StringVar(&eventStoreAddr, "event-store-api-addr", ":8081", "Address the eventstore gRPC service is listening on")
 	flags.StringVar(&gatewayAddr, "gateway-api-addr", ":8081", "Address the gateway gRPC service is listening on")
+	flags.StringVar(&mtlsCertsPath, "mtls-certs-path", "", "Path to the ca.crt, tls.crt and tls.key to serve and to connect to the eventstore and the internal gRPC service of the gateway with mutual TLS. If not specified connections are not secured.")
+	flags.StringSliceVar(&mtlsAllowedPeers, "mtls-allowed-peers", []string{}, "Common or DNS names of the client certificates allowed to connect if mutual TLS is enabled. If not specified every certificate of the CA is allowed.")
+	flags.DurationVar(&idempotencyWindow, "idempotency-window", commandhandler.DefaultIdempotencyWindow, "How long replies of commands are remembered for the idempotency key sent with them. Zero disables idempotency keys.")
 }
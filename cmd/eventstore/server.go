@@ -15,14 +15,20 @@
 package main
 
 import (
+	"context"
+	"time"
+
 	"github.com/finleap-connect/monoskope/internal/common"
 	"github.com/finleap-connect/monoskope/internal/eventstore"
 	"github.com/finleap-connect/monoskope/internal/messagebus"
 	"github.com/finleap-connect/monoskope/internal/telemetry"
 	api_common "github.com/finleap-connect/monoskope/pkg/api/domain/common"
 	api_es "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	_ "github.com/finleap-connect/monoskope/pkg/domain/eventschemas"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/grpc"
 	"github.com/finleap-connect/monoskope/pkg/logger"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	"github.com/finleap-connect/monoskope/pkg/util"
 	"github.com/spf13/cobra"
 	ggrpc "google.golang.org/grpc"
@@ -33,6 +39,11 @@ var (
 	metricsAddr  string
 	keepAlive    bool
 	msgbusPrefix string
+
+	checkpointInterval time.Duration
+
+	mtlsCertsPath    string
+	mtlsAllowedPeers []string
 )
 
 var serverCmd = &cobra.Command{
@@ -71,11 +82,36 @@ var serverCmd = &cobra.Command{
 		}
 		defer util.PanicOnErrorFunc(store.Close)
 
+		// Create signed checkpoints periodically
+		if checkpointInterval > 0 {
+			log.Info("Setting up checkpointer...", "interval", checkpointInterval)
+			checkpointer, err := eventstore.NewCheckpointer(store, checkpointInterval)
+			if err != nil {
+				log.Error(err, "Failed to configure checkpointer.")
+				return err
+			}
+			checkpointCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			go checkpointer.Run(checkpointCtx)
+		}
+
+		// Require mutual TLS optionally
+		var serverOpts []ggrpc.ServerOption
+		if mtlsCertsPath != "" {
+			log.Info("Setting up mutual TLS...", "allowedPeers", mtlsAllowedPeers)
+			tlsLoader, err := m8tls.NewMutualTLSConfigLoader(mtlsCertsPath)
+			if err != nil {
+				return err
+			}
+			defer tlsLoader.Stop()
+			serverOpts = append(serverOpts, grpc.MutualTLSServerOption(tlsLoader, mtlsAllowedPeers...))
+		}
+
 		// Create the server
 		log.Info("Creating gRPC server...")
-		grpcServer := grpc.NewServer("event-store-grpc", keepAlive)
+		grpcServer := grpc.NewServer("event-store-grpc", keepAlive, serverOpts...)
 		grpcServer.RegisterService(func(s ggrpc.ServiceRegistrar) {
-			api_es.RegisterEventStoreServer(s, eventstore.NewApiServer(store, publisher))
+			api_es.RegisterEventStoreServer(s, eventstore.NewApiServer(store, publisher, es.DefaultEventSchemaRegistry))
 			api_common.RegisterServiceInformationServiceServer(s, common.NewServiceInformationService())
 		})
 
@@ -93,4 +129,7 @@ func init() {
 	flags.StringVarP(&apiAddr, "api-addr", "a", ":8080", "Address the gRPC service will listen on")
 	flags.StringVar(&metricsAddr, "metrics-addr", ":9102", "Address the metrics http service will listen on")
 	flags.StringVar(&msgbusPrefix, "msgbus-routing-key-prefix", "m8", "Prefix for all messages emitted to the msg bus")
+	flags.DurationVar(&checkpointInterval, "checkpoint-interval", 0, "Interval of signed checkpoints over the hash chain, 0 disables checkpoints")
+	flags.StringVar(&mtlsCertsPath, "mtls-certs-path", "", "Path to the ca.crt, tls.crt and tls.key to require mutual TLS with. If not specified connections are not secured.")
+	flags.StringSliceVar(&mtlsAllowedPeers, "mtls-allowed-peers", []string{}, "Common or DNS names of the client certificates allowed to connect. If not specified every certificate of the CA is allowed.")
 }
@@ -0,0 +1,124 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/finleap-connect/monoskope/internal/eventstore"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+var (
+	timeoutVerify    string
+	checkpointPubKey string
+	createCheckpoint bool
+	errChainIsBroken = errors.New("hash chain of the event store is broken")
+)
+
+var verifyChainCmd = &cobra.Command{
+	Use:   "verify-chain [flags]",
+	Short: "Verifies the hash chain",
+	Long:  `Verifies the hash chain of all events and the signed checkpoints and reports the first broken link`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		log := logger.WithName("verify-chain-cmd")
+
+		timeout, err := time.ParseDuration(timeoutVerify)
+		if err != nil {
+			return err
+		}
+
+		// init event store
+		log.Info("Setting up event store...")
+		store, err := eventstore.NewEventStoreWithCheckpointVerification(checkpointPubKey)
+		if err != nil {
+			log.Error(err, "Failed to configure event store.")
+			return err
+		}
+		defer store.Close()
+
+		verifier, ok := store.(es.IntegrityVerifier)
+		if !ok {
+			return esErrors.ErrIntegrityVerificationNotSupported
+		}
+
+		// setup context
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		log.Info("Starting verification...")
+		report, err := verifier.VerifyIntegrity(ctx)
+		if err != nil {
+			log.Error(err, "Failed to verify integrity.")
+			return err
+		}
+		printIntegrityReport(report)
+
+		if !report.Intact() {
+			return errChainIsBroken
+		}
+
+		if createCheckpoint {
+			log.Info("Creating checkpoint...")
+			checkpoint, err := verifier.Checkpoint(ctx)
+			if err != nil {
+				log.Error(err, "Failed to create checkpoint.")
+				return err
+			}
+			fmt.Printf("%-24s%s (digest %s)\n", "Created checkpoint:", checkpoint.Timestamp.Format(time.RFC3339), checkpoint.Digest)
+		}
+		return nil
+	},
+}
+
+func printIntegrityReport(report *es.IntegrityReport) {
+	fmt.Printf("%-24s%d\n", "Verified events:", report.VerifiedEvents)
+	fmt.Printf("%-24s%d\n", "Unchained events:", report.UnchainedEvents)
+	fmt.Printf("%-24s%d\n", "Verified checkpoints:", report.VerifiedCheckpoints)
+	if report.UnverifiedCheckpoints > 0 {
+		fmt.Printf("%-24s%d (no key configured)\n", "Unverified checkpoints:", report.UnverifiedCheckpoints)
+	}
+	if checkpoint := report.LatestCheckpoint; checkpoint != nil {
+		fmt.Printf("%-24s%s (digest %s)\n", "Latest checkpoint:", checkpoint.Timestamp.Format(time.RFC3339), checkpoint.Digest)
+	}
+	fmt.Printf("%-24s%d\n", "Broken links:", report.BrokenLinks)
+
+	if link := report.FirstBrokenLink; link != nil {
+		fmt.Println()
+		fmt.Println("First broken link:")
+		fmt.Printf("  Timestamp:  %s\n", link.Timestamp.Format(time.RFC3339Nano))
+		if link.AggregateType != "" {
+			fmt.Printf("  Aggregate:  %s %s@%d\n", link.AggregateType, link.AggregateID, link.AggregateVersion)
+		}
+		fmt.Printf("  Event:      %s\n", link.EventID)
+		fmt.Printf("  Reason:     %s\n", link.Reason)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(verifyChainCmd)
+	// Local flags
+	flags := verifyChainCmd.Flags()
+	flags.StringVar(&timeoutVerify, "timeout", "1h", "Timeout after which to cancel the verification")
+	flags.StringVar(&checkpointPubKey, "checkpoint-public-key", "", "Path to the PEM encoded ed25519 public key to verify checkpoints with, defaults to the signing key if mounted")
+	flags.BoolVar(&createCheckpoint, "checkpoint", false, "Create a signed checkpoint after successful verification")
+}
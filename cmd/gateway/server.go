@@ -16,6 +16,7 @@ package main
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"path"
 	"strings"
@@ -24,11 +25,12 @@ import (
 	api_common "github.com/finleap-connect/monoskope/pkg/api/domain/common"
 	api "github.com/finleap-connect/monoskope/pkg/api/gateway"
 	"github.com/finleap-connect/monoskope/pkg/domain"
+	esMessaging "github.com/finleap-connect/monoskope/pkg/eventsourcing/messaging"
 	"github.com/finleap-connect/monoskope/pkg/grpc"
 	authm "github.com/finleap-connect/monoskope/pkg/grpc/middleware/auth"
 	"github.com/finleap-connect/monoskope/pkg/jwt"
-	"github.com/finleap-connect/monoskope/pkg/k8s"
 	"github.com/finleap-connect/monoskope/pkg/logger"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	"github.com/finleap-connect/monoskope/pkg/util"
 	ggrpc "google.golang.org/grpc"
 
@@ -61,6 +63,11 @@ var (
 	jwtPath                    string
 	eventStoreAddr             string
 	msgbusPrefix               string
+	grpcInternalApiAddr        string
+	mtlsCertsPath              string
+	mtlsAllowedPeers           []string
+	commandHandlerAddr         string
+	queryHandlerAddr           string
 )
 
 var serverCmd = &cobra.Command{
@@ -135,9 +142,20 @@ var serverCmd = &cobra.Command{
 			return err
 		}
 
+		// Use mutual TLS for internal connections optionally
+		var tlsLoader *m8tls.TLSConfigLoader
+		if mtlsCertsPath != "" {
+			log.Info("Setting up mutual TLS...", "allowedPeers", mtlsAllowedPeers)
+			tlsLoader, err = m8tls.NewMutualTLSConfigLoader(mtlsCertsPath)
+			if err != nil {
+				return err
+			}
+			defer tlsLoader.Stop()
+		}
+
 		// Create EventStore client
 		log.Info("Connecting event store...", "eventStoreAddr", eventStoreAddr)
-		esConnection, esClient, err := eventstore.NewEventStoreClient(ctx, eventStoreAddr)
+		esConnection, esClient, err := eventstore.NewEventStoreClient(ctx, eventStoreAddr, tlsLoader)
 		if err != nil {
 			return err
 		}
@@ -145,7 +163,10 @@ var serverCmd = &cobra.Command{
 
 		// init message bus consumer
 		log.Info("Setting up message bus consumer...")
-		ebConsumer, err := messagebus.NewEventBusConsumer("gateway", msgbusPrefix)
+		// The gateway does not serve the DeadLetterQueue API, failing events are requeued instead of being dead-lettered
+		ebConsumer, err := messagebus.NewEventBusConsumer("gateway", msgbusPrefix, func(conf *esMessaging.RabbitEventBusConfig) {
+			conf.DeadLetterExchangeName = ""
+		})
 		if err != nil {
 			return err
 		}
@@ -157,12 +178,19 @@ var serverCmd = &cobra.Command{
 		}
 
 		// API servers
-		authServer, err := gateway.NewAuthServer(ctx, gatewayURL, server, policiesPath, gwDomain.UserRoleBindingRepository)
+		authServer, err := gateway.NewAuthServer(ctx, gatewayURL, server, policiesPath, gwDomain.UserRoleBindingRepository, gwDomain.RoleRepository)
+		if err != nil {
+			return err
+		}
+
+		// Serve the gRPC APIs as RESTful JSON next to the OIDC provider
+		restGateway, err := gateway.NewRESTGateway(ctx, localAddr(grpcApiAddr), commandHandlerAddr, queryHandlerAddr, tlsLoader)
 		if err != nil {
 			return err
 		}
+		defer restGateway.Close()
 
-		oidcProviderServer := gateway.NewOIDCProviderServer(server)
+		oidcProviderServer := gateway.NewOIDCProviderServer(server).WithRESTGateway(restGateway)
 		gatewayApiServer := gateway.NewGatewayAPIServer(&authClientConfig, client, server, gwDomain.UserRepository)
 
 		// Look for config
@@ -180,7 +208,7 @@ var serverCmd = &cobra.Command{
 		// Parse token lifetime
 		tokenLifeTimePerRole := make(map[string]time.Duration)
 		for k, v := range k8sTokenLifetime {
-			if err := k8s.ValidateRole(k); err != nil {
+			if err := gwDomain.RoleRepository.ValidateK8sRole(ctx, k); err != nil {
 				return err
 			}
 			k8sTokenValidityDuration, err := time.ParseDuration(v)
@@ -190,7 +218,7 @@ var serverCmd = &cobra.Command{
 			tokenLifeTimePerRole[k] = k8sTokenValidityDuration
 		}
 		clusterAuthApiServer := gateway.NewClusterAuthAPIServer(gatewayURL, signer, gwDomain.ClusterAccessRepo, tokenLifeTimePerRole)
-		apiTokenServer := gateway.NewAPITokenServer(gatewayURL, signer, gwDomain.UserRepository)
+		apiTokenServer := gateway.NewAPITokenServer(gatewayURL, signer, gwDomain.UserRepository, gwDomain.UserRoleBindingRepository)
 
 		authMiddleware := authm.NewAuthMiddleware(authServer.AsClient(), []string{
 			"/grpc.health.v1.Health/Check",
@@ -221,6 +249,23 @@ var serverCmd = &cobra.Command{
 		eg.Go(func() error {
 			return oidcProviderServer.Serve(httpApiAddr)
 		})
+
+		// Serve the auth API to other services with mutual TLS
+		if tlsLoader != nil {
+			internalLis, err := net.Listen("tcp", grpcInternalApiAddr)
+			if err != nil {
+				return err
+			}
+			defer internalLis.Close()
+
+			internalGrpcServer := grpc.NewServer("gateway-internal-grpc", keepAlive, grpc.MutualTLSServerOption(tlsLoader, mtlsAllowedPeers...))
+			internalGrpcServer.RegisterService(func(s ggrpc.ServiceRegistrar) {
+				api.RegisterGatewayAuthServer(s, authServer)
+			})
+			eg.Go(func() error {
+				return internalGrpcServer.ServeFromListener(internalLis, nil)
+			})
+		}
 		return eg.Wait()
 	},
 }
@@ -247,5 +292,22 @@ func init() {
 	util.PanicOnError(serverCmd.MarkFlagRequired("gateway-url"))
 
 	flags.StringVar(&policiesPath, "policies-path", "/etc/gateway/policies/policies.rego", "Path to rego policies to authorize requests against")
+	flags.StringVar(&grpcInternalApiAddr, "grpc-internal-api-addr", ":8082", "Address the gRPC service for other services will listen on if mutual TLS is enabled")
+	flags.StringVar(&mtlsCertsPath, "mtls-certs-path", "", "Path to the ca.crt, tls.crt and tls.key to use for mutual TLS with other services. If not specified connections are not secured.")
+	flags.StringSliceVar(&mtlsAllowedPeers, "mtls-allowed-peers", []string{}, "Common or DNS names of the client certificates allowed to connect to the internal gRPC service. If not specified every certificate of the CA is allowed.")
+	flags.StringVar(&commandHandlerAddr, "commandhandler-api-addr", "", "Address the commandhandler gRPC service is listening on. If specified its API is served as RESTful JSON too.")
+	flags.StringVar(&queryHandlerAddr, "queryhandler-api-addr", "", "Address the queryhandler gRPC service is listening on. If specified its API is served as RESTful JSON too.")
 	flags.StringVar(&jwtPath, "jwt-signing-verifying-path", "/etc/gateway/jwt", "Path to tls.key and tls.cert for signing and verifying JWTs")
 }
+
+// localAddr returns the address to connect to a server listening on the given address on the same machine.
+func localAddr(listenAddr string) string {
+	host, port, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return listenAddr
+	}
+	if ip := net.ParseIP(host); host == "" || ip != nil && ip.IsUnspecified() {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
@@ -0,0 +1,329 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	qhApi "github.com/finleap-connect/monoskope/pkg/api/domain"
+	commonApi "github.com/finleap-connect/monoskope/pkg/api/domain/common"
+	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	gatewayApi "github.com/finleap-connect/monoskope/pkg/api/gateway"
+	ef "github.com/finleap-connect/monoskope/pkg/audit/formatters/event"
+	"github.com/finleap-connect/monoskope/pkg/domain"
+	_ "github.com/finleap-connect/monoskope/pkg/domain/eventschemas"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	esMessaging "github.com/finleap-connect/monoskope/pkg/eventsourcing/messaging"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/storage"
+	"github.com/finleap-connect/monoskope/pkg/grpc"
+	authm "github.com/finleap-connect/monoskope/pkg/grpc/middleware/auth"
+	"github.com/finleap-connect/monoskope/pkg/jwt"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	"github.com/finleap-connect/monoskope/pkg/util"
+	ggrpc "google.golang.org/grpc"
+
+	"github.com/finleap-connect/monoskope/internal/commandhandler"
+	"github.com/finleap-connect/monoskope/internal/common"
+	"github.com/finleap-connect/monoskope/internal/dev"
+	"github.com/finleap-connect/monoskope/internal/gateway"
+	"github.com/finleap-connect/monoskope/internal/gateway/auth"
+	"github.com/finleap-connect/monoskope/internal/queryhandler"
+	"github.com/finleap-connect/monoskope/internal/scimserver"
+	"github.com/finleap-connect/monoskope/internal/telemetry"
+	"github.com/spf13/cobra"
+	_ "go.uber.org/automaxprocs"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	grpcApiAddr  string
+	httpApiAddr  string
+	idpAddr      string
+	scimAddr     string
+	metricsAddr  string
+	superUsers   string
+	policiesPath string
+)
+
+var devCmd = &cobra.Command{
+	Use:   "dev [flags]",
+	Short: "Starts all services in one process for development",
+	Long: `Starts the gateway, eventstore, commandhandler, queryhandler and SCIM server in one process.
+Events are kept in memory and get lost on exit. Users are signed in by a stub identity provider without credentials.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log := logger.WithName("dev-cmd")
+		ctx := cmd.Context()
+
+		// Enable OpenTelemetry optionally
+		log.Info("Initializing open telemetry...")
+		shutdownTelemetry, err := telemetry.InitOpenTelemetry(ctx)
+		if err != nil && err != telemetry.ErrOpenTelemetryNotEnabled {
+			return err
+		}
+		if shutdownTelemetry != nil {
+			defer util.PanicOnErrorFunc(shutdownTelemetry)
+		}
+
+		// Seed super users via the same logic the commandhandler uses
+		if superUsers != "" {
+			if err := os.Setenv("SUPER_USERS", superUsers); err != nil {
+				return err
+			}
+		}
+		defaultUser := strings.Split(os.Getenv("SUPER_USERS"), ",")[0]
+
+		// Create all listeners upfront so services can connect to each other right away
+		grpcListener, err := net.Listen("tcp", grpcApiAddr)
+		if err != nil {
+			return err
+		}
+		defer grpcListener.Close()
+		metricsListener, err := net.Listen("tcp", metricsAddr)
+		if err != nil {
+			return err
+		}
+		defer metricsListener.Close()
+		httpListener, err := net.Listen("tcp", httpApiAddr)
+		if err != nil {
+			return err
+		}
+		defer httpListener.Close()
+		idpListener, err := net.Listen("tcp", idpAddr)
+		if err != nil {
+			return err
+		}
+		defer idpListener.Close()
+		scimListener, err := net.Listen("tcp", scimAddr)
+		if err != nil {
+			return err
+		}
+		defer scimListener.Close()
+
+		eg, _ := errgroup.WithContext(ctx)
+		shutdown := util.NewShutdownWaitGroup()
+		shutdown.RegisterSignalHandler(func() {
+			util.PanicOnError(idpListener.Close())
+			util.PanicOnError(scimListener.Close())
+		})
+
+		// Setup event store and bus in memory
+		log.Info("Setting up in-memory event store and bus...")
+		store := storage.NewInMemoryEventStore()
+		if err := store.Open(ctx); err != nil {
+			return err
+		}
+		defer util.PanicOnErrorFunc(store.Close)
+
+		bus, err := esMessaging.NewInMemoryEventBus("monoskope", esMessaging.DefaultMaxRetries, esMessaging.DefaultRetryInterval)
+		if err != nil {
+			return err
+		}
+		defer util.PanicOnErrorFunc(bus.Close)
+
+		eventStore := dev.NewInProcessEventStore(store, bus)
+		eg.Go(eventStore.Serve)
+		esConnection, esClient, err := eventStore.NewClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer util.PanicOnErrorFunc(esConnection.Close)
+
+		// Setup domains
+		log.Info("Setting up es/cqrs...")
+		gwDomain, err := domain.NewGatewayDomain(ctx, bus, esClient)
+		if err != nil {
+			return err
+		}
+		qhDomain, err := domain.NewQueryHandlerDomain(ctx, bus, esClient)
+		if err != nil {
+			return err
+		}
+		aggregateStore, err := domain.SetupCommandHandlerDomain(ctx, esClient)
+		if err != nil {
+			return err
+		}
+
+		// Start the stub identity provider
+		idp, err := dev.NewStubIdentityProvider(localURL(idpListener), defaultUser)
+		if err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			return serveHTTP(shutdown, idpListener, idp.Handler())
+		})
+
+		// Setup gateway auth
+		log.Info("Configuring JWT signing and verifying...")
+		jwtPath, err := os.MkdirTemp("", "monoskope-dev-jwt")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(jwtPath)
+		if err := dev.WriteSigningKey(jwtPath); err != nil {
+			return err
+		}
+		signer := jwt.NewSigner(path.Join(jwtPath, "tls.key"))
+		verifier, err := jwt.NewVerifier(path.Join(jwtPath, "tls.crt"))
+		if err != nil {
+			return err
+		}
+		defer verifier.Close()
+
+		gatewayURL := localURL(httpListener)
+		authClientConfig := &auth.ClientConfig{
+			IdentityProvider: idp.Issuer(),
+			Scopes:           []string{"openid", "profile", "email"},
+			ClientId:         "monoskope-dev",
+			ClientSecret:     "monoskope-dev",
+			Nonce:            "monoskope-dev",
+			RedirectURIs:     []string{"http://localhost:8000", "http://localhost:18000"},
+		}
+		authClient := auth.NewClient(authClientConfig)
+		if err := authClient.SetupOIDC(ctx); err != nil {
+			return err
+		}
+		authServer := auth.NewServer(&auth.ServerConfig{URL: gatewayURL, TokenValidity: 12 * time.Hour}, signer, verifier)
+
+		gatewayAuthServer, err := gateway.NewAuthServer(ctx, gatewayURL, authServer, policiesPath, gwDomain.UserRoleBindingRepository, gwDomain.RoleRepository)
+		if err != nil {
+			return err
+		}
+		grpcAddr := grpcListener.Addr().String()
+		restGateway, err := gateway.NewRESTGateway(ctx, grpcAddr, grpcAddr, grpcAddr, nil)
+		if err != nil {
+			return err
+		}
+		defer restGateway.Close()
+		oidcProviderServer := gateway.NewOIDCProviderServer(authServer).WithRESTGateway(restGateway)
+		eg.Go(func() error {
+			return oidcProviderServer.ServeFromListener(httpListener)
+		})
+
+		// Serve the APIs of gateway, commandhandler and queryhandler on the same port
+		log.Info("Creating gRPC server...")
+		authMiddleware := authm.NewAuthMiddleware(gatewayAuthServer.AsClient(), []string{
+			"/grpc.health.v1.Health/Check",
+			"/gateway.GatewayAuth/",
+			"/gateway.Gateway/",
+		})
+		grpcServer := grpc.NewServerWithOpts("monoskope-grpc", false,
+			[]ggrpc.UnaryServerInterceptor{
+				authMiddleware.UnaryServerInterceptor(),
+			}, []ggrpc.StreamServerInterceptor{
+				authMiddleware.StreamServerInterceptor(),
+			},
+		)
+
+		commandHandlerApiServer := commandhandler.NewApiServer(es.DefaultCommandRegistry, aggregateStore)
+		grpcServer.RegisterService(func(s ggrpc.ServiceRegistrar) {
+			// gateway
+			gatewayApi.RegisterGatewayServer(s, gateway.NewGatewayAPIServer(authClientConfig, authClient, authServer, gwDomain.UserRepository))
+			gatewayApi.RegisterClusterAuthServer(s, gateway.NewClusterAuthAPIServer(gatewayURL, signer, gwDomain.ClusterAccessRepo, map[string]time.Duration{
+				"default": 12 * time.Hour,
+				"admin":   5 * time.Minute,
+				"oncall":  10 * time.Minute,
+			}))
+			gatewayApi.RegisterAPITokenServer(s, gateway.NewAPITokenServer(gatewayURL, signer, gwDomain.UserRepository, gwDomain.UserRoleBindingRepository))
+			gatewayApi.RegisterGatewayAuthServer(s, gatewayAuthServer)
+			// commandhandler
+			esApi.RegisterCommandHandlerServer(s, commandHandlerApiServer)
+			qhApi.RegisterCommandHandlerExtensionsServer(s, commandHandlerApiServer)
+			// queryhandler
+			qhApi.RegisterTenantServer(s, queryhandler.NewTenantServer(qhDomain.TenantRepository, qhDomain.TenantUserRepository, qhDomain.VisibilityRepo))
+			qhApi.RegisterRoleServer(s, queryhandler.NewRoleServer(qhDomain.RoleRepository))
+			qhApi.RegisterUserServer(s, queryhandler.NewUserServer(qhDomain.UserRepository, qhDomain.VisibilityRepo))
+			qhApi.RegisterClusterServer(s, queryhandler.NewClusterServer(qhDomain.ClusterRepository, qhDomain.VisibilityRepo))
+			qhApi.RegisterClusterAccessServer(s, queryhandler.NewClusterAccessServer(qhDomain.ClusterAccessRepo, qhDomain.TenantClusterBindingRepository))
+			qhApi.RegisterAuditLogServer(s, queryhandler.NewAuditLogServer(esClient, ef.DefaultEventFormatterRegistry, qhDomain.UserRepository))
+			qhApi.RegisterProjectionAdminServer(s, queryhandler.NewProjectionAdminServer(qhDomain.ProjectionRebuilders...))
+			qhApi.RegisterDeadLetterQueueServer(s, queryhandler.NewDeadLetterQueueServer(bus))
+			commonApi.RegisterServiceInformationServiceServer(s, common.NewServiceInformationService())
+		})
+		eg.Go(func() error {
+			return grpcServer.ServeFromListener(grpcListener, metricsListener)
+		})
+
+		// Setup SCIM server using the gRPC API like the standalone one
+		log.Info("Setting up SCIM server...")
+		conn, commandHandlerClient, err := grpc.NewClientWithAuthForward(ctx, grpcAddr, nil, esApi.NewCommandHandlerClient)
+		if err != nil {
+			return err
+		}
+		defer util.PanicOnErrorFunc(conn.Close)
+		conn, userClient, err := grpc.NewClientWithAuthForward(ctx, grpcAddr, nil, qhApi.NewUserClient)
+		if err != nil {
+			return err
+		}
+		defer util.PanicOnErrorFunc(conn.Close)
+		conn, roleClient, err := grpc.NewClientWithAuthForward(ctx, grpcAddr, nil, qhApi.NewRoleClient)
+		if err != nil {
+			return err
+		}
+		defer util.PanicOnErrorFunc(conn.Close)
+
+		scimServer := scimserver.NewServer(
+			scimserver.NewProvierConfig(),
+			scimserver.NewUserHandler(commandHandlerClient, userClient),
+			scimserver.NewGroupHandler(commandHandlerClient, userClient, roleClient),
+		)
+		eg.Go(func() error {
+			return serveHTTP(shutdown, scimListener, scimServer)
+		})
+
+		log.Info("Ready!",
+			"grpcApiAddr", grpcAddr,
+			"gatewayURL", gatewayURL,
+			"identityProviderURL", idp.Issuer(),
+			"scimURL", localURL(scimListener),
+			"superUsers", os.Getenv("SUPER_USERS"),
+		)
+		return eg.Wait()
+	},
+}
+
+// serveHTTP serves the handler until the listener is closed on shutdown.
+func serveHTTP(shutdown *util.ShutdownWaitGroup, lis net.Listener, handler http.Handler) error {
+	err := http.Serve(lis, handler)
+	if shutdown.IsExpected() {
+		return nil
+	}
+	return err
+}
+
+// localURL returns the URL of a listener for clients on the same machine.
+func localURL(lis net.Listener) string {
+	_, port, err := net.SplitHostPort(lis.Addr().String())
+	util.PanicOnError(err)
+	return fmt.Sprintf("http://localhost:%s", port)
+}
+
+func init() {
+	rootCmd.AddCommand(devCmd)
+	// Local flags
+	flags := devCmd.Flags()
+	flags.StringVar(&grpcApiAddr, "grpc-api-addr", "localhost:8080", "Address the gRPC APIs of gateway, commandhandler and queryhandler will listen on")
+	flags.StringVar(&httpApiAddr, "http-api-addr", "localhost:8081", "Address the HTTP API of the gateway will listen on")
+	flags.StringVar(&idpAddr, "identity-provider-addr", "localhost:8082", "Address the stub identity provider will listen on")
+	flags.StringVar(&scimAddr, "scim-api-addr", "localhost:8083", "Address the SCIM server will listen on")
+	flags.StringVar(&metricsAddr, "metrics-addr", "localhost:9102", "Address the metrics http service will listen on")
+	flags.StringVar(&superUsers, "super-users", "admin@monoskope.local", "Comma separated emails of users to create as system admins, overrides SUPER_USERS. The first one is signed in by the identity provider by default.")
+	flags.StringVar(&policiesPath, "policies-path", "build/package/helm/gateway/files/policies/policies.rego", "Path to rego policies to authorize requests against")
+}
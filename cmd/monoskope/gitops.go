@@ -0,0 +1,173 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/finleap-connect/monoskope/internal/gitops"
+	"github.com/finleap-connect/monoskope/pkg/client"
+	"github.com/finleap-connect/monoskope/pkg/git"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+const authTokenEnvVar = "M8_AUTH_TOKEN"
+
+var (
+	gitopsConfigPath string
+	gitopsApiAddr    string
+	gitopsInsecure   bool
+	gitopsTimeout    string
+	gitopsWatch      bool
+)
+
+var gitopsCmd = &cobra.Command{
+	Use:   "gitops",
+	Short: "Reconciles Monoskope with a desired state in git",
+	Long:  `Reconciles tenants, clusters, users, role bindings and tenant cluster bindings with the desired state defined in a git repository. Requires the API token of a system admin in the environment variable ` + authTokenEnvVar + `.`,
+}
+
+var planGitopsCmd = &cobra.Command{
+	Use:   "plan [flags]",
+	Short: "Shows the changes required to reconcile",
+	Long:  `Reads the desired state from the latest commit and prints the changes required to reconcile Monoskope with it without applying them`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withGitOpsReconciler(cmd.Context(), func(ctx context.Context, reconciler *gitops.Reconciler, _ *gitops.Config) error {
+			ctx, cancel, err := withGitOpsTimeout(ctx)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			plan, err := reconciler.Plan(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Commit %s:\n%s", plan.Commit, plan)
+			return nil
+		})
+	},
+}
+
+var applyGitopsCmd = &cobra.Command{
+	Use:   "apply [flags]",
+	Short: "Applies the changes required to reconcile",
+	Long:  `Reads the desired state from the latest commit and applies the changes required to reconcile Monoskope with it. The commit is recorded in the metadata of the emitted events.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log := logger.WithName("apply-gitops-cmd")
+
+		return withGitOpsReconciler(cmd.Context(), func(ctx context.Context, reconciler *gitops.Reconciler, conf *gitops.Config) error {
+			apply := func() error {
+				ctx, cancel, err := withGitOpsTimeout(ctx)
+				if err != nil {
+					return err
+				}
+				defer cancel()
+
+				plan, err := reconciler.Reconcile(ctx)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Commit %s:\n%s", plan.Commit, plan)
+				return nil
+			}
+
+			if !gitopsWatch {
+				return apply()
+			}
+
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			ticker := time.NewTicker(*conf.Interval)
+			defer ticker.Stop()
+			for {
+				if err := apply(); err != nil {
+					log.Error(err, "Failed running reconciliation loop.")
+				}
+				select {
+				case <-ticker.C:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		})
+	},
+}
+
+// withGitOpsReconciler connects to Monoskope, clones the configured repo and calls f with a reconciler.
+func withGitOpsReconciler(ctx context.Context, f func(context.Context, *gitops.Reconciler, *gitops.Config) error) error {
+	conf, err := gitops.NewConfigFromFilePath(gitopsConfigPath)
+	if err != nil {
+		return err
+	}
+
+	// The token is read from the environment only to keep it out of the process list
+	authToken := os.Getenv(authTokenEnvVar)
+	if authToken == "" {
+		return fmt.Errorf("environment variable %s must be set", authTokenEnvVar)
+	}
+	opts := []client.Option{client.WithToken(authToken)}
+	if gitopsInsecure {
+		opts = append(opts, client.WithInsecure())
+	}
+
+	c, err := client.New(ctx, gitopsApiAddr, opts...)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	gitClient, err := git.NewGitClient(conf.Repository)
+	if err != nil {
+		return err
+	}
+	defer gitClient.Close()
+
+	if err := gitClient.Clone(ctx); err != nil {
+		return err
+	}
+
+	return f(ctx, gitops.NewReconciler(conf, c, gitClient), conf)
+}
+
+// withGitOpsTimeout returns a context which is cancelled after the configured timeout.
+func withGitOpsTimeout(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	timeout, err := time.ParseDuration(gitopsTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, nil
+}
+
+func init() {
+	rootCmd.AddCommand(gitopsCmd)
+	gitopsCmd.AddCommand(planGitopsCmd)
+	gitopsCmd.AddCommand(applyGitopsCmd)
+	// Local flags
+	flags := gitopsCmd.PersistentFlags()
+	flags.StringVar(&gitopsConfigPath, "config", "gitops.yaml", "Path of the configuration of the repository containing the desired state")
+	flags.StringVar(&gitopsApiAddr, "api-addr", "localhost:8080", "Address of the gRPC API of Monoskope")
+	flags.BoolVar(&gitopsInsecure, "insecure", false, "Connect without transport security, e.g. to the dev server")
+	flags.StringVar(&gitopsTimeout, "timeout", "10m", "Timeout of a single reconciliation after which to cancel")
+	applyGitopsCmd.Flags().BoolVar(&gitopsWatch, "watch", false, "Reconcile continuously at the configured interval until interrupted")
+}
@@ -0,0 +1,159 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/finleap-connect/monoskope/internal/queryhandler"
+	api "github.com/finleap-connect/monoskope/pkg/api/domain"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const authTokenEnvVar = "M8_AUTH_TOKEN"
+
+var (
+	projectionsApiAddr       string
+	projectionsAggregateType string
+	projectionsTimeout       string
+	projectionsMtlsCertsPath string
+	errProjectionsDrifted    = errors.New("projections drifted from the event store")
+)
+
+var projectionsCmd = &cobra.Command{
+	Use:   "projections",
+	Short: "Rebuilds or checks projections",
+	Long:  `Rebuilds or checks the projections of a running queryhandler. Requires the API token of a system admin in the environment variable ` + authTokenEnvVar + `.`,
+}
+
+var rebuildProjectionsCmd = &cobra.Command{
+	Use:   "rebuild [flags]",
+	Short: "Rebuilds projections",
+	Long:  `Rebuilds the projections of one or all aggregate types from the event store and swaps them with the live ones without downtime`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log := logger.WithName("rebuild-projections-cmd")
+
+		return withProjectionAdminClient(cmd.Context(), func(ctx context.Context, client api.ProjectionAdminClient) error {
+			log.Info("Rebuilding projections...", "aggregateType", projectionsAggregateType)
+			stream, err := client.Rebuild(ctx, wrapperspb.String(projectionsAggregateType))
+			if err != nil {
+				return err
+			}
+
+			for {
+				result, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				fmt.Printf("%-24s%d projections in %s\n", result.AggregateType+":", result.Projections, result.Duration.AsDuration())
+			}
+		})
+	},
+}
+
+var checkProjectionsCmd = &cobra.Command{
+	Use:   "check [flags]",
+	Short: "Checks projections",
+	Long:  `Compares the versions of the projections of one or all aggregate types with the event store and reports any drift`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log := logger.WithName("check-projections-cmd")
+
+		return withProjectionAdminClient(cmd.Context(), func(ctx context.Context, client api.ProjectionAdminClient) error {
+			log.Info("Checking projections...", "aggregateType", projectionsAggregateType)
+			stream, err := client.Check(ctx, wrapperspb.String(projectionsAggregateType))
+			if err != nil {
+				return err
+			}
+
+			drifted := false
+			for {
+				result, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				fmt.Printf("%-24s%d checked, %d drifted\n", result.AggregateType+":", result.CheckedProjections, len(result.Drift))
+				for _, drift := range result.Drift {
+					fmt.Printf("  %s projection version %d, aggregate version %d\n", drift.AggregateId, drift.ProjectionVersion, drift.AggregateVersion)
+				}
+				drifted = drifted || len(result.Drift) > 0
+			}
+
+			if drifted {
+				return errProjectionsDrifted
+			}
+			return nil
+		})
+	},
+}
+
+// withProjectionAdminClient connects to the queryhandler and calls f with a client of the ProjectionAdmin API.
+func withProjectionAdminClient(ctx context.Context, f func(context.Context, api.ProjectionAdminClient) error) error {
+	timeout, err := time.ParseDuration(projectionsTimeout)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The token is read from the environment only to keep it out of the process list
+	authToken := os.Getenv(authTokenEnvVar)
+	if authToken == "" {
+		return fmt.Errorf("environment variable %s must be set", authTokenEnvVar)
+	}
+
+	var tlsLoader *m8tls.TLSConfigLoader
+	if projectionsMtlsCertsPath != "" {
+		tlsLoader, err = m8tls.NewMutualTLSConfigLoader(projectionsMtlsCertsPath)
+		if err != nil {
+			return err
+		}
+		defer tlsLoader.Stop()
+	}
+
+	conn, client, err := queryhandler.NewProjectionAdminClient(ctx, projectionsApiAddr, authToken, tlsLoader)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return f(ctx, client)
+}
+
+func init() {
+	rootCmd.AddCommand(projectionsCmd)
+	projectionsCmd.AddCommand(rebuildProjectionsCmd)
+	projectionsCmd.AddCommand(checkProjectionsCmd)
+	// Local flags
+	flags := projectionsCmd.PersistentFlags()
+	flags.StringVar(&projectionsApiAddr, "api-addr", "localhost:8080", "Address the gRPC service of the queryhandler is listening on")
+	flags.StringVar(&projectionsAggregateType, "aggregate-type", "", "Type of the aggregates whose projections to rebuild or check, all if not specified")
+	flags.StringVar(&projectionsTimeout, "timeout", "10m", "Timeout after which to cancel")
+	flags.StringVar(&projectionsMtlsCertsPath, "mtls-certs-path", "", "Path to the ca.crt, tls.crt and tls.key to connect to the queryhandler with mutual TLS. If not specified the connection is not secured.")
+}
@@ -15,6 +15,10 @@
 package main
 
 import (
+	"fmt"
+	"os"
+	"time"
+
 	ef "github.com/finleap-connect/monoskope/pkg/audit/formatters/event"
 	"github.com/finleap-connect/monoskope/pkg/grpc/middleware/auth"
 	"github.com/finleap-connect/monoskope/pkg/util"
@@ -22,10 +26,15 @@ import (
 	qhApi "github.com/finleap-connect/monoskope/pkg/api/domain"
 	commonApi "github.com/finleap-connect/monoskope/pkg/api/domain/common"
 	"github.com/finleap-connect/monoskope/pkg/domain"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	esMessaging "github.com/finleap-connect/monoskope/pkg/eventsourcing/messaging"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/storage"
 	grpc "github.com/finleap-connect/monoskope/pkg/grpc"
 	"github.com/finleap-connect/monoskope/pkg/logger"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	ggrpc "google.golang.org/grpc"
 
+	"github.com/finleap-connect/monoskope/internal/auditforwarder"
 	"github.com/finleap-connect/monoskope/internal/common"
 	"github.com/finleap-connect/monoskope/internal/eventstore"
 	"github.com/finleap-connect/monoskope/internal/gateway"
@@ -38,13 +47,24 @@ import (
 )
 
 var (
-	apiAddr        string
-	metricsAddr    string
-	keepAlive      bool
-	eventStoreAddr string
-	msgbusPrefix   string
-	gatewayAddr    string
-	k8sAuthZConf   string
+	apiAddr          string
+	metricsAddr      string
+	keepAlive        bool
+	eventStoreAddr   string
+	msgbusPrefix     string
+	gatewayAddr      string
+	k8sAuthZConf     string
+	auditFwdConf     string
+	maxRetries       int
+	retryInterval    time.Duration
+	projectionStore  string
+	mtlsCertsPath    string
+	mtlsAllowedPeers []string
+)
+
+const (
+	projectionStoreMemory   = "memory"
+	projectionStorePostgres = "postgres"
 )
 
 var serverCmd = &cobra.Command{
@@ -66,9 +86,22 @@ var serverCmd = &cobra.Command{
 			defer util.PanicOnErrorFunc(shutdownTelemetry)
 		}
 
+		// Use and require mutual TLS for internal connections optionally
+		var tlsLoader *m8tls.TLSConfigLoader
+		var serverOpts []ggrpc.ServerOption
+		if mtlsCertsPath != "" {
+			log.Info("Setting up mutual TLS...", "allowedPeers", mtlsAllowedPeers)
+			tlsLoader, err = m8tls.NewMutualTLSConfigLoader(mtlsCertsPath)
+			if err != nil {
+				return err
+			}
+			defer tlsLoader.Stop()
+			serverOpts = append(serverOpts, grpc.MutualTLSServerOption(tlsLoader, mtlsAllowedPeers...))
+		}
+
 		// Create EventStore client
 		log.Info("Connecting event store...", "eventStoreAddr", eventStoreAddr)
-		esConnection, esClient, err := eventstore.NewEventStoreClient(ctx, eventStoreAddr)
+		esConnection, esClient, err := eventstore.NewEventStoreClient(ctx, eventStoreAddr, tlsLoader)
 		if err != nil {
 			return err
 		}
@@ -76,15 +109,31 @@ var serverCmd = &cobra.Command{
 
 		// init message bus consumer
 		log.Info("Setting up message bus consumer...")
-		ebConsumer, err := messagebus.NewEventBusConsumer("queryhandler", msgbusPrefix)
+		ebConsumer, err := messagebus.NewEventBusConsumer("queryhandler", msgbusPrefix, func(conf *esMessaging.RabbitEventBusConfig) {
+			conf.MaxRetries = maxRetries
+			conf.RetryInterval = retryInterval
+		})
 		if err != nil {
 			return err
 		}
 		defer util.PanicOnErrorFunc(ebConsumer.Close)
 
 		// Setup domain
-		log.Info("Seting up es/cqrs...")
-		qhDomain, err := domain.NewQueryHandlerDomain(ctx, ebConsumer, esClient)
+		log.Info("Seting up es/cqrs...", "projectionStore", projectionStore)
+		var qhDomain *domain.QueryHandlerDomain
+		switch projectionStore {
+		case projectionStoreMemory:
+			qhDomain, err = domain.NewQueryHandlerDomain(ctx, ebConsumer, esClient)
+		case projectionStorePostgres:
+			db, dbErr := storage.NewPostgresDB(ctx, os.Getenv("PROJECTIONS_DB_URL"))
+			if dbErr != nil {
+				return dbErr
+			}
+			defer util.PanicOnErrorFunc(db.Close)
+			qhDomain, err = domain.NewQueryHandlerDomainWithPostgres(ctx, ebConsumer, esClient, db)
+		default:
+			err = fmt.Errorf("unknown projection store %q", projectionStore)
+		}
 		if err != nil {
 			return err
 		}
@@ -92,7 +141,7 @@ var serverCmd = &cobra.Command{
 		// Create gRPC server and register implementation
 		// Create Gateway Auth client
 		log.Info("Connecting gateway...", "gatewayAddr", gatewayAddr)
-		conn, gatewaySvcClient, err := gateway.NewInsecureAuthServerClient(ctx, gatewayAddr)
+		conn, gatewaySvcClient, err := gateway.NewAuthServerClient(ctx, gatewayAddr, tlsLoader)
 		if err != nil {
 			return err
 		}
@@ -108,6 +157,7 @@ var serverCmd = &cobra.Command{
 			}, []ggrpc.StreamServerInterceptor{
 				authMiddleware.StreamServerInterceptor(),
 			},
+			serverOpts...,
 		)
 
 		// Configure k8s authz reconciliation
@@ -116,7 +166,7 @@ var serverCmd = &cobra.Command{
 			if err != nil {
 				return err
 			}
-			k8sAuthZManager := k8sauthz.NewManager(qhDomain.UserRepository, qhDomain.ClusterAccessRepo)
+			k8sAuthZManager := k8sauthz.NewManager(qhDomain.UserRepository, qhDomain.ClusterAccessRepo, qhDomain.RoleRepository)
 
 			if err := k8sAuthZManager.Run(ctx, conf); err != nil {
 				return err
@@ -124,13 +174,31 @@ var serverCmd = &cobra.Command{
 			defer util.PanicOnErrorFunc(k8sAuthZManager.Close)
 		}
 
+		// Configure audit forwarding
+		if auditFwdConf != "" {
+			conf, err := auditforwarder.NewConfigFromFilePath(auditFwdConf)
+			if err != nil {
+				return err
+			}
+			auditForwarder := auditforwarder.NewAuditForwarder(esClient, ef.DefaultEventFormatterRegistry, auditforwarder.NewSinkFromConfig(conf))
+			if err := auditForwarder.Register(ctx, ebConsumer); err != nil {
+				return err
+			}
+			defer util.PanicOnErrorFunc(auditForwarder.Close)
+		}
+
 		grpcServer.RegisterService(func(s ggrpc.ServiceRegistrar) {
-			qhApi.RegisterTenantServer(s, queryhandler.NewTenantServer(qhDomain.TenantRepository, qhDomain.TenantUserRepository))
-			qhApi.RegisterUserServer(s, queryhandler.NewUserServer(qhDomain.UserRepository))
-			qhApi.RegisterClusterServer(s, queryhandler.NewClusterServer(qhDomain.ClusterRepository))
+			qhApi.RegisterTenantServer(s, queryhandler.NewTenantServer(qhDomain.TenantRepository, qhDomain.TenantUserRepository, qhDomain.VisibilityRepo))
+			qhApi.RegisterRoleServer(s, queryhandler.NewRoleServer(qhDomain.RoleRepository))
+			qhApi.RegisterUserServer(s, queryhandler.NewUserServer(qhDomain.UserRepository, qhDomain.VisibilityRepo))
+			qhApi.RegisterClusterServer(s, queryhandler.NewClusterServer(qhDomain.ClusterRepository, qhDomain.VisibilityRepo))
 			qhApi.RegisterClusterAccessServer(s, queryhandler.NewClusterAccessServer(qhDomain.ClusterAccessRepo, qhDomain.TenantClusterBindingRepository))
 			qhApi.RegisterAuditLogServer(s, queryhandler.NewAuditLogServer(esClient, ef.DefaultEventFormatterRegistry, qhDomain.UserRepository))
 			commonApi.RegisterServiceInformationServiceServer(s, common.NewServiceInformationService())
+			qhApi.RegisterProjectionAdminServer(s, queryhandler.NewProjectionAdminServer(qhDomain.ProjectionRebuilders...))
+			if deadLetterQueue, ok := ebConsumer.(es.DeadLetterQueue); ok {
+				qhApi.RegisterDeadLetterQueueServer(s, queryhandler.NewDeadLetterQueueServer(deadLetterQueue))
+			}
 		})
 
 		// Finally start the server
@@ -148,6 +216,12 @@ func init() {
 	flags.StringVar(&metricsAddr, "metrics-addr", ":9102", "Address the metrics http service will listen on")
 	flags.StringVar(&eventStoreAddr, "event-store-api-addr", ":8081", "Address the eventstore gRPC service is listening on")
 	flags.StringVar(&msgbusPrefix, "msgbus-routing-key-prefix", "m8", "Prefix for all messages emitted to the msg bus")
+	flags.IntVar(&maxRetries, "msgbus-max-retries", esMessaging.DefaultMaxRetries, "Number of retries of a failing event handler before the event is moved to the dead letter queue")
+	flags.DurationVar(&retryInterval, "msgbus-retry-interval", esMessaging.DefaultRetryInterval, "Initial interval between retries of a failing event handler")
+	flags.StringVar(&projectionStore, "projection-store", projectionStoreMemory, "Where to keep projections, either memory or postgres. The postgres store connects to the database at PROJECTIONS_DB_URL.")
 	flags.StringVar(&gatewayAddr, "gateway-api-addr", ":8081", "Address the gateway gRPC service is listening on")
+	flags.StringVar(&mtlsCertsPath, "mtls-certs-path", "", "Path to the ca.crt, tls.crt and tls.key to serve and to connect to the eventstore and the internal gRPC service of the gateway with mutual TLS. If not specified connections are not secured.")
+	flags.StringSliceVar(&mtlsAllowedPeers, "mtls-allowed-peers", []string{}, "Common or DNS names of the client certificates allowed to connect if mutual TLS is enabled. If not specified every certificate of the CA is allowed.")
 	flags.StringVar(&k8sAuthZConf, "k8s-authz-conf-path", "", "Path to load K8sAuthZ config from. If not specified the feature is disabled.")
+	flags.StringVar(&auditFwdConf, "audit-forwarder-conf-path", "", "Path to load audit forwarder config from. If not specified the feature is disabled.")
 }
@@ -26,6 +26,7 @@ import (
 	commandHandlerApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
 	grpcUtil "github.com/finleap-connect/monoskope/pkg/grpc"
 	"github.com/finleap-connect/monoskope/pkg/logger"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	"github.com/finleap-connect/monoskope/pkg/util"
 	"github.com/heptiolabs/healthcheck"
 	"github.com/spf13/cobra"
@@ -38,6 +39,7 @@ var (
 	healthApiAddr      string
 	commandHandlerAddr string
 	queryHandlerAddr   string
+	mtlsCertsPath      string
 )
 
 var serveCmd = &cobra.Command{
@@ -59,9 +61,20 @@ var serveCmd = &cobra.Command{
 			defer util.PanicOnErrorFunc(shutdownTelemetry)
 		}
 
+		// Use mutual TLS for internal connections optionally
+		var tlsLoader *m8tls.TLSConfigLoader
+		if mtlsCertsPath != "" {
+			log.Info("Setting up mutual TLS...")
+			tlsLoader, err = m8tls.NewMutualTLSConfigLoader(mtlsCertsPath)
+			if err != nil {
+				return err
+			}
+			defer tlsLoader.Stop()
+		}
+
 		// Create CommandHandler client
 		log.Info("Connecting command handler...", "commandHandlerAddr", commandHandlerAddr)
-		conn, commandHandlerClient, err := grpcUtil.NewClientWithAuthForward(ctx, commandHandlerAddr, false, commandHandlerApi.NewCommandHandlerClient)
+		conn, commandHandlerClient, err := grpcUtil.NewClientWithAuthForward(ctx, commandHandlerAddr, tlsLoader, commandHandlerApi.NewCommandHandlerClient)
 		if err != nil {
 			return err
 		}
@@ -69,7 +82,14 @@ var serveCmd = &cobra.Command{
 
 		// Create User client
 		log.Info("Connecting queryhandler...", "queryHandlerAddr", queryHandlerAddr)
-		conn, userClient, err := grpcUtil.NewClientWithAuthForward(ctx, queryHandlerAddr, false, domainApi.NewUserClient)
+		conn, userClient, err := grpcUtil.NewClientWithAuthForward(ctx, queryHandlerAddr, tlsLoader, domainApi.NewUserClient)
+		if err != nil {
+			return err
+		}
+		defer util.PanicOnErrorFunc(conn.Close)
+
+		// Create Role client
+		conn, roleClient, err := grpcUtil.NewClientWithAuthForward(ctx, queryHandlerAddr, tlsLoader, domainApi.NewRoleClient)
 		if err != nil {
 			return err
 		}
@@ -98,7 +118,7 @@ var serveCmd = &cobra.Command{
 
 		providerConfig := scimserver.NewProvierConfig()
 		userHandler := scimserver.NewUserHandler(commandHandlerClient, userClient)
-		groupHandler := scimserver.NewGroupHandler(commandHandlerClient, userClient)
+		groupHandler := scimserver.NewGroupHandler(commandHandlerClient, userClient, roleClient)
 		scimServer := scimserver.NewServer(providerConfig, userHandler, groupHandler)
 
 		// Start routine waiting for signals
@@ -141,4 +161,5 @@ func init() {
 	flags.StringVar(&healthApiAddr, "health-api-addr", ":8082", "Address the health check HTTP service will listen on")
 	flags.StringVar(&commandHandlerAddr, "command-handler-api-addr", ":8081", "Address the command handler gRPC service is listening on")
 	flags.StringVar(&queryHandlerAddr, "query-handler-api-addr", ":8082", "Address the query handler gRPC service is listening on")
+	flags.StringVar(&mtlsCertsPath, "mtls-certs-path", "", "Path to the ca.crt, tls.crt and tls.key to connect to the commandhandler and the queryhandler with mutual TLS. If not specified connections are not secured.")
 }
@@ -42,6 +42,10 @@ go-test-ci: ## Run tests in CI/CD.
 	@find . -name '*.coverprofile' -exec rm {} \;
 	@$(GINKGO) -r -cover --failFast -requireSuite -covermode count -outputdir=$(BUILD_PATH) -coverprofile=monoskope.coverprofile 
 
+.PHONY: go-run-dev
+go-run-dev: ## Run all services in one process with in-memory backends.
+	@go run ./cmd/monoskope dev
+
 .PHONY: go-coverage
 go-coverage: ## Print coverage from coverprofiles.
 	@go tool cover -func monoskope.coverprofile
@@ -54,6 +58,10 @@ go-protobuf: .protobuf-deps
 	export PATH="$(LOCALBIN):$$PATH:" ; find ./api -name '*.proto' -exec $(PROTOC) -I. -I$(PROTOC_IMPORTS_DIR) --go-grpc_opt=module=github.com/finleap-connect/monoskope --go-grpc_out=. --validate_out="lang=go,module=github.com/finleap-connect/monoskope:." {} \;
 	# generates client part
 	export PATH="$(LOCALBIN):$$PATH" ; find ./api -name '*.proto' -exec $(PROTOC) -I. -I$(PROTOC_IMPORTS_DIR) --go_opt=module=github.com/finleap-connect/monoskope --go_out=. --validate_out="lang=go,module=github.com/finleap-connect/monoskope:." {} \;
+	# generates RESTful JSON gateway
+	export PATH="$(LOCALBIN):$$PATH" ; find ./api -name '*.proto' -exec $(PROTOC) -I. -I$(PROTOC_IMPORTS_DIR) --grpc-gateway_opt=module=github.com/finleap-connect/monoskope --grpc-gateway_out=. {} \;
+	# generates OpenAPI document of the RESTful JSON gateway
+	export PATH="$(LOCALBIN):$$PATH" ; $(PROTOC) -I. -I$(PROTOC_IMPORTS_DIR) --openapiv2_opt=allow_merge=true,merge_file_name=monoskope --openapiv2_out=api/openapi $(OPENAPI_PROTO_FILES)
 
 .PHONY: go-rebuild-mocks
 go-rebuild-mocks: .protobuf-deps gomock
@@ -64,7 +72,7 @@ go-rebuild-mocks: .protobuf-deps gomock
 	$(MOCKGEN) -copyright_file $(COPYRIGHT_FILE) -destination internal/test/api/gateway/gateway_auth_client.go github.com/finleap-connect/monoskope/pkg/api/gateway GatewayAuthClient
 	$(MOCKGEN) -copyright_file $(COPYRIGHT_FILE) -destination internal/test/eventsourcing/mock_handler.go github.com/finleap-connect/monoskope/pkg/eventsourcing EventHandler
 	$(MOCKGEN) -copyright_file $(COPYRIGHT_FILE) -destination internal/test/eventsourcing/aggregate_store.go github.com/finleap-connect/monoskope/pkg/eventsourcing AggregateStore
-	$(MOCKGEN) -copyright_file $(COPYRIGHT_FILE) -destination internal/test/domain/repositories/repositories.go github.com/finleap-connect/monoskope/pkg/domain/repositories UserRepository,ClusterRepository,ClusterAccessRepository
+	$(MOCKGEN) -copyright_file $(COPYRIGHT_FILE) -destination internal/test/domain/repositories/repositories.go github.com/finleap-connect/monoskope/pkg/domain/repositories UserRepository,UserRoleBindingRepository,ClusterRepository,ClusterAccessRepository
 
 ##@ Build Dependencies
 
@@ -91,6 +99,8 @@ PROTOC_VERSION ?= 21.2
 PROTOC_GEN_GO_VERSION ?= v1.28
 PROTOC_GEN_GO_GRPC_VERSION ?= v1.2
 PROTOC_GEN_VALIDATE_VERSION ?= 0.6.7
+GRPC_GATEWAY_VERSION ?= v2.7.0
+GOOGLEAPIS_REF ?= master
 
 GOARCH := $(shell go env GOARCH)
 GOOS := $(shell go env GOOS)
@@ -111,6 +121,7 @@ PROTO_ARCH_OS := $(PROTO_OS)-$(PROTO_ARCH)
 ## Tool Config
 PROTOC_IMPORTS_DIR          ?= $(BUILD_PATH)/api_includes
 PROTO_FILES                 != find api -name "*.proto"
+OPENAPI_PROTO_FILES         ?= api/gateway/service.proto api/eventsourcing/commandhandler_service.proto api/domain/commandhandler_service.proto api/domain/queryhandler_service.proto
 
 ginkgo: $(GINKGO) ## Download ginkgo locally if necessary.
 $(GINKGO): $(LOCALBIN)
@@ -145,4 +156,11 @@ protoc-get $(PROTOC):
 	GOBIN=$(LOCALBIN) go install google.golang.org/protobuf/cmd/protoc-gen-go@$(PROTOC_GEN_GO_VERSION)
 	GOBIN=$(LOCALBIN) go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@$(PROTOC_GEN_GO_GRPC_VERSION)
 	GOBIN=$(LOCALBIN) go install github.com/envoyproxy/protoc-gen-validate@v$(PROTOC_GEN_VALIDATE_VERSION)
+	mkdir -p $(PROTOC_IMPORTS_DIR)/google/api/
+	$(CURL) -fL -o $(PROTOC_IMPORTS_DIR)/google/api/annotations.proto "https://raw.githubusercontent.com/googleapis/googleapis/$(GOOGLEAPIS_REF)/google/api/annotations.proto"
+	$(CURL) -fL -o $(PROTOC_IMPORTS_DIR)/google/api/http.proto "https://raw.githubusercontent.com/googleapis/googleapis/$(GOOGLEAPIS_REF)/google/api/http.proto"
+	mkdir -p $(PROTOC_IMPORTS_DIR)/google/rpc/
+	$(CURL) -fL -o $(PROTOC_IMPORTS_DIR)/google/rpc/status.proto "https://raw.githubusercontent.com/googleapis/googleapis/$(GOOGLEAPIS_REF)/google/rpc/status.proto"
+	GOBIN=$(LOCALBIN) go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway@$(GRPC_GATEWAY_VERSION)
+	GOBIN=$(LOCALBIN) go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2@$(GRPC_GATEWAY_VERSION)
 	
\ No newline at end of file
@@ -0,0 +1,137 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditforwarder
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	DefaultSyslogNetwork  = "udp"
+	DefaultSyslogAppName  = "monoskope"
+	DefaultWebhookTimeout = 10 * time.Second
+)
+
+var (
+	ErrExactlyOneSinkRequired     = errors.New("exactly one of syslog or webhook must be configured")
+	ErrSyslogAddressRequired      = errors.New("syslog address is required")
+	ErrSyslogNetworkNotSupported  = errors.New("syslog network must be udp or tcp")
+	ErrWebhookURLRequired         = errors.New("webhook url is required")
+	ErrWebhookRequestUnsuccessful = errors.New("webhook responded with unsuccessful status")
+)
+
+// SyslogConfig configures forwarding to a syslog receiver using RFC 5424 messages.
+type SyslogConfig struct {
+	// Network is the transport to use, either udp or tcp. Defaults to udp.
+	Network string `yaml:"network"`
+	// Address is the host:port of the syslog receiver.
+	Address string `yaml:"address"`
+	// AppName is the APP-NAME field of the messages. Defaults to monoskope.
+	AppName string `yaml:"appName"`
+	// Hostname is the HOSTNAME field of the messages. Defaults to the hostname of the machine.
+	Hostname string `yaml:"hostname"`
+}
+
+// WebhookConfig configures forwarding to an HTTP webhook.
+type WebhookConfig struct {
+	// URL the audit events are posted to as JSON.
+	URL string `yaml:"url"`
+	// Headers are added to each request. Environment variables in values are expanded, e.g. "Bearer ${WEBHOOK_TOKEN}".
+	Headers map[string]string `yaml:"headers"`
+	// Timeout of a single request. Defaults to 10s.
+	Timeout *time.Duration `yaml:"timeout"`
+}
+
+// Config is the configuration of the audit forwarder.
+type Config struct {
+	// Syslog configures forwarding to syslog.
+	Syslog *SyslogConfig `yaml:"syslog"`
+	// Webhook configures forwarding to an HTTP webhook.
+	Webhook *WebhookConfig `yaml:"webhook"`
+}
+
+// NewConfigFromFilePath creates a new Config from a given yaml file path
+func NewConfigFromFilePath(name string) (*Config, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewConfigFromFile(data)
+}
+
+// NewConfigFromFile creates a new Config from a given yaml file
+func NewConfigFromFile(data []byte) (*Config, error) {
+	conf := &Config{}
+	if err := yaml.Unmarshal(data, conf); err != nil {
+		return nil, err
+	}
+
+	if err := conf.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := conf.setDefaults(); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// validate validates the configuration
+func (c *Config) validate() error {
+	if (c.Syslog == nil) == (c.Webhook == nil) {
+		return ErrExactlyOneSinkRequired
+	}
+	if c.Syslog != nil {
+		if c.Syslog.Address == "" {
+			return ErrSyslogAddressRequired
+		}
+		if c.Syslog.Network != "" && c.Syslog.Network != "udp" && c.Syslog.Network != "tcp" {
+			return ErrSyslogNetworkNotSupported
+		}
+	}
+	if c.Webhook != nil && c.Webhook.URL == "" {
+		return ErrWebhookURLRequired
+	}
+	return nil
+}
+
+// setDefaults sets the default values on the configuration
+func (c *Config) setDefaults() error {
+	if c.Syslog != nil {
+		if c.Syslog.Network == "" {
+			c.Syslog.Network = DefaultSyslogNetwork
+		}
+		if c.Syslog.AppName == "" {
+			c.Syslog.AppName = DefaultSyslogAppName
+		}
+		if c.Syslog.Hostname == "" {
+			hostname, err := os.Hostname()
+			if err != nil {
+				return err
+			}
+			c.Syslog.Hostname = hostname
+		}
+	}
+	if c.Webhook != nil && c.Webhook.Timeout == nil {
+		timeout := DefaultWebhookTimeout
+		c.Webhook.Timeout = &timeout
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditforwarder
+
+import (
+	"context"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/audit"
+	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	auditFormatter "github.com/finleap-connect/monoskope/pkg/audit/formatters/audit"
+	"github.com/finleap-connect/monoskope/pkg/audit/formatters/event"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+)
+
+// workQueueName is the name of the queue shared by all audit forwarder instances
+const workQueueName = "auditforwarder"
+
+// Sink ships audit events to an external system
+type Sink interface {
+	// Send ships a single audit event
+	Send(context.Context, *audit.AuditEvent) error
+	// Close releases all resources of the sink
+	Close() error
+}
+
+// NewSinkFromConfig creates the Sink configured
+func NewSinkFromConfig(conf *Config) Sink {
+	if conf.Syslog != nil {
+		return NewSyslogSink(conf.Syslog)
+	}
+	return NewWebhookSink(conf.Webhook)
+}
+
+// AuditForwarder is an EventHandler formatting all events as audit events and shipping them to a Sink
+type AuditForwarder struct {
+	log       logger.Logger
+	formatter auditFormatter.AuditFormatter
+	sink      Sink
+}
+
+// NewAuditForwarder creates a new AuditForwarder
+func NewAuditForwarder(esClient esApi.EventStoreClient, efRegistry event.EventFormatterRegistry, sink Sink) *AuditForwarder {
+	return &AuditForwarder{
+		log:       logger.WithName("audit-forwarder"),
+		formatter: auditFormatter.NewAuditFormatter(esClient, efRegistry),
+		sink:      sink,
+	}
+}
+
+// Register subscribes the forwarder to all events of the bus. Multiple instances share the work.
+func (f *AuditForwarder) Register(ctx context.Context, consumer es.EventBusConsumer) error {
+	return consumer.AddWorker(ctx, f, workQueueName, consumer.Matcher().Any())
+}
+
+// HandleEvent implements the EventHandler interface
+func (f *AuditForwarder) HandleEvent(ctx context.Context, e es.Event) error {
+	auditEvent := f.formatter.NewAuditEvent(ctx, es.NewProtoFromEvent(e))
+	if err := f.sink.Send(ctx, auditEvent); err != nil {
+		f.log.Error(err, "Failed to forward audit event.", "eventType", e.EventType(), "aggregateId", e.AggregateID())
+		return err
+	}
+	return nil
+}
+
+// Close closes the sink
+func (f *AuditForwarder) Close() error {
+	return f.sink.Close()
+}
@@ -0,0 +1,200 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditforwarder
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/audit"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/audit/formatters/event"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	"github.com/finleap-connect/monoskope/pkg/domain/metadata"
+	"github.com/finleap-connect/monoskope/pkg/domain/mock"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// recordingSink records all audit events sent
+type recordingSink struct {
+	events []*audit.AuditEvent
+}
+
+func (s *recordingSink) Send(_ context.Context, e *audit.AuditEvent) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *recordingSink) Close() error {
+	return nil
+}
+
+var _ = Describe("internal/auditforwarder", func() {
+	ctx := context.Background()
+	auditEvent := &audit.AuditEvent{
+		Timestamp: timestamppb.New(time.Date(2022, 1, 2, 3, 4, 5, 6000, time.UTC)),
+		EventType: "TenantCreatedV2",
+		Actor:     &audit.Actor{Id: "1", Name: "admin", Email: "admin@monoskope.io"},
+		Target:    &audit.Target{AggregateType: "Tenant", AggregateId: "2"},
+		Details:   `“admin@monoskope.io“ created tenant "x]"`,
+	}
+	expectedSyslogMessage := `<109>1 2022-01-02T03:04:05.000006Z test-host monoskope 42 TenantCreatedV2 [audit@32473 eventType="TenantCreatedV2" actorId="1" actorEmail="admin@monoskope.io" aggregateType="Tenant" aggregateId="2"] “admin@monoskope.io“ created tenant "x]"`
+
+	Context("Config", func() {
+		It("NewConfigFromFile() creates a new instance with defaults", func() {
+			conf, err := NewConfigFromFile([]byte("syslog:\n  address: localhost:514\n"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.Syslog.Network).To(Equal(DefaultSyslogNetwork))
+			Expect(conf.Syslog.AppName).To(Equal(DefaultSyslogAppName))
+			Expect(conf.Syslog.Hostname).ToNot(BeEmpty())
+
+			conf, err = NewConfigFromFile([]byte("webhook:\n  url: https://siem.example.com\n"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*conf.Webhook.Timeout).To(Equal(DefaultWebhookTimeout))
+		})
+		It("NewConfigFromFile() fails for invalid configs", func() {
+			_, err := NewConfigFromFile([]byte(""))
+			Expect(err).To(MatchError(ErrExactlyOneSinkRequired))
+			_, err = NewConfigFromFile([]byte("syslog:\n  address: localhost:514\nwebhook:\n  url: https://siem.example.com\n"))
+			Expect(err).To(MatchError(ErrExactlyOneSinkRequired))
+			_, err = NewConfigFromFile([]byte("syslog:\n  network: unix\n  address: /dev/log\n"))
+			Expect(err).To(MatchError(ErrSyslogNetworkNotSupported))
+			_, err = NewConfigFromFile([]byte("webhook:\n  timeout: 1s\n"))
+			Expect(err).To(MatchError(ErrWebhookURLRequired))
+		})
+	})
+
+	Context("syslog", func() {
+		newSink := func(network, address string) Sink {
+			sink := NewSyslogSink(&SyslogConfig{Network: network, Address: address, AppName: DefaultSyslogAppName, Hostname: "test-host"})
+			sink.(*syslogSink).procId = "42"
+			return sink
+		}
+
+		It("sends RFC 5424 messages via udp", func() {
+			conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			defer conn.Close()
+
+			sink := newSink("udp", conn.LocalAddr().String())
+			defer sink.Close()
+			Expect(sink.Send(ctx, auditEvent)).To(Succeed())
+
+			buf := make([]byte, 4096)
+			Expect(conn.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+			n, _, err := conn.ReadFrom(buf)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(buf[:n])).To(Equal(expectedSyslogMessage))
+		})
+
+		It("sends octet counted RFC 5424 messages via tcp", func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+
+			sink := newSink("tcp", listener.Addr().String())
+			defer sink.Close()
+			Expect(sink.Send(ctx, auditEvent)).To(Succeed())
+
+			conn, err := listener.Accept()
+			Expect(err).ToNot(HaveOccurred())
+			defer conn.Close()
+			Expect(conn.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+
+			reader := bufio.NewReader(conn)
+			length, err := reader.ReadString(' ')
+			Expect(err).ToNot(HaveOccurred())
+			n, err := strconv.Atoi(strings.TrimSpace(length))
+			Expect(err).ToNot(HaveOccurred())
+			msg := make([]byte, n)
+			_, err = io.ReadFull(reader, msg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(msg)).To(Equal(expectedSyslogMessage))
+		})
+	})
+
+	Context("webhook", func() {
+		It("posts audit events as json", func() {
+			Expect(os.Setenv("AUDIT_WEBHOOK_TOKEN", "secret")).To(Succeed())
+			defer os.Unsetenv("AUDIT_WEBHOOK_TOKEN")
+
+			var received map[string]interface{}
+			var authorization string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization = r.Header.Get("Authorization")
+				_ = json.NewDecoder(r.Body).Decode(&received)
+			}))
+			defer server.Close()
+
+			timeout := time.Second
+			sink := NewWebhookSink(&WebhookConfig{URL: server.URL, Headers: map[string]string{"Authorization": "Bearer ${AUDIT_WEBHOOK_TOKEN}"}, Timeout: &timeout})
+			defer sink.Close()
+			Expect(sink.Send(ctx, auditEvent)).To(Succeed())
+			Expect(authorization).To(Equal("Bearer secret"))
+			Expect(received["eventType"]).To(Equal("TenantCreatedV2"))
+			Expect(received["details"]).To(Equal(auditEvent.Details))
+		})
+
+		It("fails on unsuccessful responses", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer server.Close()
+
+			timeout := time.Second
+			sink := NewWebhookSink(&WebhookConfig{URL: server.URL, Timeout: &timeout})
+			defer sink.Close()
+			Expect(sink.Send(ctx, auditEvent)).To(MatchError(ContainSubstring(ErrWebhookRequestUnsuccessful.Error())))
+		})
+	})
+
+	Context("AuditForwarder", func() {
+		It("forwards formatted events to the sink", func() {
+			mdManager, err := metadata.NewDomainMetadataManager(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			mdManager.SetUserInformation(&metadata.UserInformation{
+				Id:    mock.TestAdminUser.ID(),
+				Name:  mock.TestAdminUser.Name,
+				Email: mock.TestAdminUser.Email,
+			})
+			eventCtx := mdManager.GetContext()
+
+			sink := &recordingSink{}
+			forwarder := NewAuditForwarder(nil, event.DefaultEventFormatterRegistry, sink)
+			tenantId := uuid.New()
+			ed := es.ToEventDataFromProto(&eventdata.TenantCreatedV2{Name: "tenant", Prefix: "t"})
+			Expect(forwarder.HandleEvent(eventCtx, es.NewEvent(eventCtx, events.TenantCreatedV2, ed, time.Now().UTC(), aggregates.Tenant, tenantId, 1))).To(Succeed())
+
+			Expect(sink.events).To(HaveLen(1))
+			Expect(sink.events[0].Actor.Email).To(Equal(mock.TestAdminUser.Email))
+			Expect(sink.events[0].Target.AggregateId).To(Equal(tenantId.String()))
+			Expect(sink.events[0].Details).To(ContainSubstring("tenant"))
+		})
+	})
+})
@@ -12,29 +12,16 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package repositories
+package auditforwarder
 
-import "github.com/google/uuid"
+import (
+	"testing"
 
-type testProjection struct {
-	id      uuid.UUID
-	version uint64
-}
-
-func newTestProjection(id uuid.UUID) *testProjection {
-	return &testProjection{
-		id: id,
-	}
-}
-
-func (t *testProjection) ID() uuid.UUID {
-	return t.id
-}
-
-func (t *testProjection) Version() uint64 {
-	return t.version
-}
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
 
-func (t *testProjection) IncrementVersion() {
-	t.version++
+func TestAuditForwarder(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "internal/auditforwarder")
 }
@@ -0,0 +1,138 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditforwarder
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/audit"
+)
+
+const (
+	// syslogPriority is facility "log audit" (13) with severity "notice" (5)
+	syslogPriority = 13*8 + 5
+	// syslogTimestampFormat is RFC 3339 limited to microseconds as required by RFC 5424
+	syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+	// syslogStructuredDataId is the SD-ID of the audit parameters
+	syslogStructuredDataId = "audit@32473"
+	syslogNilValue         = "-"
+)
+
+// syslogSink sends audit events as RFC 5424 messages to a syslog receiver
+type syslogSink struct {
+	conf   *SyslogConfig
+	procId string
+	mutex  sync.Mutex
+	conn   net.Conn
+}
+
+// NewSyslogSink creates a Sink sending to the configured syslog receiver
+func NewSyslogSink(conf *SyslogConfig) Sink {
+	return &syslogSink{
+		conf:   conf,
+		procId: fmt.Sprint(os.Getpid()),
+	}
+}
+
+// Send formats and sends the given audit event. The connection is re-established if sending fails.
+func (s *syslogSink) Send(ctx context.Context, e *audit.AuditEvent) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	msg := s.format(e)
+	if s.conf.Network == "tcp" {
+		// octet counting framing, see RFC 6587
+		msg = fmt.Sprintf("%d %s", len(msg), msg)
+	}
+
+	if s.conn == nil {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, s.conf.Network, s.conf.Address)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+
+	if _, err := s.conn.Write([]byte(msg)); err != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+		return err
+	}
+	return nil
+}
+
+// Close closes the connection to the syslog receiver
+func (s *syslogSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// format creates the RFC 5424 message of the given audit event
+func (s *syslogSink) format(e *audit.AuditEvent) string {
+	structuredData := fmt.Sprintf("[%s eventType=\"%s\" actorId=\"%s\" actorEmail=\"%s\" aggregateType=\"%s\" aggregateId=\"%s\"]",
+		syslogStructuredDataId,
+		escapeParamValue(e.GetEventType()),
+		escapeParamValue(e.GetActor().GetId()),
+		escapeParamValue(e.GetActor().GetEmail()),
+		escapeParamValue(e.GetTarget().GetAggregateType()),
+		escapeParamValue(e.GetTarget().GetAggregateId()),
+	)
+
+	return fmt.Sprintf("<%d>1 %s %s %s %s %s %s %s",
+		syslogPriority,
+		e.GetTimestamp().AsTime().Format(syslogTimestampFormat),
+		headerField(s.conf.Hostname, 255),
+		headerField(s.conf.AppName, 48),
+		headerField(s.procId, 128),
+		headerField(e.GetEventType(), 32),
+		structuredData,
+		e.GetDetails(),
+	)
+}
+
+// headerField returns the value as valid header field of the given max length or the nil value if empty
+func headerField(value string, maxLen int) string {
+	value = strings.Map(func(r rune) rune {
+		if r <= 32 || r > 126 {
+			return -1
+		}
+		return r
+	}, value)
+	if value == "" {
+		return syslogNilValue
+	}
+	if len(value) > maxLen {
+		return value[:maxLen]
+	}
+	return value
+}
+
+// escapeParamValue escapes the characters which are not allowed unescaped in structured data param values
+func escapeParamValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditforwarder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/audit"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// webhookSink posts audit events as JSON to an HTTP endpoint
+type webhookSink struct {
+	conf   *WebhookConfig
+	client *http.Client
+}
+
+// NewWebhookSink creates a Sink posting to the configured webhook
+func NewWebhookSink(conf *WebhookConfig) Sink {
+	return &webhookSink{
+		conf:   conf,
+		client: &http.Client{Timeout: *conf.Timeout},
+	}
+}
+
+// Send posts the given audit event and fails if the webhook doesn't respond with a 2xx status
+func (s *webhookSink) Send(ctx context.Context, e *audit.AuditEvent) error {
+	body, err := protojson.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.conf.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.conf.Headers {
+		req.Header.Set(k, os.ExpandEnv(v))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%w: %s", ErrWebhookRequestUnsuccessful, resp.Status)
+	}
+	return nil
+}
+
+// Close releases idle connections
+func (s *webhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
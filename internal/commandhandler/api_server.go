@@ -16,11 +16,15 @@ package commandhandler
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
+	"time"
 
 	api_domain "github.com/finleap-connect/monoskope/pkg/api/domain"
 	api "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/api/eventsourcing/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/aggregates"
+	aggregateTypes "github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
 	"github.com/finleap-connect/monoskope/pkg/domain/errors"
@@ -29,26 +33,61 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/logger"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // apiServer is the implementation of the CommandHandler API
 type apiServer struct {
 	api.UnimplementedCommandHandlerServer
 	api_domain.UnimplementedCommandHandlerExtensionsServer
-	cmdRegistry evs.CommandRegistry
-	log         logger.Logger
+	cmdRegistry    evs.CommandRegistry
+	aggregateStore evs.AggregateStore
+	idempotency    *idempotencyCache
+	log            logger.Logger
 }
 
 // NewApiServer returns a new configured instance of apiServer
-func NewApiServer(cmdRegistry evs.CommandRegistry) *apiServer {
+func NewApiServer(cmdRegistry evs.CommandRegistry, aggregateStore evs.AggregateStore) *apiServer {
 	return &apiServer{
-		cmdRegistry: cmdRegistry,
-		log:         logger.WithName("commandhandler-api-server"),
+		cmdRegistry:    cmdRegistry,
+		aggregateStore: aggregateStore,
+		idempotency:    newIdempotencyCache(DefaultIdempotencyWindow),
+		log:            logger.WithName("commandhandler-api-server"),
 	}
 }
 
+// WithIdempotencyWindow sets how long replies of commands are remembered for their idempotency key. Zero disables idempotency keys.
+func (s *apiServer) WithIdempotencyWindow(window time.Duration) *apiServer {
+	if window <= 0 {
+		s.idempotency = nil
+	} else {
+		s.idempotency = newIdempotencyCache(window)
+	}
+	return s
+}
+
 // Execute implements the API method Execute
 func (s *apiServer) Execute(ctx context.Context, command *commands.Command) (*api.CommandReply, error) {
+	key := metadata.IdempotencyKeyFromIncomingContext(ctx)
+	if key == "" || s.idempotency == nil {
+		return s.execute(ctx, command)
+	}
+
+	m, err := metadata.NewDomainMetadataManager(ctx)
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+
+	// Keys are scoped to the user so that users can not retrieve replies of others
+	userKey := m.GetUserInformation().Id.String() + "/" + key
+	return s.idempotency.Execute(ctx, userKey, command, func(ctx context.Context) (*api.CommandReply, error) {
+		return s.execute(ctx, command)
+	})
+}
+
+// createCommand creates the command from its api representation.
+func (s *apiServer) createCommand(command *commands.Command) (evs.Command, error) {
 	id, err := uuid.Parse(command.GetId())
 	if err != nil {
 		return nil, errors.ErrInvalidArgument(fmt.Sprintf("Failed to parse id of command: %s", err.Error()))
@@ -58,6 +97,15 @@ func (s *apiServer) Execute(ctx context.Context, command *commands.Command) (*ap
 	if err != nil {
 		return nil, errors.TranslateToGrpcError(err)
 	}
+	return cmd, nil
+}
+
+// execute creates and handles the command.
+func (s *apiServer) execute(ctx context.Context, command *commands.Command) (*api.CommandReply, error) {
+	cmd, err := s.createCommand(command)
+	if err != nil {
+		return nil, err
+	}
 
 	m, err := metadata.NewDomainMetadataManager(ctx)
 	if err != nil {
@@ -75,12 +123,106 @@ func (s *apiServer) Execute(ctx context.Context, command *commands.Command) (*ap
 	}, nil
 }
 
+// Validate implements the API method Validate
+func (s *apiServer) Validate(ctx context.Context, command *commands.Command) (*api.ValidationReply, error) {
+	cmd, err := s.createCommand(command)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := metadata.NewDomainMetadataManager(ctx)
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+
+	result, events, err := s.cmdRegistry.ValidateCommand(m.GetContext(), cmd)
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+
+	reply := &api.ValidationReply{
+		AggregateId: result.Id.String(),
+		Version:     result.Version,
+	}
+	for _, event := range events {
+		reply.Events = append(reply.Events, evs.NewProtoFromEvent(event))
+	}
+	return reply, nil
+}
+
+// ExecuteBatch implements the API method ExecuteBatch
+func (s *apiServer) ExecuteBatch(ctx context.Context, batch *api.CommandBatch) (*api.CommandBatchReply, error) {
+	results := make([]*api.CommandResult, len(batch.GetCommands()))
+	for i := range results {
+		results[i] = new(api.CommandResult)
+	}
+
+	cmds := make([]evs.Command, 0, len(batch.GetCommands()))
+	for i, command := range batch.GetCommands() {
+		cmd, err := s.createCommand(command)
+		if err != nil {
+			return newFailedCommandBatchReply(results, i, err), nil
+		}
+		cmds = append(cmds, cmd)
+	}
+
+	m, err := metadata.NewDomainMetadataManager(ctx)
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+
+	replies, err := s.cmdRegistry.HandleCommands(m.GetContext(), cmds, batch.GetDryRun())
+	if err != nil {
+		var batchErr *evs.CommandBatchError
+		if goerrors.As(err, &batchErr) {
+			return newFailedCommandBatchReply(results, batchErr.Index, batchErr.Err), nil
+		}
+		return nil, errors.TranslateToGrpcError(err)
+	}
+
+	for i, reply := range replies {
+		results[i].AggregateId = reply.Id.String()
+		results[i].Version = reply.Version
+	}
+	return &api.CommandBatchReply{
+		Results:   results,
+		Committed: !batch.GetDryRun(),
+	}, nil
+}
+
+// newFailedCommandBatchReply creates the reply of a batch in which the command at the given index failed.
+// The commands following the failed one are reported as aborted.
+func newFailedCommandBatchReply(results []*api.CommandResult, index int, err error) *api.CommandBatchReply {
+	st, ok := status.FromError(err)
+	if !ok {
+		st = status.Convert(errors.TranslateToGrpcError(err))
+	}
+	results[index].Error = st.Proto()
+	for _, result := range results[index+1:] {
+		result.Error = status.New(codes.Aborted, "command has not been executed since a previous command of the batch failed").Proto()
+	}
+	return &api.CommandBatchReply{
+		Results: results,
+	}
+}
+
 // GetPermissionModel implements API method GetPermissionModel
 func (s *apiServer) GetPermissionModel(ctx context.Context, in *empty.Empty) (*api_domain.PermissionModel, error) {
 	permissionModel := &api_domain.PermissionModel{}
 	for _, role := range roles.AvailableRoles {
 		permissionModel.Roles = append(permissionModel.Roles, string(role))
 	}
+
+	// Roles defined in addition to the built-in ones
+	definedRoles, err := s.aggregateStore.All(ctx, aggregateTypes.Role)
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+	for _, aggregate := range definedRoles {
+		if role, ok := aggregate.(*aggregates.RoleAggregate); ok && !role.Deleted() {
+			permissionModel.Roles = append(permissionModel.Roles, role.Name())
+		}
+	}
 	for _, scope := range scopes.AvailableScopes {
 		permissionModel.Scopes = append(permissionModel.Scopes, string(scope))
 	}
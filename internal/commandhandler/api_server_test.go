@@ -0,0 +1,182 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commandhandler
+
+import (
+	"context"
+	"time"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	api "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/api/eventsourcing/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/aggregates"
+	domainCommands "github.com/finleap-connect/monoskope/pkg/domain/commands"
+	aggregateTypes "github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	commandTypes "github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	evs "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// testHandler handles and validates commands and fails for the commands of a specific aggregate.
+type testHandler struct {
+	failingId uuid.UUID
+	dryRun    bool
+}
+
+func (h *testHandler) HandleCommand(ctx context.Context, cmd evs.Command) (*evs.CommandReply, error) {
+	return &evs.CommandReply{Id: cmd.AggregateID(), Version: 1}, nil
+}
+
+func (h *testHandler) ValidateCommand(ctx context.Context, cmd evs.Command) (*evs.CommandReply, []evs.Event, error) {
+	if cmd.AggregateID() == h.failingId {
+		return nil, nil, errors.ErrTenantAlreadyExists
+	}
+	event := evs.NewEvent(ctx, events.TenantCreated, nil, time.Now().UTC(), cmd.AggregateType(), cmd.AggregateID(), 1)
+	return &evs.CommandReply{Id: cmd.AggregateID(), Version: 1}, []evs.Event{event}, nil
+}
+
+func (h *testHandler) HandleCommands(ctx context.Context, cmds []evs.Command, dryRun bool) ([]*evs.CommandReply, error) {
+	h.dryRun = dryRun
+	var replies []*evs.CommandReply
+	for i, cmd := range cmds {
+		if cmd.AggregateID() == h.failingId {
+			return nil, &evs.CommandBatchError{Index: i, Err: errors.ErrTenantAlreadyExists}
+		}
+		replies = append(replies, &evs.CommandReply{Id: cmd.AggregateID(), Version: 1})
+	}
+	return replies, nil
+}
+
+// testAggregateStore returns the given aggregates of all types.
+type testAggregateStore struct {
+	evs.AggregateStore
+	aggregates []evs.Aggregate
+}
+
+func (s *testAggregateStore) All(ctx context.Context, aggregateType evs.AggregateType) ([]evs.Aggregate, error) {
+	return s.aggregates, nil
+}
+
+// newTestRole creates a role aggregate from its events.
+func newTestRole(ctx context.Context, name string, deleted bool) evs.Aggregate {
+	role := aggregates.NewRoleAggregate(nil)
+	data := evs.ToEventDataFromProto(&eventdata.RoleCreated{Name: name})
+	Expect(role.ApplyEvent(evs.NewEvent(ctx, events.RoleCreated, data, time.Now().UTC(), aggregateTypes.Role, uuid.New(), 1))).To(Succeed())
+	if deleted {
+		Expect(role.ApplyEvent(evs.NewEvent(ctx, events.RoleDeleted, nil, time.Now().UTC(), aggregateTypes.Role, uuid.New(), 2))).To(Succeed())
+	}
+	return role
+}
+
+var _ = Describe("internal/commandhandler/apiServer", func() {
+	ctx := context.Background()
+
+	var (
+		handler *testHandler
+		store   *testAggregateStore
+		server  *apiServer
+	)
+
+	newCommand := func(id uuid.UUID) *commands.Command {
+		return &commands.Command{Id: id.String(), Type: commandTypes.CreateTenant.String()}
+	}
+
+	BeforeEach(func() {
+		handler = &testHandler{failingId: uuid.New()}
+		registry := evs.NewCommandRegistry()
+		registry.RegisterCommand(domainCommands.NewCreateTenantCommand)
+		registry.SetHandler(handler, commandTypes.CreateTenant)
+		store = &testAggregateStore{}
+		server = NewApiServer(registry, store)
+	})
+
+	It("validates commands", func() {
+		id := uuid.New()
+		reply, err := server.Validate(ctx, newCommand(id))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reply.AggregateId).To(Equal(id.String()))
+		Expect(reply.Version).To(Equal(uint64(1)))
+		Expect(reply.Events).To(HaveLen(1))
+		Expect(reply.Events[0].Type).To(Equal(events.TenantCreated.String()))
+	})
+	It("returns the error of invalid commands", func() {
+		_, err := server.Validate(ctx, newCommand(handler.failingId))
+		Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+	})
+	It("executes all commands of a batch", func() {
+		ids := []uuid.UUID{uuid.New(), uuid.New()}
+		reply, err := server.ExecuteBatch(ctx, &api.CommandBatch{
+			Commands: []*commands.Command{newCommand(ids[0]), newCommand(ids[1])},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reply.Committed).To(BeTrue())
+		Expect(reply.Results).To(HaveLen(2))
+		for i, result := range reply.Results {
+			Expect(result.Error).To(BeNil())
+			Expect(result.AggregateId).To(Equal(ids[i].String()))
+			Expect(result.Version).To(Equal(uint64(1)))
+		}
+		Expect(handler.dryRun).To(BeFalse())
+	})
+	It("does not commit dry runs", func() {
+		reply, err := server.ExecuteBatch(ctx, &api.CommandBatch{
+			Commands: []*commands.Command{newCommand(uuid.New())},
+			DryRun:   true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reply.Committed).To(BeFalse())
+		Expect(reply.Results[0].Error).To(BeNil())
+		Expect(handler.dryRun).To(BeTrue())
+	})
+	It("reports the failed command and aborts the following ones", func() {
+		reply, err := server.ExecuteBatch(ctx, &api.CommandBatch{
+			Commands: []*commands.Command{newCommand(uuid.New()), newCommand(handler.failingId), newCommand(uuid.New())},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reply.Committed).To(BeFalse())
+		Expect(reply.Results).To(HaveLen(3))
+		Expect(reply.Results[0].Error).To(BeNil())
+		Expect(status.FromProto(reply.Results[1].Error).Code()).To(Equal(codes.AlreadyExists))
+		Expect(status.FromProto(reply.Results[2].Error).Code()).To(Equal(codes.Aborted))
+	})
+	It("reports commands which can not be created", func() {
+		invalid := newCommand(uuid.New())
+		invalid.Id = "invalid"
+		reply, err := server.ExecuteBatch(ctx, &api.CommandBatch{
+			Commands: []*commands.Command{invalid, newCommand(uuid.New())},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reply.Committed).To(BeFalse())
+		Expect(status.FromProto(reply.Results[0].Error).Code()).To(Equal(codes.InvalidArgument))
+		Expect(status.FromProto(reply.Results[1].Error).Code()).To(Equal(codes.Aborted))
+	})
+	It("returns the built-in and defined roles as permission model", func() {
+		store.aggregates = []evs.Aggregate{newTestRole(ctx, "auditor", false), newTestRole(ctx, "viewer", true)}
+
+		permissionModel, err := server.GetPermissionModel(ctx, &emptypb.Empty{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(permissionModel.Roles).To(ContainElements(string(roles.Admin), string(roles.User), "auditor"))
+		Expect(permissionModel.Roles).ToNot(ContainElement("viewer"))
+	})
+})
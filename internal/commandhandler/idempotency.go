@@ -0,0 +1,166 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commandhandler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	api "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/api/eventsourcing/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultIdempotencyWindow is the default time replies are remembered for their idempotency key.
+	DefaultIdempotencyWindow = 10 * time.Minute
+	// maxIdempotencyKeyLength is the maximum length of an idempotency key in bytes.
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotencyEntry is the reply of a command executed with an idempotency key.
+type idempotencyEntry struct {
+	fingerprint []byte
+	expires     time.Time
+	done        chan struct{}
+	reply       *api.CommandReply
+	err         error
+}
+
+// idempotencyExpiry is the time the entry of a key expires at.
+type idempotencyExpiry struct {
+	key     string
+	expires time.Time
+}
+
+// idempotencyCache remembers the replies of commands executed with an idempotency key
+// for a given window, so that retries of a command are not executed twice.
+type idempotencyCache struct {
+	window   time.Duration
+	now      func() time.Time
+	mutex    sync.Mutex
+	entries  map[string]*idempotencyEntry
+	expiries []idempotencyExpiry // expiries of the finished entries, oldest first since the window is the same for all
+}
+
+// newIdempotencyCache creates a cache remembering replies for the given window.
+func newIdempotencyCache(window time.Duration) *idempotencyCache {
+	return &idempotencyCache{
+		window:  window,
+		now:     time.Now,
+		entries: make(map[string]*idempotencyEntry),
+	}
+}
+
+// fingerprint returns a hash identifying the command to detect keys reused for different commands.
+func fingerprint(command *commands.Command) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(command)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
+
+// withoutCancel keeps the values of its parent context but is never canceled, see context.WithoutCancel of go 1.21.
+type withoutCancel struct {
+	context.Context
+}
+
+func (withoutCancel) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (withoutCancel) Done() <-chan struct{}       { return nil }
+func (withoutCancel) Err() error                  { return nil }
+
+// Execute returns the remembered reply for the key or executes the command and remembers its reply.
+// Concurrent retries wait for the first execution to finish. Failed executions are not remembered so they can be retried.
+// The command is executed even if the caller gives up waiting, otherwise a retry could execute a command twice
+// whose events have been stored already.
+func (c *idempotencyCache) Execute(ctx context.Context, key string, command *commands.Command, execute func(context.Context) (*api.CommandReply, error)) (*api.CommandReply, error) {
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, errors.ErrInvalidArgument(fmt.Sprintf("idempotency key must not be longer than %d bytes", maxIdempotencyKeyLength))
+	}
+
+	commandFingerprint, err := fingerprint(command)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	c.removeExpired()
+	entry, exists := c.entries[key]
+	if !exists {
+		entry = &idempotencyEntry{
+			fingerprint: commandFingerprint,
+			done:        make(chan struct{}),
+		}
+		c.entries[key] = entry
+	}
+	c.mutex.Unlock()
+
+	if exists {
+		if !bytes.Equal(entry.fingerprint, commandFingerprint) {
+			return nil, errors.ErrInvalidArgument("idempotency key has already been used for a different command")
+		}
+		select {
+		case <-entry.done:
+			if entry.err != nil {
+				return nil, entry.err
+			}
+			return proto.Clone(entry.reply).(*api.CommandReply), nil
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	entry.reply, entry.err = execute(withoutCancel{ctx})
+
+	c.mutex.Lock()
+	if entry.err != nil {
+		delete(c.entries, key)
+	} else {
+		entry.expires = c.now().Add(c.window)
+		c.expiries = append(c.expiries, idempotencyExpiry{key: key, expires: entry.expires})
+	}
+	c.mutex.Unlock()
+	close(entry.done)
+
+	if entry.err != nil {
+		return nil, entry.err
+	}
+	return proto.Clone(entry.reply).(*api.CommandReply), nil
+}
+
+// removeExpired removes all entries whose window has passed. The mutex must be held.
+func (c *idempotencyCache) removeExpired() {
+	now := c.now()
+	expired := 0
+	for _, expiry := range c.expiries {
+		if !now.After(expiry.expires) {
+			break
+		}
+		// The key might have been used again after its entry expired
+		if entry, ok := c.entries[expiry.key]; ok && entry.expires.Equal(expiry.expires) {
+			delete(c.entries, expiry.key)
+		}
+		expired++
+	}
+	c.expiries = c.expiries[expired:]
+}
@@ -0,0 +1,178 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commandhandler
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/api/eventsourcing/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("internal/commandhandler/idempotencyCache", func() {
+	ctx := context.Background()
+
+	var (
+		cache    *idempotencyCache
+		now      time.Time
+		command  *commands.Command
+		executed int
+		execute  func(context.Context) (*api.CommandReply, error)
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		cache = newIdempotencyCache(time.Minute)
+		cache.now = func() time.Time { return now }
+		command = &commands.Command{Id: uuid.Nil.String(), Type: "CreateTenant"}
+		executed = 0
+		execute = func(context.Context) (*api.CommandReply, error) {
+			executed++
+			return &api.CommandReply{AggregateId: uuid.New().String(), Version: 1}, nil
+		}
+	})
+
+	It("returns the remembered reply for retries", func() {
+		first, err := cache.Execute(ctx, "key", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := cache.Execute(ctx, "key", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second.AggregateId).To(Equal(first.AggregateId))
+		Expect(executed).To(Equal(1))
+	})
+	It("executes commands with different keys", func() {
+		first, err := cache.Execute(ctx, "key-a", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := cache.Execute(ctx, "key-b", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second.AggregateId).ToNot(Equal(first.AggregateId))
+		Expect(executed).To(Equal(2))
+	})
+	It("forgets replies after the window", func() {
+		_, err := cache.Execute(ctx, "key", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		now = now.Add(2 * time.Minute)
+		_, err = cache.Execute(ctx, "key", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(executed).To(Equal(2))
+	})
+	It("evicts expired replies of all keys", func() {
+		for _, key := range []string{"key-a", "key-b", "key-c"} {
+			_, err := cache.Execute(ctx, key, command, execute)
+			Expect(err).ToNot(HaveOccurred())
+			now = now.Add(30 * time.Second)
+		}
+		Expect(cache.entries).To(HaveLen(3))
+
+		// key-a and key-b have expired by now
+		now = now.Add(time.Second)
+		_, err := cache.Execute(ctx, "key-d", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cache.entries).To(HaveLen(2))
+		Expect(cache.entries).To(HaveKey("key-c"))
+		Expect(cache.entries).To(HaveKey("key-d"))
+		Expect(cache.expiries).To(HaveLen(2))
+	})
+	It("keeps replies of keys used again after they expired", func() {
+		_, err := cache.Execute(ctx, "key", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		now = now.Add(2 * time.Minute)
+		_, err = cache.Execute(ctx, "key", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+
+		now = now.Add(30 * time.Second)
+		_, err = cache.Execute(ctx, "key", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(executed).To(Equal(2))
+	})
+	It("does not remember failed executions", func() {
+		_, err := cache.Execute(ctx, "key", command, func(context.Context) (*api.CommandReply, error) {
+			return nil, errors.TranslateToGrpcError(errors.ErrUnauthorized)
+		})
+		Expect(err).To(HaveOccurred())
+		_, err = cache.Execute(ctx, "key", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(executed).To(Equal(1))
+	})
+	It("remembers executions the caller stopped waiting for", func() {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		first, err := cache.Execute(cancelCtx, "key", command, func(ctx context.Context) (*api.CommandReply, error) {
+			// The client times out while the events are stored
+			cancel()
+			if err := ctx.Err(); err != nil {
+				return nil, status.FromContextError(err).Err()
+			}
+			return execute(ctx)
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cancelCtx.Err()).To(HaveOccurred())
+
+		second, err := cache.Execute(ctx, "key", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second.AggregateId).To(Equal(first.AggregateId))
+		Expect(executed).To(Equal(1))
+	})
+	It("rejects keys reused for a different command", func() {
+		_, err := cache.Execute(ctx, "key", command, execute)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = cache.Execute(ctx, "key", &commands.Command{Id: uuid.Nil.String(), Type: "CreateCluster"}, execute)
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		Expect(executed).To(Equal(1))
+	})
+	It("rejects too long keys", func() {
+		_, err := cache.Execute(ctx, strings.Repeat("k", maxIdempotencyKeyLength+1), command, execute)
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		Expect(executed).To(BeZero())
+	})
+	It("executes concurrent retries once", func() {
+		release := make(chan struct{})
+		var mutex sync.Mutex
+		blockingExecute := func(ctx context.Context) (*api.CommandReply, error) {
+			<-release
+			mutex.Lock()
+			defer mutex.Unlock()
+			return execute(ctx)
+		}
+
+		var wg sync.WaitGroup
+		replies := make([]*api.CommandReply, 3)
+		for i := range replies {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				reply, err := cache.Execute(ctx, "key", command, blockingExecute)
+				Expect(err).ToNot(HaveOccurred())
+				replies[i] = reply
+			}(i)
+		}
+		close(release)
+		wg.Wait()
+
+		Expect(executed).To(Equal(1))
+		Expect(replies[1].AggregateId).To(Equal(replies[0].AggregateId))
+		Expect(replies[2].AggregateId).To(Equal(replies[0].AggregateId))
+	})
+})
@@ -55,7 +55,7 @@ func NewTestEnv(eventStoreTestEnv *eventstore.TestEnv, gatewayTestEnv *gateway.T
 		gatewayTestEnv:    gatewayTestEnv,
 	}
 
-	env.esConn, env.esClient, err = eventstore.NewEventStoreClient(ctx, env.eventStoreTestEnv.GetApiAddr())
+	env.esConn, env.esClient, err = eventstore.NewEventStoreClient(ctx, env.eventStoreTestEnv.GetApiAddr(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +69,7 @@ func NewTestEnv(eventStoreTestEnv *eventstore.TestEnv, gatewayTestEnv *gateway.T
 
 	os.Setenv("CREATE_MOCKS", "true")
 
-	err = domain.SetupCommandHandlerDomain(ctx, env.esClient)
+	aggregateStore, err := domain.SetupCommandHandlerDomain(ctx, env.esClient)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +83,7 @@ func NewTestEnv(eventStoreTestEnv *eventstore.TestEnv, gatewayTestEnv *gateway.T
 		},
 	)
 
-	commandHandler := NewApiServer(es.DefaultCommandRegistry)
+	commandHandler := NewApiServer(es.DefaultCommandRegistry, aggregateStore)
 	env.grpcServer.RegisterService(func(s ggrpc.ServiceRegistrar) {
 		esApi.RegisterCommandHandlerServer(s, commandHandler)
 	})
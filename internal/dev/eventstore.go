@@ -0,0 +1,66 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dev
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/finleap-connect/monoskope/internal/eventstore"
+	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/grpc"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufferSize = 1024 * 1024
+
+// InProcessEventStore serves the EventStore API to clients within the same process without a network listener.
+type InProcessEventStore struct {
+	listener   *bufconn.Listener
+	grpcServer *grpc.Server
+}
+
+// NewInProcessEventStore creates the EventStore API server for the given store publishing stored events to the bus.
+func NewInProcessEventStore(store es.EventStore, publisher es.EventBusPublisher) *InProcessEventStore {
+	s := &InProcessEventStore{
+		listener:   bufconn.Listen(bufferSize),
+		grpcServer: grpc.NewServer("eventstore-grpc", false),
+	}
+	s.grpcServer.RegisterService(func(r ggrpc.ServiceRegistrar) {
+		esApi.RegisterEventStoreServer(r, eventstore.NewApiServer(store, publisher, es.DefaultEventSchemaRegistry))
+	})
+	return s
+}
+
+// Serve serves the API until the process receives a shutdown signal.
+func (s *InProcessEventStore) Serve() error {
+	return s.grpcServer.ServeFromListener(s.listener, nil)
+}
+
+// NewClient connects a new client to the API.
+func (s *InProcessEventStore) NewClient(ctx context.Context) (*ggrpc.ClientConn, esApi.EventStoreClient, error) {
+	conn, err := grpc.NewGrpcConnectionFactoryWithInsecure("bufnet").
+		WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return s.listener.DialContext(ctx)
+		}).
+		ConnectWithTimeout(ctx, 10*time.Second)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, esApi.NewEventStoreClient(conn), nil
+}
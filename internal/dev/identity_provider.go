@@ -0,0 +1,254 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dev
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	mjwt "github.com/finleap-connect/monoskope/pkg/jwt"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	idTokenValidity  = time.Hour
+	authCodeValidity = time.Minute
+)
+
+// authCode is an authorization code issued by the StubIdentityProvider which has not been exchanged yet.
+type authCode struct {
+	clientId string
+	email    string
+	nonce    string
+	expiry   time.Time
+}
+
+// idTokenClaims are the claims of the ID tokens issued by the StubIdentityProvider.
+type idTokenClaims struct {
+	*jwt.Claims
+	*mjwt.StandardClaims
+	Nonce string `json:"nonce,omitempty"`
+}
+
+// StubIdentityProvider is an OIDC identity provider for development which
+// signs in every user without asking for credentials.
+// The user can be chosen by passing its email as login_hint, by default the configured user is signed in.
+type StubIdentityProvider struct {
+	log          logger.Logger
+	issuer       string
+	defaultEmail string
+	signer       jose.Signer
+	keys         *jose.JSONWebKeySet
+	mutex        sync.Mutex
+	codes        map[string]*authCode
+}
+
+// NewStubIdentityProvider creates a StubIdentityProvider issuing tokens as the given issuer URL
+// which signs in the user with the given email by default.
+func NewStubIdentityProvider(issuer, defaultEmail string) (*StubIdentityProvider, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	keyId, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: mjwt.SignatureAlgorithm, Key: privateKey},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", keyId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StubIdentityProvider{
+		log:          logger.WithName("stub-idp"),
+		issuer:       issuer,
+		defaultEmail: defaultEmail,
+		signer:       signer,
+		keys: &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key:       privateKey.Public(),
+			KeyID:     keyId,
+			Algorithm: string(mjwt.SignatureAlgorithm),
+			Use:       "sig",
+		}}},
+		codes: make(map[string]*authCode),
+	}, nil
+}
+
+// Issuer returns the issuer URL of the identity provider.
+func (p *StubIdentityProvider) Issuer() string {
+	return p.issuer
+}
+
+// Handler returns the http.Handler serving the OIDC endpoints.
+func (p *StubIdentityProvider) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/keys", p.jwks)
+	mux.HandleFunc("/auth", p.authorize)
+	mux.HandleFunc("/token", p.token)
+	return mux
+}
+
+// discovery serves the provider metadata, see https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+func (p *StubIdentityProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                p.issuer + "/auth",
+		"token_endpoint":                        p.issuer + "/token",
+		"jwks_uri":                              p.issuer + "/keys",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{string(mjwt.SignatureAlgorithm)},
+		"scopes_supported":                      []string{"openid", "profile", "email", "offline_access"},
+	})
+}
+
+// jwks serves the public key ID tokens are signed with
+func (p *StubIdentityProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, p.keys)
+}
+
+// authorize signs the user in immediately and redirects back to the client with an authorization code
+func (p *StubIdentityProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || redirectURI.String() == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	email := query.Get("login_hint")
+	if email == "" {
+		email = p.defaultEmail
+	}
+
+	code, err := randomString()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	p.mutex.Lock()
+	p.codes[code] = &authCode{
+		clientId: query.Get("client_id"),
+		email:    email,
+		nonce:    query.Get("nonce"),
+		expiry:   time.Now().Add(authCodeValidity),
+	}
+	p.mutex.Unlock()
+	p.log.Info("Signed in user.", "email", email, "clientId", query.Get("client_id"))
+
+	redirectQuery := redirectURI.Query()
+	redirectQuery.Set("code", code)
+	redirectQuery.Set("state", query.Get("state"))
+	redirectURI.RawQuery = redirectQuery.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+// token exchanges an authorization code for a signed ID token
+func (p *StubIdentityProvider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeTokenError(w, "invalid_request")
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		writeTokenError(w, "unsupported_grant_type")
+		return
+	}
+
+	p.mutex.Lock()
+	code, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mutex.Unlock()
+	if !ok || time.Now().After(code.expiry) {
+		writeTokenError(w, "invalid_grant")
+		return
+	}
+
+	now := time.Now().UTC()
+	rawIDToken, err := jwt.Signed(p.signer).Claims(&idTokenClaims{
+		Claims: &jwt.Claims{
+			Issuer:   p.issuer,
+			Subject:  code.email,
+			Audience: jwt.Audience{code.clientId},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(idTokenValidity)),
+		},
+		StandardClaims: &mjwt.StandardClaims{
+			Name:          nameFromEmail(code.email),
+			Email:         code.email,
+			EmailVerified: true,
+		},
+		Nonce: code.nonce,
+	}).CompactSerialize()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	accessToken, err := randomString()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   int(idTokenValidity.Seconds()),
+		"id_token":     rawIDToken,
+	})
+}
+
+// nameFromEmail uses the local part of the email as name like the SUPER_USERS setup does
+func nameFromEmail(email string) string {
+	for i, c := range email {
+		if c == '@' {
+			return email[:i]
+		}
+	}
+	return email
+}
+
+func writeTokenError(w http.ResponseWriter, code string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.WithName("stub-idp").Error(err, "writing response failed")
+	}
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random string: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
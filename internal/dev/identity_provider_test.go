@@ -0,0 +1,118 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dev
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/coreos/go-oidc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/oauth2"
+)
+
+var _ = Describe("internal/dev/StubIdentityProvider", func() {
+	var (
+		server   *httptest.Server
+		idp      *StubIdentityProvider
+		provider *oidc.Provider
+		config   *oauth2.Config
+		ctx      = context.Background()
+	)
+
+	// authorize follows the auth endpoint and returns the code and state of the redirect
+	authorize := func(options ...oauth2.AuthCodeOption) (string, string) {
+		client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}}
+		res, err := client.Get(config.AuthCodeURL("some-state", options...))
+		Expect(err).NotTo(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusFound))
+
+		location, err := url.Parse(res.Header.Get("Location"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(location.Host).To(Equal("localhost:8000"))
+		return location.Query().Get("code"), location.Query().Get("state")
+	}
+
+	BeforeEach(func() {
+		var err error
+		server = httptest.NewUnstartedServer(nil)
+		idp, err = NewStubIdentityProvider("http://"+server.Listener.Addr().String(), "admin@monoskope.local")
+		Expect(err).NotTo(HaveOccurred())
+		server.Config.Handler = idp.Handler()
+		server.Start()
+
+		provider, err = oidc.NewProvider(ctx, idp.Issuer())
+		Expect(err).NotTo(HaveOccurred())
+		config = &oauth2.Config{
+			ClientID:     "monoskope-dev",
+			ClientSecret: "secret",
+			Endpoint:     provider.Endpoint(),
+			RedirectURL:  "http://localhost:8000",
+			Scopes:       []string{oidc.ScopeOpenID, "profile", "email"},
+		}
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("signs in the default user without credentials", func() {
+		code, state := authorize(oidc.Nonce("some-nonce"))
+		Expect(code).NotTo(BeEmpty())
+		Expect(state).To(Equal("some-state"))
+
+		token, err := config.Exchange(ctx, code)
+		Expect(err).NotTo(HaveOccurred())
+		rawIDToken, ok := token.Extra("id_token").(string)
+		Expect(ok).To(BeTrue())
+
+		idToken, err := provider.Verifier(&oidc.Config{ClientID: config.ClientID}).Verify(ctx, rawIDToken)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(idToken.Nonce).To(Equal("some-nonce"))
+
+		claims := struct {
+			Name          string `json:"name"`
+			Email         string `json:"email"`
+			EmailVerified bool   `json:"email_verified"`
+		}{}
+		Expect(idToken.Claims(&claims)).To(Succeed())
+		Expect(claims.Email).To(Equal("admin@monoskope.local"))
+		Expect(claims.Name).To(Equal("admin"))
+		Expect(claims.EmailVerified).To(BeTrue())
+	})
+	It("signs in the user given as login hint", func() {
+		code, _ := authorize(oauth2.SetAuthURLParam("login_hint", "jane.doe@monoskope.local"))
+
+		token, err := config.Exchange(ctx, code)
+		Expect(err).NotTo(HaveOccurred())
+		idToken, err := provider.Verifier(&oidc.Config{ClientID: config.ClientID}).Verify(ctx, token.Extra("id_token").(string))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(idToken.Subject).To(Equal("jane.doe@monoskope.local"))
+	})
+	It("rejects authorization codes used twice", func() {
+		code, _ := authorize()
+
+		_, err := config.Exchange(ctx, code)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = config.Exchange(ctx, code)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid_grant"))
+	})
+})
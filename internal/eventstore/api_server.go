@@ -15,6 +15,7 @@
 package eventstore
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/finleap-connect/monoskope/internal/eventstore/metrics"
@@ -22,7 +23,11 @@ import (
 	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/domain/errors"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
 	"github.com/finleap-connect/monoskope/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // apiServer is the implementation of the EventStore API
@@ -32,11 +37,12 @@ type apiServer struct {
 	log     logger.Logger
 	store   es.EventStore
 	bus     es.EventBusPublisher
+	schemas es.EventSchemaRegistry
 	metrics *metrics.EventStoreMetrics
 }
 
 // NewApiServer returns a new configured instance of apiServer
-func NewApiServer(store es.EventStore, bus es.EventBusPublisher) *apiServer {
+func NewApiServer(store es.EventStore, bus es.EventBusPublisher, schemas es.EventSchemaRegistry) *apiServer {
 	m, err := metrics.NewEventStoreMetrics()
 	if err != nil {
 		panic(fmt.Errorf("Error setting up metrics server: %w", err))
@@ -46,6 +52,7 @@ func NewApiServer(store es.EventStore, bus es.EventBusPublisher) *apiServer {
 		log:     logger.WithName("server"),
 		store:   store,
 		bus:     bus,
+		schemas: schemas,
 		metrics: m,
 	}
 
@@ -55,7 +62,10 @@ func NewApiServer(store es.EventStore, bus es.EventBusPublisher) *apiServer {
 // Store implements the API method for storing events
 func (s *apiServer) Store(stream esApi.EventStore_StoreServer) error {
 	// Perform the use case for storing events
-	if err := usecases.NewStoreEventsUseCase(stream, s.store, s.bus, s.metrics).Run(stream.Context()); err != nil {
+	if err := usecases.NewStoreEventsUseCase(stream, s.store, s.bus, s.schemas, s.metrics).Run(stream.Context()); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
 		return errors.TranslateToGrpcError(err)
 	}
 	return nil
@@ -78,3 +88,26 @@ func (s *apiServer) RetrieveOr(filters *esApi.EventFilters, stream esApi.EventSt
 	}
 	return nil
 }
+
+// VerifyIntegrity implements the API method for verifying the hash chain of the store
+func (s *apiServer) VerifyIntegrity(ctx context.Context, _ *emptypb.Empty) (*esApi.IntegrityReport, error) {
+	verifier, ok := s.store.(es.IntegrityVerifier)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, esErrors.ErrIntegrityVerificationNotSupported.Error())
+	}
+
+	report, err := verifier.VerifyIntegrity(ctx)
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+	return usecases.NewIntegrityReportProto(report), nil
+}
+
+// GetEventSchemas implements the API method for retrieving the schemas of all known event types
+func (s *apiServer) GetEventSchemas(ctx context.Context, _ *emptypb.Empty) (*esApi.EventSchemas, error) {
+	schemas, err := usecases.NewEventSchemasProto(s.schemas.GetEventSchemas())
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+	return schemas, nil
+}
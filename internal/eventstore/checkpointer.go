@@ -0,0 +1,61 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventstore
+
+import (
+	"context"
+	"time"
+
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+)
+
+// Checkpointer periodically creates signed checkpoints over the hash chains of the event store.
+type Checkpointer struct {
+	log      logger.Logger
+	verifier es.IntegrityVerifier
+	interval time.Duration
+}
+
+// NewCheckpointer creates a new Checkpointer for the given store.
+func NewCheckpointer(store es.EventStore, interval time.Duration) (*Checkpointer, error) {
+	verifier, ok := store.(es.IntegrityVerifier)
+	if !ok {
+		return nil, errors.ErrIntegrityVerificationNotSupported
+	}
+	return &Checkpointer{
+		log:      logger.WithName("checkpointer"),
+		verifier: verifier,
+		interval: interval,
+	}, nil
+}
+
+// Run creates a checkpoint every interval until the context is done.
+func (c *Checkpointer) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.verifier.Checkpoint(ctx); err != nil {
+				c.log.Error(err, "Failed to create checkpoint.")
+			}
+		}
+	}
+}
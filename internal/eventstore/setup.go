@@ -23,10 +23,21 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/eventsourcing/storage"
 	grpcUtil "github.com/finleap-connect/monoskope/pkg/grpc"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	"google.golang.org/grpc"
 )
 
 func NewEventStore() (eventsourcing.EventStore, error) {
+	return newEventStore("")
+}
+
+// NewEventStoreWithCheckpointVerification creates an event store which verifies checkpoints
+// with the PEM encoded ed25519 public key at the given path.
+func NewEventStoreWithCheckpointVerification(publicKeyPath string) (eventsourcing.EventStore, error) {
+	return newEventStore(publicKeyPath)
+}
+
+func newEventStore(publicKeyPath string) (eventsourcing.EventStore, error) {
 	var dbUrl string
 
 	if v := os.Getenv("DB_URL"); v != "" {
@@ -43,6 +54,20 @@ func NewEventStore() (eventsourcing.EventStore, error) {
 		return nil, err
 	}
 
+	// Checkpoints are only signed if a key has been mounted
+	if _, err := os.Stat(storage.CheckpointKeyPath); err == nil {
+		err = conf.ConfigureCheckpointSigning(storage.CheckpointKeyPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if publicKeyPath != "" {
+		err = conf.ConfigureCheckpointVerification(publicKeyPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	store, err := storage.NewPostgresEventStore(conf)
 	if err != nil {
 		return nil, err
@@ -58,9 +83,10 @@ func NewEventStore() (eventsourcing.EventStore, error) {
 	return store, nil
 }
 
-func NewEventStoreClient(ctx context.Context, eventStoreAddr string) (*grpc.ClientConn, esApi.EventStoreClient, error) {
+// NewEventStoreClient connects to the EventStore, with mutual TLS if a TLSConfigLoader is given.
+func NewEventStoreClient(ctx context.Context, eventStoreAddr string, tlsLoader *m8tls.TLSConfigLoader) (*grpc.ClientConn, esApi.EventStoreClient, error) {
 	conn, err := grpcUtil.
-		NewGrpcConnectionFactoryWithInsecure(eventStoreAddr).
+		NewInternalGrpcConnectionFactory(eventStoreAddr, tlsLoader).
 		WithOpenTelemetry().
 		ConnectWithTimeout(ctx, 10*time.Second)
 	if err != nil {
@@ -78,7 +78,7 @@ func NewTestEnvWithParent(testEnv *test.TestEnv) (*TestEnv, error) {
 	// Create server
 	env.grpcServer = grpc.NewServer("eventstore_grpc", false)
 	env.grpcServer.RegisterService(func(s ggrpc.ServiceRegistrar) {
-		api.RegisterEventStoreServer(s, NewApiServer(env.storageTestEnv.Store, env.publisher))
+		api.RegisterEventStoreServer(s, NewApiServer(env.storageTestEnv.Store, env.publisher, es.DefaultEventSchemaRegistry))
 	})
 
 	env.apiListener, err = net.Listen("tcp", "127.0.0.1:0")
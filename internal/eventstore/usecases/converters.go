@@ -19,6 +19,10 @@ import (
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // NewStoreQueryFromProto converts proto esApi.EventFilter to storage.StoreQuery
@@ -55,5 +59,114 @@ func NewStoreQueryFromProto(protoFilter *esApi.EventFilter) (*es.StoreQuery, err
 		storeQuery.MaxTimestamp = &val
 	}
 
+	if protoFilter.GetEventType() != nil {
+		eventType := es.EventType(protoFilter.GetEventType().GetValue())
+		storeQuery.EventType = &eventType
+	}
+	if protoFilter.GetIssuerId() != nil {
+		issuerId, err := uuid.Parse(protoFilter.GetIssuerId().GetValue())
+		if err != nil {
+			return nil, errors.ErrCouldNotParseIssuerId
+		}
+		storeQuery.IssuerId = &issuerId
+	}
+	if protoFilter.GetTenantId() != nil {
+		tenantId, err := uuid.Parse(protoFilter.GetTenantId().GetValue())
+		if err != nil {
+			return nil, errors.ErrCouldNotParseTenantId
+		}
+		storeQuery.TenantId = &tenantId
+	}
+	if protoFilter.GetUserId() != nil {
+		userId, err := uuid.Parse(protoFilter.GetUserId().GetValue())
+		if err != nil {
+			return nil, errors.ErrCouldNotParseUserId
+		}
+		storeQuery.UserId = &userId
+	}
+
 	return storeQuery, nil
 }
+
+// NewIntegrityReportProto converts es.IntegrityReport to proto esApi.IntegrityReport
+func NewIntegrityReportProto(report *es.IntegrityReport) *esApi.IntegrityReport {
+	protoReport := &esApi.IntegrityReport{
+		VerifiedEvents:        report.VerifiedEvents,
+		UnchainedEvents:       report.UnchainedEvents,
+		VerifiedCheckpoints:   report.VerifiedCheckpoints,
+		UnverifiedCheckpoints: report.UnverifiedCheckpoints,
+		BrokenLinks:           report.BrokenLinks,
+	}
+
+	if link := report.FirstBrokenLink; link != nil {
+		protoReport.FirstBrokenLink = &esApi.BrokenLink{
+			AggregateType:    link.AggregateType.String(),
+			AggregateVersion: link.AggregateVersion,
+			Timestamp:        timestamppb.New(link.Timestamp),
+			Reason:           link.Reason,
+		}
+		if link.EventID != uuid.Nil {
+			protoReport.FirstBrokenLink.EventId = link.EventID.String()
+		}
+		if link.AggregateID != uuid.Nil {
+			protoReport.FirstBrokenLink.AggregateId = link.AggregateID.String()
+		}
+	}
+
+	if checkpoint := report.LatestCheckpoint; checkpoint != nil {
+		protoReport.LatestCheckpoint = &esApi.Checkpoint{
+			Timestamp:  timestamppb.New(checkpoint.Timestamp),
+			Aggregates: checkpoint.Aggregates,
+			Digest:     checkpoint.Digest,
+			Signature:  checkpoint.Signature,
+		}
+	}
+
+	return protoReport
+}
+
+// NewEventSchemasProto converts the given es.EventSchema to proto esApi.EventSchemas including
+// the file descriptors declaring their data types.
+func NewEventSchemasProto(schemas []*es.EventSchema) (*esApi.EventSchemas, error) {
+	protoSchemas := &esApi.EventSchemas{}
+	visited := make(map[string]bool)
+
+	var addFile func(fd protoreflect.FileDescriptor) error
+	addFile = func(fd protoreflect.FileDescriptor) error {
+		if visited[fd.Path()] {
+			return nil
+		}
+		visited[fd.Path()] = true
+
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			if err := addFile(imports.Get(i).FileDescriptor); err != nil {
+				return err
+			}
+		}
+
+		bytes, err := proto.Marshal(protodesc.ToFileDescriptorProto(fd))
+		if err != nil {
+			return err
+		}
+		protoSchemas.FileDescriptorProtos = append(protoSchemas.FileDescriptorProtos, bytes)
+		return nil
+	}
+
+	for _, schema := range schemas {
+		protoSchema := &esApi.EventSchema{
+			Type:          schema.EventType.String(),
+			AggregateType: schema.AggregateType.String(),
+			Consumers:     schema.Consumers,
+		}
+		if schema.Data != nil {
+			protoSchema.DataType = string(schema.Data.FullName())
+			if err := addFile(schema.Data.ParentFile()); err != nil {
+				return nil, err
+			}
+		}
+		protoSchemas.Schemas = append(protoSchemas.Schemas, protoSchema)
+	}
+
+	return protoSchemas, nil
+}
@@ -18,10 +18,14 @@ import (
 	"time"
 
 	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	testEd "github.com/finleap-connect/monoskope/pkg/api/eventsourcing/eventdata"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -52,4 +56,75 @@ var _ = Describe("Converters", func() {
 		Expect(q.MinTimestamp).To(Equal(&minTimestamp))
 		Expect(q.MaxTimestamp).To(Equal(&maxTimestamp))
 	})
+	It("can convert event type, issuer, tenant and user filters", func() {
+		issuerId := uuid.New()
+		tenantId := uuid.New()
+		userId := uuid.New()
+		eventType := es.EventType("TestEventType")
+
+		q, err := NewStoreQueryFromProto(&esApi.EventFilter{
+			EventType: wrapperspb.String(eventType.String()),
+			IssuerId:  wrapperspb.String(issuerId.String()),
+			TenantId:  wrapperspb.String(tenantId.String()),
+			UserId:    wrapperspb.String(userId.String()),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*q.EventType).To(Equal(eventType))
+		Expect(*q.IssuerId).To(Equal(issuerId))
+		Expect(*q.TenantId).To(Equal(tenantId))
+		Expect(*q.UserId).To(Equal(userId))
+	})
+	It("fails to convert invalid issuer id", func() {
+		_, err := NewStoreQueryFromProto(&esApi.EventFilter{IssuerId: wrapperspb.String("invalid")})
+		Expect(err).To(HaveOccurred())
+	})
+	It("can convert integrity reports to proto", func() {
+		aggregateId := uuid.New()
+		timestamp := time.Now().UTC()
+
+		report := &es.IntegrityReport{
+			VerifiedEvents: 3,
+			BrokenLinks:    1,
+			FirstBrokenLink: &es.BrokenLink{
+				AggregateType:    "TestAggregateType",
+				AggregateID:      aggregateId,
+				AggregateVersion: 2,
+				Timestamp:        timestamp,
+				Reason:           "missing",
+			},
+		}
+
+		protoReport := NewIntegrityReportProto(report)
+		Expect(protoReport.VerifiedEvents).To(BeNumerically("==", 3))
+		Expect(protoReport.BrokenLinks).To(BeNumerically("==", 1))
+		Expect(protoReport.LatestCheckpoint).To(BeNil())
+		Expect(protoReport.FirstBrokenLink.EventId).To(BeEmpty())
+		Expect(protoReport.FirstBrokenLink.AggregateId).To(Equal(aggregateId.String()))
+		Expect(protoReport.FirstBrokenLink.AggregateVersion).To(BeNumerically("==", 2))
+		Expect(protoReport.FirstBrokenLink.Timestamp.AsTime()).To(Equal(timestamp))
+	})
+	It("can convert event schemas to proto which can be decoded by integrators", func() {
+		registry := es.NewEventSchemaRegistry()
+		registry.RegisterEventSchema("TestEventCreated", "TestAggregateType", &testEd.TestEventData{})
+		registry.RegisterEventSchema("TestEventDeleted", "TestAggregateType", nil)
+		registry.RegisterEventConsumer("test-consumer")
+
+		protoSchemas, err := NewEventSchemasProto(registry.GetEventSchemas())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(protoSchemas.Schemas).To(HaveLen(2))
+		Expect(protoSchemas.Schemas[0].DataType).To(Equal("eventdata.TestEventData"))
+		Expect(protoSchemas.Schemas[0].Consumers).To(ConsistOf("test-consumer"))
+		Expect(protoSchemas.Schemas[1].DataType).To(BeEmpty())
+
+		fileSet := &descriptorpb.FileDescriptorSet{}
+		for _, bytes := range protoSchemas.FileDescriptorProtos {
+			fd := &descriptorpb.FileDescriptorProto{}
+			Expect(proto.Unmarshal(bytes, fd)).To(Succeed())
+			fileSet.File = append(fileSet.File, fd)
+		}
+		files, err := protodesc.NewFiles(fileSet)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = files.FindDescriptorByName("eventdata.TestEventData")
+		Expect(err).ToNot(HaveOccurred())
+	})
 })
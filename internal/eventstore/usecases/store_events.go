@@ -40,17 +40,19 @@ type StoreEventsUseCase struct {
 
 	store   es.EventStore
 	bus     es.EventBusPublisher
+	schemas es.EventSchemaRegistry
 	stream  esApi.EventStore_StoreServer
 	metrics *metrics.EventStoreMetrics
 }
 
-// NewStoreEventsUseCase creates a new usecase which stores all events in the store
-// and broadcasts these events via the message bus
-func NewStoreEventsUseCase(stream esApi.EventStore_StoreServer, store es.EventStore, bus es.EventBusPublisher, metrics *metrics.EventStoreMetrics) usecase.UseCase {
+// NewStoreEventsUseCase creates a new usecase which validates all events of the stream against their schema,
+// stores them in the store at once and broadcasts these events via the message bus
+func NewStoreEventsUseCase(stream esApi.EventStore_StoreServer, store es.EventStore, bus es.EventBusPublisher, schemas es.EventSchemaRegistry, metrics *metrics.EventStoreMetrics) usecase.UseCase {
 	useCase := &StoreEventsUseCase{
 		UseCaseBase: usecase.NewUseCaseBase("store-events"),
 		store:       store,
 		bus:         bus,
+		schemas:     schemas,
 		stream:      stream,
 		metrics:     metrics,
 	}
@@ -61,9 +63,11 @@ func (u *StoreEventsUseCase) Run(ctx context.Context) error {
 	ctx, span := telemetry.GetSpan(ctx, "store-events")
 	defer span.End()
 
-	for {
-		startTime := time.Now()
+	startTime := time.Now()
 
+	// Receive and validate all events of the stream before storing any of them
+	var events []es.Event
+	for {
 		// Read next event
 		event, err := u.stream.Recv()
 
@@ -89,16 +93,29 @@ func (u *StoreEventsUseCase) Run(ctx context.Context) error {
 			return err
 		}
 
+		// Validate event data against the schema of the event type
+		if err := u.schemas.Validate(ev); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return errors.ErrInvalidArgument(err.Error())
+		}
+
+		events = append(events, ev)
+	}
+
+	if len(events) > 0 {
 		// Store events in database
-		u.Log.V(logger.DebugLevel).Info("Saving events in the store...")
-		if err := u.store.Save(ctx, []es.Event{ev}); err != nil {
+		u.Log.V(logger.DebugLevel).Info("Saving events in the store...", "eventCount", len(events))
+		if err := u.save(ctx, events); err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
 			return err
 		}
+	}
 
+	for _, ev := range events {
 		// Count successfully stored event
-		u.metrics.StoredTotalCounter.WithLabelValues(event.Type, event.AggregateType).Inc()
+		u.metrics.StoredTotalCounter.WithLabelValues(ev.EventType().String(), ev.AggregateType().String()).Inc()
 
 		// Send events to message bus
 		u.Log.V(logger.DebugLevel).Info("Sending events to the message bus...")
@@ -106,7 +123,7 @@ func (u *StoreEventsUseCase) Run(ctx context.Context) error {
 		params := backoff.NewExponentialBackOff()
 		params.MaxElapsedTime = MAX_BACKOFF_PUBLISH
 
-		err = backoff.Retry(func() error {
+		err := backoff.Retry(func() error {
 			return u.bus.PublishEvent(ctx, ev)
 		}, params)
 		if err != nil {
@@ -114,8 +131,19 @@ func (u *StoreEventsUseCase) Run(ctx context.Context) error {
 			span.SetStatus(codes.Error, err.Error())
 			return err
 		}
-		u.metrics.StoredHistogram.WithLabelValues(event.Type, event.AggregateType).Observe(time.Since(startTime).Seconds())
+		u.metrics.StoredHistogram.WithLabelValues(ev.EventType().String(), ev.AggregateType().String()).Observe(time.Since(startTime).Seconds())
 	}
 
 	return u.stream.SendAndClose(&emptypb.Empty{})
 }
+
+// save stores all events of the stream, all of them or none if the stream contains events of several aggregates.
+func (u *StoreEventsUseCase) save(ctx context.Context, events []es.Event) error {
+	if batchStore, ok := u.store.(es.BatchEventStore); ok {
+		return batchStore.SaveAll(ctx, events)
+	}
+	if len(es.GroupEventsByAggregate(events)) > 1 {
+		return errors.ErrFailedPrecondition("store does not support saving events of several aggregates at once")
+	}
+	return u.store.Save(ctx, events)
+}
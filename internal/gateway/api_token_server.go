@@ -26,29 +26,32 @@ import (
 
 type apiTokenServer struct {
 	api.UnimplementedAPITokenServer
-	log      logger.Logger
-	signer   jwt.JWTSigner
-	userRepo repositories.UserRepository
-	issuer   string
+	log             logger.Logger
+	signer          jwt.JWTSigner
+	userRepo        repositories.UserRepository
+	roleBindingRepo repositories.UserRoleBindingRepository
+	issuer          string
 }
 
 func NewAPITokenServer(
 	issuer string,
 	signer jwt.JWTSigner,
 	userRepo repositories.UserRepository,
+	roleBindingRepo repositories.UserRoleBindingRepository,
 ) api.APITokenServer {
 	s := &apiTokenServer{
-		log:      logger.WithName("server"),
-		signer:   signer,
-		userRepo: userRepo,
-		issuer:   issuer,
+		log:             logger.WithName("server"),
+		signer:          signer,
+		userRepo:        userRepo,
+		roleBindingRepo: roleBindingRepo,
+		issuer:          issuer,
 	}
 	return s
 }
 
 func (s *apiTokenServer) RequestAPIToken(ctx context.Context, request *api.APITokenRequest) (*api.APITokenResponse, error) {
 	response := new(api.APITokenResponse)
-	uc := usecases.NewGenerateAPITokenUsecase(request, response, s.signer, s.userRepo, s.issuer)
+	uc := usecases.NewGenerateAPITokenUsecase(request, response, s.signer, s.userRepo, s.roleBindingRepo, s.issuer)
 	err := uc.Run(ctx)
 	if err != nil {
 		return nil, err
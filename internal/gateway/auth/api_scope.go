@@ -0,0 +1,182 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+)
+
+// Access levels of fine-grained API scopes
+const (
+	ScopeAccessRead  = "read"
+	ScopeAccessWrite = "write"
+)
+
+// Resources of fine-grained API scopes
+const (
+	ScopeResourceUsers                 = "users"
+	ScopeResourceRoleBindings          = "rolebindings"
+	ScopeResourceTenants               = "tenants"
+	ScopeResourceClusters              = "clusters"
+	ScopeResourceTenantClusterBindings = "tenantclusterbindings"
+	ScopeResourceAuditLog              = "auditlog"
+	ScopeResourceRoles                 = "roles"
+	// ScopeResourceTenant requires the id of a tenant, e.g. "write:tenant/<id>"
+	ScopeResourceTenant = "tenant"
+	// ScopeResourceCommand requires a command type, e.g. "write:command/CreateTenant"
+	ScopeResourceCommand = "command"
+)
+
+// scopeResourceCommandTypes maps resources to the keys of commands.CommandTypes
+var scopeResourceCommandTypes = map[string]string{
+	ScopeResourceUsers:                 "User",
+	ScopeResourceRoleBindings:          "UserRoleBinding",
+	ScopeResourceTenants:               "Tenant",
+	ScopeResourceClusters:              "Cluster",
+	ScopeResourceTenantClusterBindings: "TenantClusterBinding",
+	ScopeResourceRoles:                 "Role",
+}
+
+// scopeResourceReadable is the set of resources which can be used with read access
+var scopeResourceReadable = map[string]bool{
+	ScopeResourceUsers:    true,
+	ScopeResourceTenants:  true,
+	ScopeResourceClusters: true,
+	ScopeResourceAuditLog: true,
+	ScopeResourceRoles:    true,
+	ScopeResourceTenant:   true,
+}
+
+// tenantCommandTypes are the command types which can be executed with a "write:tenant/<id>" scope
+var tenantCommandTypes = []es.CommandType{
+	commands.UpdateTenant,
+	commands.DeleteTenant,
+	commands.CreateUserRoleBinding,
+	commands.CreateTenantClusterBinding,
+}
+
+// APIScope is a fine-grained scope of an API token in the form <access>:<resource>[/<id>].
+type APIScope struct {
+	Access   string
+	Resource string
+	Id       string
+}
+
+// ParseAPIScope parses and validates a scope in the form <access>:<resource>[/<id>].
+func ParseAPIScope(scope string) (*APIScope, error) {
+	access, resourceAndId, ok := strings.Cut(scope, ":")
+	if !ok {
+		return nil, fmt.Errorf("scope '%s' is invalid: expected <access>:<resource>[/<id>]", scope)
+	}
+	resource, id, _ := strings.Cut(resourceAndId, "/")
+	s := &APIScope{Access: access, Resource: resource, Id: id}
+	if err := s.validate(); err != nil {
+		return nil, fmt.Errorf("scope '%s' is invalid: %w", scope, err)
+	}
+	return s, nil
+}
+
+// ParseAPIScopes parses all scopes which are fine-grained API scopes and ignores the others.
+func ParseAPIScopes(scopes []string) []*APIScope {
+	var apiScopes []*APIScope
+	for _, scope := range scopes {
+		if apiScope, err := ParseAPIScope(scope); err == nil {
+			apiScopes = append(apiScopes, apiScope)
+		}
+	}
+	return apiScopes
+}
+
+func (s *APIScope) validate() error {
+	switch s.Access {
+	case ScopeAccessRead:
+		if !scopeResourceReadable[s.Resource] {
+			return fmt.Errorf("resource '%s' can not be read", s.Resource)
+		}
+	case ScopeAccessWrite:
+		if s.Resource == ScopeResourceAuditLog {
+			return fmt.Errorf("resource '%s' can not be written", s.Resource)
+		}
+	default:
+		return fmt.Errorf("access '%s' is unknown", s.Access)
+	}
+
+	switch s.Resource {
+	case ScopeResourceTenant:
+		if _, err := uuid.Parse(s.Id); err != nil {
+			return fmt.Errorf("resource '%s' requires a valid tenant id", s.Resource)
+		}
+	case ScopeResourceCommand:
+		if !isCommandType(es.CommandType(s.Id)) {
+			return fmt.Errorf("command type '%s' is unknown", s.Id)
+		}
+	case ScopeResourceAuditLog:
+		if s.Id != "" {
+			return fmt.Errorf("resource '%s' does not allow an id", s.Resource)
+		}
+	default:
+		if _, ok := scopeResourceCommandTypes[s.Resource]; !ok {
+			return fmt.Errorf("resource '%s' is unknown", s.Resource)
+		}
+		if s.Id != "" {
+			return fmt.Errorf("resource '%s' does not allow an id", s.Resource)
+		}
+	}
+	return nil
+}
+
+// IsTenantScope returns true if the scope is bound to a single tenant.
+func (s *APIScope) IsTenantScope() bool {
+	return s.Resource == ScopeResourceTenant
+}
+
+// CommandTypes returns the command types which can be executed with the scope.
+func (s *APIScope) CommandTypes() []es.CommandType {
+	if s.Access != ScopeAccessWrite {
+		return nil
+	}
+	switch s.Resource {
+	case ScopeResourceTenant:
+		return tenantCommandTypes
+	case ScopeResourceCommand:
+		return []es.CommandType{es.CommandType(s.Id)}
+	default:
+		return commands.CommandTypes[scopeResourceCommandTypes[s.Resource]]
+	}
+}
+
+// String returns the scope in the form <access>:<resource>[/<id>].
+func (s *APIScope) String() string {
+	if s.Id == "" {
+		return fmt.Sprintf("%s:%s", s.Access, s.Resource)
+	}
+	return fmt.Sprintf("%s:%s/%s", s.Access, s.Resource, s.Id)
+}
+
+func isCommandType(commandType es.CommandType) bool {
+	for _, commandTypes := range commands.CommandTypes {
+		for _, t := range commandTypes {
+			if t == commandType {
+				return true
+			}
+		}
+	}
+	return false
+}
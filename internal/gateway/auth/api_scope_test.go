@@ -0,0 +1,85 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("internal/gateway/auth/api_scope", func() {
+	tenantId := uuid.New().String()
+
+	It("parses valid scopes", func() {
+		for _, scope := range []string{
+			"read:clusters",
+			"read:users",
+			"read:auditlog",
+			"write:clusters",
+			"write:rolebindings",
+			"read:tenant/" + tenantId,
+			"write:tenant/" + tenantId,
+			"write:command/CreateTenant",
+		} {
+			apiScope, err := ParseAPIScope(scope)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiScope.String()).To(Equal(scope))
+		}
+	})
+
+	It("rejects invalid scopes", func() {
+		for _, scope := range []string{
+			"API",
+			"delete:clusters",
+			"read:something",
+			"read:rolebindings",
+			"write:auditlog",
+			"read:clusters/" + tenantId,
+			"write:tenant",
+			"write:tenant/not-a-uuid",
+			"write:command/DoSomething",
+		} {
+			_, err := ParseAPIScope(scope)
+			Expect(err).To(HaveOccurred(), scope)
+		}
+	})
+
+	It("maps scopes to command types", func() {
+		apiScope, err := ParseAPIScope("write:clusters")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(apiScope.CommandTypes()).To(ConsistOf(commands.ClusterCommands))
+
+		apiScope, err = ParseAPIScope("write:command/CreateTenant")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(apiScope.CommandTypes()).To(ConsistOf(commands.CreateTenant))
+
+		apiScope, err = ParseAPIScope("write:tenant/" + tenantId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(apiScope.IsTenantScope()).To(BeTrue())
+		Expect(apiScope.CommandTypes()).To(ContainElement(commands.CreateUserRoleBinding))
+
+		apiScope, err = ParseAPIScope("read:clusters")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(apiScope.CommandTypes()).To(BeEmpty())
+	})
+
+	It("ignores legacy scopes when parsing a list", func() {
+		apiScopes := ParseAPIScopes([]string{"API", "WRITE_SCIM", "read:clusters"})
+		Expect(apiScopes).To(HaveLen(1))
+		Expect(apiScopes[0].String()).To(Equal("read:clusters"))
+	})
+})
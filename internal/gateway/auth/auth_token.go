@@ -67,7 +67,7 @@ func NewKubernetesAuthToken(claims *jwt.StandardClaims, clusterClaim *jwt.Cluste
 	}
 }
 
-func NewApiToken(claims *jwt.StandardClaims, issuer, userId string, validity time.Duration, scopes []gateway.AuthorizationScope) *jwt.AuthToken {
+func NewApiToken(claims *jwt.StandardClaims, issuer, userId string, validity time.Duration, scopes []gateway.AuthorizationScope, apiScopes []*APIScope) *jwt.AuthToken {
 	now := time.Now().UTC()
 
 	var scopesString string
@@ -75,6 +75,10 @@ func NewApiToken(claims *jwt.StandardClaims, issuer, userId string, validity tim
 		scopesString = fmt.Sprintf("%s %s", scopesString, scope.String())
 		scopesString = strings.TrimPrefix(scopesString, " ")
 	}
+	for _, scope := range apiScopes {
+		scopesString = fmt.Sprintf("%s %s", scopesString, scope.String())
+		scopesString = strings.TrimPrefix(scopesString, " ")
+	}
 
 	return &jwt.AuthToken{
 		Claims: &jose_jwt.Claims{
@@ -35,9 +35,16 @@ var _ = Describe("internal/gateway/auth/token", func() {
 	})
 
 	It("validate api token", func() {
-		t := NewApiToken(&jwt.StandardClaims{}, expectedIssuer, "me", expectedValidity, []gateway.AuthorizationScope{gateway.AuthorizationScope_WRITE_SCIM})
+		t := NewApiToken(&jwt.StandardClaims{}, expectedIssuer, "me", expectedValidity, []gateway.AuthorizationScope{gateway.AuthorizationScope_WRITE_SCIM}, nil)
 		t.Expiry = jose_jwt.NewNumericDate(time.Now().UTC().Add(time.Hour * 1))
 		Expect(t.Validate(expectedIssuer)).ToNot(HaveOccurred())
 		Expect(t.Scope).To(Equal(gateway.AuthorizationScope_WRITE_SCIM.String()))
 	})
+
+	It("api token contains fine-grained scopes", func() {
+		apiScope, err := ParseAPIScope("read:clusters")
+		Expect(err).ToNot(HaveOccurred())
+		t := NewApiToken(&jwt.StandardClaims{}, expectedIssuer, "me", expectedValidity, []gateway.AuthorizationScope{gateway.AuthorizationScope_WRITE_SCIM}, []*APIScope{apiScope})
+		Expect(t.Scope).To(Equal("WRITE_SCIM read:clusters"))
+	})
 })
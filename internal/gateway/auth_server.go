@@ -28,6 +28,7 @@ import (
 	grpcUtil "github.com/finleap-connect/monoskope/pkg/grpc"
 	"github.com/finleap-connect/monoskope/pkg/jwt"
 	"github.com/finleap-connect/monoskope/pkg/logger"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	"github.com/google/uuid"
 	"github.com/open-policy-agent/opa/rego"
 	"github.com/open-policy-agent/opa/topdown/print"
@@ -38,8 +39,16 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+type policyAPIScope struct {
+	Access       string
+	Resource     string
+	Id           string
+	CommandTypes []eventsourcing.CommandType
+}
+
 type policyAuthentication struct {
-	Scopes []string
+	Scopes    []string
+	APIScopes []policyAPIScope
 }
 
 type policyRoles struct {
@@ -70,6 +79,7 @@ type authServer struct {
 	issuerURL       string
 	preparedQuery   *rego.PreparedEvalQuery
 	roleBindingRepo repositories.UserRoleBindingRepository
+	roleRepo        repositories.RoleRepository
 }
 
 // authServerClientInternal can be used to wrap this server for use as grpc client implementation for local calls
@@ -81,9 +91,15 @@ func (c *authServerClientInternal) Check(ctx context.Context, req *gateway.Check
 	return c.authServer.Check(ctx, req)
 }
 
+// NewInsecureAuthServerClient connects to the GatewayAuth API without transport security.
 func NewInsecureAuthServerClient(ctx context.Context, gatewayAddr string) (*grpc.ClientConn, gateway.GatewayAuthClient, error) {
+	return NewAuthServerClient(ctx, gatewayAddr, nil)
+}
+
+// NewAuthServerClient connects to the GatewayAuth API, with mutual TLS if a TLSConfigLoader is given.
+func NewAuthServerClient(ctx context.Context, gatewayAddr string, tlsLoader *m8tls.TLSConfigLoader) (*grpc.ClientConn, gateway.GatewayAuthClient, error) {
 	conn, err := grpcUtil.
-		NewGrpcConnectionFactoryWithInsecure(gatewayAddr).
+		NewInternalGrpcConnectionFactory(gatewayAddr, tlsLoader).
 		WithOpenTelemetry().
 		ConnectWithTimeout(ctx, 10*time.Second)
 	if err != nil {
@@ -98,12 +114,13 @@ func (s *authServer) Print(_ print.Context, msg string) error {
 }
 
 // NewAuthServer creates a new instance of gateway.authServer.
-func NewAuthServer(ctx context.Context, issuerURL string, oidcServer *auth.Server, policiesPath string, roleBindingRepo repositories.UserRoleBindingRepository) (*authServer, error) {
+func NewAuthServer(ctx context.Context, issuerURL string, oidcServer *auth.Server, policiesPath string, roleBindingRepo repositories.UserRoleBindingRepository, roleRepo repositories.RoleRepository) (*authServer, error) {
 	s := &authServer{
 		log:             logger.WithName("auth-server"),
 		oidcServer:      oidcServer,
 		issuerURL:       issuerURL,
 		roleBindingRepo: roleBindingRepo,
+		roleRepo:        roleRepo,
 	}
 
 	query, err := rego.New(
@@ -188,7 +205,8 @@ func (s *authServer) validatePolicies(ctx context.Context, req *gateway.CheckReq
 		},
 		Path: req.FullMethodName,
 		Authentication: policyAuthentication{
-			Scopes: make([]string, 0),
+			Scopes:    make([]string, 0),
+			APIScopes: make([]policyAPIScope, 0),
 		},
 		Request:      string(req.Request),
 		CommandTypes: commands.CommandTypes,
@@ -203,6 +221,14 @@ func (s *authServer) validatePolicies(ctx context.Context, req *gateway.CheckReq
 
 		input.User.Roles = make([]policyRoles, 0)
 		for _, role := range roleBindings {
+			// bindings of deleted roles are not considered
+			registered, err := s.roleRepo.IsRegistered(ctx, role.Role)
+			if err != nil {
+				return false, fmt.Errorf("failed get role: %w", err)
+			}
+			if !registered {
+				continue
+			}
 			input.User.Roles = append(input.User.Roles, policyRoles{
 				Name:     role.Role,
 				Scope:    role.Scope,
@@ -213,6 +239,14 @@ func (s *authServer) validatePolicies(ctx context.Context, req *gateway.CheckReq
 
 	scopes := strings.Split(authToken.Scope, " ")
 	input.Authentication.Scopes = append(input.Authentication.Scopes, scopes...)
+	for _, apiScope := range auth.ParseAPIScopes(scopes) {
+		input.Authentication.APIScopes = append(input.Authentication.APIScopes, policyAPIScope{
+			Access:       apiScope.Access,
+			Resource:     apiScope.Resource,
+			Id:           apiScope.Id,
+			CommandTypes: apiScope.CommandTypes(),
+		})
+	}
 
 	results, err := s.preparedQuery.Eval(ctx, rego.EvalInput(input))
 	if err != nil {
@@ -197,7 +197,7 @@ var _ = Describe("Gateway Auth Server", func() {
 		expectedValidity := time.Hour * 1
 		token := auth.NewApiToken(&jwt.StandardClaims{Name: mock.TestNoneExistingUser.Name}, localAddrAPIServer, mock.TestNoneExistingUser.Id, expectedValidity, []gateway.AuthorizationScope{
 			gateway.AuthorizationScope_WRITE_SCIM,
-		})
+		}, nil)
 		signer := testEnv.JwtTestEnv.CreateSigner()
 		signedToken, err := signer.GenerateSignedToken(token)
 		Expect(err).NotTo(HaveOccurred())
@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/finleap-connect/monoskope/api/openapi"
 	"github.com/finleap-connect/monoskope/internal/gateway/auth"
 	"github.com/finleap-connect/monoskope/internal/telemetry"
 	"github.com/finleap-connect/monoskope/pkg/logger"
@@ -42,6 +43,7 @@ type oidcProviderServer struct {
 	log        logger.Logger
 	shutdown   *util.ShutdownWaitGroup
 	oidcServer *auth.Server
+	restAPI    http.Handler
 }
 
 // NewOIDCProviderServer creates a basic OIDC provider server
@@ -59,6 +61,12 @@ func NewOIDCProviderServer(oidcServer *auth.Server) *oidcProviderServer {
 	return s
 }
 
+// WithRESTGateway serves the given RESTful JSON gateway and its OpenAPI document in addition.
+func (s *oidcProviderServer) WithRESTGateway(restAPI http.Handler) *oidcProviderServer {
+	s.restAPI = restAPI
+	return s
+}
+
 // Serve tells the server to start listening on the specified address.
 func (s *oidcProviderServer) Serve(apiAddr string) error {
 	// Setup grpc listener
@@ -132,4 +140,12 @@ func (s *oidcProviderServer) registerViews(r *gin.Engine) {
 	// OIDC
 	r.GET("/.well-known/openid-configuration", s.discovery)
 	r.GET("/keys", s.keys)
+
+	// RESTful JSON gateway
+	if s.restAPI != nil {
+		r.Any("/v1/*path", gin.WrapH(s.restAPI))
+		r.GET("/openapi.json", func(c *gin.Context) {
+			c.Data(http.StatusOK, "application/json", openapi.Document)
+		})
+	}
 }
@@ -0,0 +1,131 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain"
+	_ "github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
+	"github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	api "github.com/finleap-connect/monoskope/pkg/api/gateway"
+	"github.com/finleap-connect/monoskope/pkg/domain/metadata"
+	grpcUtil "github.com/finleap-connect/monoskope/pkg/grpc"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+type registerHandlerFunc func(context.Context, *runtime.ServeMux, grpc.ClientConnInterface) error
+
+// restGateway transcodes RESTful JSON requests to the gRPC APIs of Monoskope.
+type restGateway struct {
+	log   logger.Logger
+	mux   *runtime.ServeMux
+	conns []*grpc.ClientConn
+}
+
+// NewRESTGateway creates a RESTful JSON gateway for the gRPC APIs of the gateway, the commandhandler and the queryhandler.
+// The authorization header of requests is forwarded, so the gRPC servers authenticate and authorize them via the GatewayAuth API as for any other client.
+// The APIs of the commandhandler and queryhandler are left out if their address is empty and connected with mutual TLS if a TLSConfigLoader is given.
+func NewRESTGateway(ctx context.Context, gatewayAddr, commandHandlerAddr, queryHandlerAddr string, tlsLoader *m8tls.TLSConfigLoader) (*restGateway, error) {
+	g := &restGateway{
+		log: logger.WithName("rest-gateway"),
+		mux: runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher)),
+	}
+
+	err := g.register(ctx, gatewayAddr, nil,
+		api.RegisterGatewayHandler,
+		api.RegisterClusterAuthHandler,
+		api.RegisterAPITokenHandler,
+	)
+	if err == nil && commandHandlerAddr != "" {
+		err = g.register(ctx, commandHandlerAddr, tlsLoader,
+			eventsourcing.RegisterCommandHandlerHandler,
+			domain.RegisterCommandHandlerExtensionsHandler,
+		)
+	}
+	if err == nil && queryHandlerAddr != "" {
+		err = g.register(ctx, queryHandlerAddr, tlsLoader,
+			domain.RegisterUserHandler,
+			domain.RegisterTenantHandler,
+			domain.RegisterClusterHandler,
+			domain.RegisterRoleHandler,
+			domain.RegisterClusterAccessHandler,
+			domain.RegisterAuditLogHandler,
+			domain.RegisterK8SAuthZHandler,
+			domain.RegisterDeadLetterQueueHandler,
+			domain.RegisterProjectionAdminHandler,
+		)
+	}
+	if err != nil {
+		g.Close()
+		return nil, err
+	}
+	return g, nil
+}
+
+// incomingHeaderMatcher forwards the idempotency key of commands in addition to the default headers.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, metadata.HeaderIdempotencyKey) {
+		return metadata.HeaderIdempotencyKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// register connects to the given address, with mutual TLS if a TLSConfigLoader is given, and registers the handlers of the APIs served there.
+func (g *restGateway) register(ctx context.Context, addr string, tlsLoader *m8tls.TLSConfigLoader, registerHandlers ...registerHandlerFunc) error {
+	g.log.Info("Registering RESTful JSON gateway...", "addr", addr)
+
+	factory := grpcUtil.NewGrpcConnectionFactory(addr)
+	if tlsLoader != nil {
+		factory = factory.WithMutualTLS(tlsLoader)
+	} else {
+		factory = factory.WithInsecure()
+	}
+
+	// Connect without blocking since the gRPC servers might not be up yet
+	conn, err := factory.
+		WithOpenTelemetry().
+		Connect(ctx)
+	if err != nil {
+		return err
+	}
+	g.conns = append(g.conns, conn)
+
+	for _, registerHandler := range registerHandlers {
+		if err := registerHandler(ctx, g.mux, conn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServeHTTP transcodes the request to the gRPC API it is mapped to.
+func (g *restGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+// Close closes the connections to the gRPC servers.
+func (g *restGateway) Close() {
+	for _, conn := range g.conns {
+		if err := conn.Close(); err != nil {
+			g.log.Error(err, "failed to close connection", "target", conn.Target())
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/finleap-connect/monoskope/api/openapi"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RESTful JSON gateway", func() {
+	It("can retrieve the openapi document", func() {
+		res, err := testEnv.HttpClient.Get(fmt.Sprintf("http://%s/openapi.json", localAddrOIDCProviderServer))
+		Expect(err).NotTo(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		doc := make(map[string]interface{})
+		Expect(json.NewDecoder(res.Body).Decode(&doc)).To(Succeed())
+		Expect(doc).To(HaveKey("paths"))
+		Expect(doc["paths"]).To(HaveKey("/v1/auth/upstream"))
+		Expect(openapi.Document).NotTo(BeEmpty())
+	})
+	It("can retrieve auth url", func() {
+		res, err := testEnv.HttpClient.Post(fmt.Sprintf("http://%s/v1/auth/upstream", localAddrOIDCProviderServer), "application/json", strings.NewReader(`{"callbackUrl":"http://localhost:8000"}`))
+		Expect(err).NotTo(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		response := make(map[string]string)
+		Expect(json.NewDecoder(res.Body).Decode(&response)).To(Succeed())
+		Expect(response["upstreamIdpRedirect"]).NotTo(BeEmpty())
+		Expect(response["state"]).NotTo(BeEmpty())
+	})
+	It("maps gRPC errors to HTTP status codes", func() {
+		res, err := testEnv.HttpClient.Post(fmt.Sprintf("http://%s/v1/auth/token", localAddrOIDCProviderServer), "application/json", strings.NewReader(`{"code":"invalid","state":"invalid"}`))
+		Expect(err).NotTo(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(BeNumerically(">=", http.StatusBadRequest))
+	})
+	It("does not serve APIs without upstream", func() {
+		res, err := testEnv.HttpClient.Get(fmt.Sprintf("http://%s/v1/users", localAddrOIDCProviderServer))
+		Expect(err).NotTo(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
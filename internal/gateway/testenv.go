@@ -61,6 +61,7 @@ type TestEnv struct {
 	HttpClient                    *http.Client
 	GrpcServer                    *grpc.Server
 	LocalOIDCProviderServer       *oidcProviderServer
+	RESTGateway                   *restGateway
 	PoliciesPath                  string
 	eventStoreTestEnv             *eventstore.TestEnv
 	ebConsumer                    es.EventBusConsumer
@@ -86,7 +87,7 @@ func NewTestEnvWithParent(testeEnv *test.TestEnv, eventStoreTestEnv *eventstore.
 		return nil, err
 	}
 
-	env.esConn, env.esClient, err = eventstore.NewEventStoreClient(ctx, env.eventStoreTestEnv.GetApiAddr())
+	env.esConn, env.esClient, err = eventstore.NewEventStoreClient(ctx, env.eventStoreTestEnv.GetApiAddr(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -219,11 +220,11 @@ func NewTestEnvWithParent(testeEnv *test.TestEnv, eventStoreTestEnv *eventstore.
 	}
 
 	gatewayApiServer := NewGatewayAPIServer(env.ClientAuthConfig, authClient, authServer, gwDomain.UserRepository)
-	authApiServer := NewClusterAuthAPIServer("https://localhost", signer, repositories.NewClusterAccessRepository(gwDomain.TenantClusterBindingRepository, gwDomain.ClusterRepository, gwDomain.UserRoleBindingRepository, gwDomain.TenantRepository), map[string]time.Duration{
+	authApiServer := NewClusterAuthAPIServer("https://localhost", signer, repositories.NewClusterAccessRepository(gwDomain.TenantClusterBindingRepository, gwDomain.ClusterRepository, gwDomain.UserRoleBindingRepository, gwDomain.TenantRepository, gwDomain.RoleRepository), map[string]time.Duration{
 		"default": time.Hour * 1,
 	})
 
-	gatewayAuthServer, errAuthServer := NewAuthServer(ctx, localAddrAPIServer, authServer, env.PoliciesPath, gwDomain.UserRoleBindingRepository)
+	gatewayAuthServer, errAuthServer := NewAuthServer(ctx, localAddrAPIServer, authServer, env.PoliciesPath, gwDomain.UserRoleBindingRepository, gwDomain.RoleRepository)
 	if errAuthServer != nil {
 		return nil, errAuthServer
 	}
@@ -249,7 +250,12 @@ func NewTestEnvWithParent(testeEnv *test.TestEnv, eventStoreTestEnv *eventstore.
 		}
 	}()
 
-	env.LocalOIDCProviderServer = NewOIDCProviderServer(authServer)
+	env.RESTGateway, err = NewRESTGateway(ctx, localAddrAPIServer, "", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	env.LocalOIDCProviderServer = NewOIDCProviderServer(authServer).WithRESTGateway(env.RESTGateway)
 	env.ApiListenerOIDCProviderServer, err = net.Listen("tcp", localAddrOIDCProviderServer)
 	if err != nil {
 		return nil, err
@@ -288,5 +294,8 @@ func (env *TestEnv) GetApiAddr() string {
 }
 
 func (env *TestEnv) Shutdown() error {
+	if env.RESTGateway != nil {
+		env.RESTGateway.Close()
+	}
 	return env.TestEnv.Shutdown()
 }
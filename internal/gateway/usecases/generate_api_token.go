@@ -20,8 +20,13 @@ import (
 
 	"github.com/finleap-connect/monoskope/internal/gateway/auth"
 	api "github.com/finleap-connect/monoskope/pkg/api/gateway"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
 	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	"github.com/finleap-connect/monoskope/pkg/domain/metadata"
 	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/jwt"
 	"github.com/finleap-connect/monoskope/pkg/logger"
 	"github.com/finleap-connect/monoskope/pkg/usecase"
@@ -29,13 +34,18 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// tenantAdminCommandTypes are the command types tenant admins can execute for their tenants,
+// see tenant_admin_rolebindings of the OPA policy.
+var tenantAdminCommandTypes = commands.UserRoleBindingCommands
+
 type generateAPITokenUsecase struct {
 	*usecase.UseCaseBase
-	request  *api.APITokenRequest
-	response *api.APITokenResponse
-	signer   jwt.JWTSigner
-	userRepo repositories.UserRepository
-	issuer   string
+	request         *api.APITokenRequest
+	response        *api.APITokenResponse
+	signer          jwt.JWTSigner
+	userRepo        repositories.UserRepository
+	roleBindingRepo repositories.UserRoleBindingRepository
+	issuer          string
 }
 
 func NewGenerateAPITokenUsecase(
@@ -43,6 +53,7 @@ func NewGenerateAPITokenUsecase(
 	response *api.APITokenResponse,
 	signer jwt.JWTSigner,
 	userRepo repositories.UserRepository,
+	roleBindingRepo repositories.UserRoleBindingRepository,
 	issuer string,
 ) usecase.UseCase {
 	return &generateAPITokenUsecase{
@@ -51,15 +62,29 @@ func NewGenerateAPITokenUsecase(
 		response,
 		signer,
 		userRepo,
+		roleBindingRepo,
 		issuer,
 	}
 }
 
 func (u *generateAPITokenUsecase) Run(ctx context.Context) error {
 	// Validate scopes
-	if len(u.request.GetAuthorizationScopes()) < 1 {
+	if len(u.request.GetAuthorizationScopes())+len(u.request.GetScopes()) < 1 {
 		return fmt.Errorf("At least one scope required.")
 	}
+	apiScopes := make([]*auth.APIScope, 0, len(u.request.GetScopes()))
+	for _, scope := range u.request.GetScopes() {
+		apiScope, err := auth.ParseAPIScope(scope)
+		if err != nil {
+			return errors.ErrInvalidArgument(err.Error())
+		}
+		apiScopes = append(apiScopes, apiScope)
+	}
+
+	// Make sure the token does not exceed the rights of the issuer
+	if err := u.validateIssuerRights(ctx, apiScopes); err != nil {
+		return err
+	}
 
 	// Determine user
 	var userId string
@@ -81,7 +106,7 @@ func (u *generateAPITokenUsecase) Run(ctx context.Context) error {
 	}
 
 	// Generate and sign token
-	token := auth.NewApiToken(standardClaims, u.issuer, userId, u.request.Validity.AsDuration(), u.request.GetAuthorizationScopes())
+	token := auth.NewApiToken(standardClaims, u.issuer, userId, u.request.Validity.AsDuration(), u.request.GetAuthorizationScopes(), apiScopes)
 	u.Log.V(logger.DebugLevel).Info("Token issued successfully.", "RawToken", token, "Expiry", token.Expiry.Time().String())
 	signedToken, err := u.signer.GenerateSignedToken(token)
 	if err != nil {
@@ -95,3 +120,61 @@ func (u *generateAPITokenUsecase) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// validateIssuerRights ensures the requested scopes do not exceed the rights of the user issuing the token.
+// System admins can issue tokens with any scope, tenant admins only with scopes bound to their tenants
+// which do not unlock commands they are not allowed to execute themselves.
+func (u *generateAPITokenUsecase) validateIssuerRights(ctx context.Context, apiScopes []*auth.APIScope) error {
+	metadataManager, err := metadata.NewDomainMetadataManager(ctx)
+	if err != nil {
+		return err
+	}
+	issuer := metadataManager.GetUserInformation()
+
+	roleBindings, err := u.roleBindingRepo.ByUserId(ctx, issuer.Id)
+	if err != nil {
+		return errors.TranslateToGrpcError(err)
+	}
+
+	tenantAdminOf := make(map[string]bool)
+	for _, roleBinding := range roleBindings {
+		if roleBinding.Role != string(roles.Admin) {
+			continue
+		}
+		switch roleBinding.Scope {
+		case string(scopes.System):
+			return nil
+		case string(scopes.Tenant):
+			tenantAdminOf[roleBinding.Resource] = true
+		}
+	}
+
+	if len(u.request.GetAuthorizationScopes()) > 0 {
+		u.Log.Info("Issuer is not allowed to issue tokens with the requested scopes.", "issuer", issuer.Email, "scopes", u.request.GetAuthorizationScopes())
+		return errors.TranslateToGrpcError(errors.ErrUnauthorized)
+	}
+	for _, apiScope := range apiScopes {
+		if !apiScope.IsTenantScope() || !tenantAdminOf[apiScope.Id] || !isTenantAdminCommandTypes(apiScope.CommandTypes()) {
+			u.Log.Info("Issuer is not allowed to issue tokens with the requested scope.", "issuer", issuer.Email, "scope", apiScope.String())
+			return errors.TranslateToGrpcError(errors.ErrUnauthorized)
+		}
+	}
+	return nil
+}
+
+// isTenantAdminCommandTypes returns true if tenant admins are allowed to execute all of the given command types.
+func isTenantAdminCommandTypes(commandTypes []es.CommandType) bool {
+	for _, commandType := range commandTypes {
+		allowed := false
+		for _, tenantAdminCommandType := range tenantAdminCommandTypes {
+			if commandType == tenantAdminCommandType {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
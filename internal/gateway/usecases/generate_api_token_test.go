@@ -21,8 +21,12 @@ import (
 	"github.com/finleap-connect/monoskope/internal/test"
 	mock_repositories "github.com/finleap-connect/monoskope/internal/test/domain/repositories"
 	api "github.com/finleap-connect/monoskope/pkg/api/gateway"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
 	"github.com/finleap-connect/monoskope/pkg/domain/metadata"
 	"github.com/finleap-connect/monoskope/pkg/domain/projections"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/jwt"
 	"github.com/finleap-connect/monoskope/pkg/util"
 	"github.com/golang/mock/gomock"
@@ -56,8 +60,18 @@ var _ = Describe("GenerateAPIToken", func() {
 		mockCtrl.Finish()
 	})
 
+	newRoleBinding := func(role es.Role, scope es.Scope, resource string) *projections.UserRoleBinding {
+		roleBinding := projections.NewUserRoleBinding(uuid.New())
+		roleBinding.UserId = expectedUserId.String()
+		roleBinding.Role = string(role)
+		roleBinding.Scope = string(scope)
+		roleBinding.Resource = resource
+		return roleBinding
+	}
+
 	It("can retrieve an API token", func() {
 		userRepo := mock_repositories.NewMockUserRepository(mockCtrl)
+		roleBindingRepo := mock_repositories.NewMockUserRoleBindingRepository(mockCtrl)
 
 		request := &api.APITokenRequest{
 			AuthorizationScopes: []api.AuthorizationScope{
@@ -67,7 +81,7 @@ var _ = Describe("GenerateAPIToken", func() {
 			Validity: durationpb.New(expectedValidity),
 		}
 		response := new(api.APITokenResponse)
-		uc := NewGenerateAPITokenUsecase(request, response, jwtTestEnv.CreateSigner(), userRepo, expectedIssuer)
+		uc := NewGenerateAPITokenUsecase(request, response, jwtTestEnv.CreateSigner(), userRepo, roleBindingRepo, expectedIssuer)
 
 		mdManager.SetUserInformation(&metadata.UserInformation{
 			Id:    expectedUserId,
@@ -81,6 +95,9 @@ var _ = Describe("GenerateAPIToken", func() {
 		userProjection.Email = expectedUserEmail
 
 		ctxWithUser := mdManager.GetContext()
+		roleBindingRepo.EXPECT().ByUserId(ctxWithUser, expectedUserId).Return([]*projections.UserRoleBinding{
+			newRoleBinding(roles.Admin, scopes.System, ""),
+		}, nil)
 		userRepo.EXPECT().ByUserId(ctxWithUser, expectedUserId).Return(userProjection, nil)
 
 		err := uc.Run(ctxWithUser)
@@ -89,4 +106,78 @@ var _ = Describe("GenerateAPIToken", func() {
 		Expect(response.AccessToken).ToNot(BeEmpty())
 	})
 
+	Context("fine-grained scopes", func() {
+		expectedTenantId := uuid.New().String()
+
+		It("can retrieve an API token for a tenant as tenant admin", func() {
+			userRepo := mock_repositories.NewMockUserRepository(mockCtrl)
+			roleBindingRepo := mock_repositories.NewMockUserRoleBindingRepository(mockCtrl)
+
+			request := &api.APITokenRequest{
+				Scopes:   []string{"read:tenant/" + expectedTenantId},
+				User:     &api.APITokenRequest_Username{Username: "tenant-ci"},
+				Validity: durationpb.New(expectedValidity),
+			}
+			response := new(api.APITokenResponse)
+			uc := NewGenerateAPITokenUsecase(request, response, jwtTestEnv.CreateSigner(), userRepo, roleBindingRepo, expectedIssuer)
+
+			mdManager.SetUserInformation(&metadata.UserInformation{
+				Id:    expectedUserId,
+				Name:  expectedUserName,
+				Email: expectedUserEmail,
+			})
+
+			ctxWithUser := mdManager.GetContext()
+			roleBindingRepo.EXPECT().ByUserId(ctxWithUser, expectedUserId).Return([]*projections.UserRoleBinding{
+				newRoleBinding(roles.Admin, scopes.Tenant, expectedTenantId),
+			}, nil)
+
+			err := uc.Run(ctxWithUser)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.AccessToken).ToNot(BeEmpty())
+		})
+
+		It("can't exceed the rights of the issuer", func() {
+			userRepo := mock_repositories.NewMockUserRepository(mockCtrl)
+			roleBindingRepo := mock_repositories.NewMockUserRoleBindingRepository(mockCtrl)
+
+			mdManager.SetUserInformation(&metadata.UserInformation{
+				Id:    expectedUserId,
+				Name:  expectedUserName,
+				Email: expectedUserEmail,
+			})
+			ctxWithUser := mdManager.GetContext()
+			roleBindingRepo.EXPECT().ByUserId(ctxWithUser, expectedUserId).Return([]*projections.UserRoleBinding{
+				newRoleBinding(roles.Admin, scopes.Tenant, expectedTenantId),
+			}, nil).Times(5)
+
+			for _, request := range []*api.APITokenRequest{
+				{Scopes: []string{"write:tenant/" + uuid.New().String()}},
+				// tenant admins can't update or delete their tenant or bind clusters to it
+				{Scopes: []string{"write:tenant/" + expectedTenantId}},
+				{Scopes: []string{"write:command/" + string(commands.CreateUserRoleBinding)}},
+				{Scopes: []string{"read:clusters"}},
+				{AuthorizationScopes: []api.AuthorizationScope{api.AuthorizationScope_API}},
+			} {
+				request.User = &api.APITokenRequest_Username{Username: "tenant-ci"}
+				request.Validity = durationpb.New(expectedValidity)
+				uc := NewGenerateAPITokenUsecase(request, new(api.APITokenResponse), jwtTestEnv.CreateSigner(), userRepo, roleBindingRepo, expectedIssuer)
+				Expect(uc.Run(ctxWithUser)).To(HaveOccurred())
+			}
+		})
+
+		It("rejects invalid scopes", func() {
+			userRepo := mock_repositories.NewMockUserRepository(mockCtrl)
+			roleBindingRepo := mock_repositories.NewMockUserRoleBindingRepository(mockCtrl)
+
+			request := &api.APITokenRequest{
+				Scopes:   []string{"write:auditlog"},
+				User:     &api.APITokenRequest_Username{Username: "tenant-ci"},
+				Validity: durationpb.New(expectedValidity),
+			}
+			uc := NewGenerateAPITokenUsecase(request, new(api.APITokenResponse), jwtTestEnv.CreateSigner(), userRepo, roleBindingRepo, expectedIssuer)
+			Expect(uc.Run(mdManager.GetContext())).To(HaveOccurred())
+		})
+	})
+
 })
@@ -111,6 +111,12 @@ func (s *getAuthTokenUsecase) Run(ctx context.Context) error {
 		username = fmt.Sprintf("%s-%s", username, s.request.GetRole())
 	}
 
+	// custom roles without a configured validity use the validity of the default role
+	validity, ok := s.validity[s.request.Role]
+	if !ok {
+		validity = s.validity[string(k8s.DefaultRole)]
+	}
+
 	s.Log.V(logger.DebugLevel).Info("Generating token for k8s user...", "username", username)
 	token := auth.NewKubernetesAuthToken(&jwt.StandardClaims{
 		Name:          userInfo.Name,
@@ -121,7 +127,7 @@ func (s *getAuthTokenUsecase) Run(ctx context.Context) error {
 		ClusterName:     foundClusterAccess.Cluster.GetName(),
 		ClusterUserName: username,
 		ClusterRole:     s.request.Role,
-	}, s.issuer, userInfo.Id.String(), s.validity[s.request.Role])
+	}, s.issuer, userInfo.Id.String(), validity)
 	s.Log.V(logger.DebugLevel).Info("Token issued successfully.", "RawToken", token, "Expiry", token.Expiry.Time().String())
 
 	signedToken, err := s.signer.GenerateSignedToken(token)
@@ -0,0 +1,95 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/finleap-connect/monoskope/pkg/git"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	DefaultTimeout  = 60 * time.Second
+	DefaultInterval = 10 * time.Minute
+	DefaultPath     = "monoskope.yaml"
+)
+
+var (
+	ErrRepositoryIsRequired = errors.New("repository is required")
+)
+
+// Config is the configuration for the Reconciler.
+type Config struct {
+	// Interval is an optional field to specify the interval at which the desired state is reconciled continuously. Defaults to 10m.
+	Interval *time.Duration `yaml:"interval"`
+	// Repository is the git config to use
+	Repository *git.GitConfig `yaml:"repository"`
+	// Path is the relative path of the desired state file within the repo. Defaults to monoskope.yaml.
+	Path string `yaml:"path"`
+	// Prune is an optional field to specify if tenants, clusters, users and bindings missing in the desired state should be deleted. Defaults to false.
+	Prune bool `yaml:"prune"`
+}
+
+// NewConfigFromFilePath creates a new Config from a given yaml file path
+func NewConfigFromFilePath(name string) (*Config, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewConfigFromFile(data)
+}
+
+// NewConfigFromFile creates a new Config from a given yaml file
+func NewConfigFromFile(data []byte) (*Config, error) {
+	conf := &Config{}
+	if err := yaml.Unmarshal(data, conf); err != nil {
+		return nil, err
+	}
+
+	if err := conf.validate(); err != nil {
+		return nil, err
+	}
+
+	conf.setDefaults()
+
+	return conf, nil
+}
+
+// validate validates the configuration
+func (c *Config) validate() error {
+	if c.Repository == nil {
+		return ErrRepositoryIsRequired
+	}
+	return c.Repository.Validate()
+}
+
+// setDefaults sets the default values on the configuration
+func (c *Config) setDefaults() {
+	if len(c.Path) == 0 {
+		c.Path = DefaultPath
+	}
+	if c.Interval == nil {
+		interval := DefaultInterval
+		c.Interval = &interval
+	}
+	if c.Repository.Timeout == nil {
+		timeout := DefaultTimeout
+		c.Repository.Timeout = &timeout
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	_ "embed"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+//go:embed test_config.yaml
+var testConfig []byte
+
+var _ = Describe("internal/gitops", func() {
+	Context("Config", func() {
+		It("NewConfigFromFile() creates a new instance with defaults", func() {
+			conf, err := NewConfigFromFile(testConfig)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.Path).To(Equal("platform/monoskope.yaml"))
+			Expect(conf.Prune).To(BeTrue())
+			Expect(*conf.Interval).To(Equal(5 * time.Minute))
+			Expect(*conf.Repository.Timeout).To(Equal(DefaultTimeout))
+		})
+		It("NewConfigFromFile() fails without repository", func() {
+			_, err := NewConfigFromFile([]byte("path: monoskope.yaml"))
+			Expect(err).To(Equal(ErrRepositoryIsRequired))
+		})
+	})
+})
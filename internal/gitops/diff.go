@@ -0,0 +1,479 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	cmdData "github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/common"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/projections"
+	"github.com/finleap-connect/monoskope/pkg/client"
+	commandTypes "github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/users"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Diff computes the plan reconciling the current state of Monoskope queried via the client with the desired state.
+// Tenants, clusters, users and bindings missing in the desired state are only deleted if prune is set.
+func Diff(ctx context.Context, c client.Client, state *State, prune bool) (*Plan, error) {
+	d := &differ{
+		client:     c,
+		prune:      prune,
+		plan:       &Plan{},
+		tenantIds:  make(map[string]uuid.UUID),
+		clusterIds: make(map[string]uuid.UUID),
+	}
+	if err := d.diff(ctx, state); err != nil {
+		return nil, err
+	}
+	return d.plan, nil
+}
+
+// differ collects the changes of a plan. Objects are created and updated before they are referenced and
+// deleted after all references to them are deleted.
+type differ struct {
+	client client.Client
+	prune  bool
+	plan   *Plan
+
+	tenants  []*projections.Tenant
+	clusters []*projections.Cluster
+	users    []*projections.User
+
+	// ids of the desired tenants and clusters by name, either existing ones or the ones they are created with
+	tenantIds  map[string]uuid.UUID
+	clusterIds map[string]uuid.UUID
+
+	// deletions are added to the plan after all other changes
+	bindingDeletions     []func()
+	roleBindingDeletions []func()
+	userDeletions        []func()
+	clusterDeletions     []func()
+	tenantDeletions      []func()
+}
+
+func (d *differ) diff(ctx context.Context, state *State) error {
+	var err error
+	if d.tenants, err = client.Collect(d.client.Tenants(ctx, false)); err != nil {
+		return fmt.Errorf("failed to query tenants: %w", err)
+	}
+	if d.clusters, err = client.Collect(d.client.Clusters(ctx, false, "")); err != nil {
+		return fmt.Errorf("failed to query clusters: %w", err)
+	}
+	if d.users, err = client.Collect(d.client.Users(ctx, false)); err != nil {
+		return fmt.Errorf("failed to query users: %w", err)
+	}
+
+	if err := d.diffTenants(state.Tenants); err != nil {
+		return err
+	}
+	d.diffClusters(state.Clusters)
+	if err := d.diffUsers(ctx, state.Users); err != nil {
+		return err
+	}
+	if err := d.diffTenantClusterBindings(ctx, state.TenantClusterBindings); err != nil {
+		return err
+	}
+
+	for _, deletions := range [][]func(){d.bindingDeletions, d.roleBindingDeletions, d.userDeletions, d.clusterDeletions, d.tenantDeletions} {
+		for _, deletion := range deletions {
+			deletion()
+		}
+	}
+	return nil
+}
+
+// delete schedules the deletion of the object with the given id if pruning is enabled.
+func (d *differ) delete(deletions *[]func(), kind, name string, commandType es.CommandType, id string) {
+	if !d.prune {
+		return
+	}
+	*deletions = append(*deletions, func() {
+		d.plan.add(ActionDelete, kind, name, client.BatchCommand{AggregateId: uuid.MustParse(id), CommandType: commandType})
+	})
+}
+
+func (d *differ) diffTenants(desired []*Tenant) error {
+	existing := make(map[string]*projections.Tenant)
+	for _, t := range d.tenants {
+		existing[t.Name] = t
+	}
+
+	// Create and update parents before their children
+	byName := make(map[string]*Tenant)
+	for _, t := range desired {
+		byName[t.Name] = t
+	}
+	var diffTenant func(t *Tenant) error
+	diffTenant = func(t *Tenant) error {
+		if _, ok := d.tenantIds[t.Name]; ok {
+			return nil
+		}
+		parentId := ""
+		if t.Parent != "" {
+			if err := diffTenant(byName[t.Parent]); err != nil {
+				return err
+			}
+			parentId = d.tenantIds[t.Parent].String()
+		}
+
+		current, ok := existing[t.Name]
+		if !ok {
+			id := uuid.New()
+			d.tenantIds[t.Name] = id
+			d.plan.add(ActionCreate, KindTenant, t.Name, client.BatchCommand{AggregateId: id, CommandType: commandTypes.CreateTenant, Data: &cmdData.CreateTenantCommandData{
+				Name:               t.Name,
+				Prefix:             t.Prefix,
+				ParentId:           parentId,
+				CostCentre:         t.CostCentre,
+				Contact:            t.Contact,
+				MaxClusterBindings: t.MaxClusterBindings,
+			}})
+			return nil
+		}
+
+		d.tenantIds[t.Name] = uuid.MustParse(current.Id)
+		if current.Prefix != t.Prefix {
+			return fmt.Errorf("prefix of tenant %q can not be changed from %q to %q", t.Name, current.Prefix, t.Prefix)
+		}
+		data := &cmdData.UpdateTenantCommandData{}
+		if current.ParentId != parentId {
+			data.ParentId = wrapperspb.String(parentId)
+		}
+		if current.CostCentre != t.CostCentre {
+			data.CostCentre = wrapperspb.String(t.CostCentre)
+		}
+		if current.Contact != t.Contact {
+			data.Contact = wrapperspb.String(t.Contact)
+		}
+		if current.MaxClusterBindings != t.MaxClusterBindings {
+			data.MaxClusterBindings = wrapperspb.UInt32(t.MaxClusterBindings)
+		}
+		d.update(KindTenant, t.Name, current.Id, commandTypes.UpdateTenant, data)
+		return nil
+	}
+	for _, t := range desired {
+		if err := diffTenant(t); err != nil {
+			return err
+		}
+	}
+
+	// Delete children before their parents
+	var obsolete []*projections.Tenant
+	for _, t := range d.tenants {
+		if _, ok := d.tenantIds[t.Name]; !ok {
+			obsolete = append(obsolete, t)
+		}
+	}
+	depths := make(map[string]int)
+	for _, t := range d.tenants {
+		for parentId := t.ParentId; parentId != ""; parentId = d.tenantById(parentId).GetParentId() {
+			depths[t.Id]++
+			if depths[t.Id] > len(d.tenants) {
+				return fmt.Errorf("tenant %q is its own ancestor", t.Name)
+			}
+		}
+	}
+	sort.SliceStable(obsolete, func(i, j int) bool {
+		return depths[obsolete[i].Id] > depths[obsolete[j].Id]
+	})
+	for _, t := range obsolete {
+		d.delete(&d.tenantDeletions, KindTenant, t.Name, commandTypes.DeleteTenant, t.Id)
+	}
+	return nil
+}
+
+func (d *differ) diffClusters(desired []*Cluster) {
+	existing := make(map[string]*projections.Cluster)
+	for _, c := range d.clusters {
+		existing[c.Name] = c
+	}
+
+	for _, c := range desired {
+		current, ok := existing[c.Name]
+		if !ok {
+			id := uuid.New()
+			d.clusterIds[c.Name] = id
+			d.plan.add(ActionCreate, KindCluster, c.Name, client.BatchCommand{AggregateId: id, CommandType: commandTypes.CreateCluster, Data: &cmdData.CreateCluster{
+				Name:             c.Name,
+				ApiServerAddress: c.ApiServerAddress,
+				CaCertBundle:     []byte(c.CaCertBundle),
+				Description:      c.Description,
+				Labels:           &cmdData.ClusterLabels{Values: c.Labels},
+			}})
+			continue
+		}
+
+		d.clusterIds[c.Name] = uuid.MustParse(current.Id)
+		data := &cmdData.UpdateCluster{}
+		if current.ApiServerAddress != c.ApiServerAddress {
+			data.ApiServerAddress = wrapperspb.String(c.ApiServerAddress)
+		}
+		if !bytes.Equal(current.CaCertBundle, []byte(c.CaCertBundle)) {
+			data.CaCertBundle = []byte(c.CaCertBundle)
+		}
+		if current.Description != c.Description {
+			data.Description = wrapperspb.String(c.Description)
+		}
+		if !equalLabels(current.Labels, c.Labels) {
+			data.Labels = &cmdData.ClusterLabels{Values: c.Labels}
+		}
+		d.update(KindCluster, c.Name, current.Id, commandTypes.UpdateCluster, data)
+	}
+
+	for _, c := range d.clusters {
+		if _, ok := d.clusterIds[c.Name]; !ok {
+			d.delete(&d.clusterDeletions, KindCluster, c.Name, commandTypes.DeleteCluster, c.Id)
+		}
+	}
+}
+
+func (d *differ) diffUsers(ctx context.Context, desired []*User) error {
+	existing := make(map[string]*projections.User)
+	for _, u := range d.users {
+		existing[u.Email] = u
+	}
+
+	desiredEmails := make(map[string]bool)
+	for _, u := range desired {
+		desiredEmails[u.Email] = true
+
+		var userId uuid.UUID
+		var roleBindings []*projections.UserRoleBinding
+		current, ok := existing[u.Email]
+		if !ok {
+			userId = uuid.New()
+			d.plan.add(ActionCreate, KindUser, u.Email, client.BatchCommand{AggregateId: userId, CommandType: commandTypes.CreateUser, Data: &cmdData.CreateUserCommandData{
+				Email: u.Email,
+				Name:  u.Name,
+			}})
+		} else {
+			userId = uuid.MustParse(current.Id)
+			data := &cmdData.UpdateUserCommandData{}
+			if current.Name != u.Name {
+				data.Name = wrapperspb.String(u.Name)
+			}
+			d.update(KindUser, u.Email, current.Id, commandTypes.UpdateUser, data)
+
+			var err error
+			if roleBindings, err = client.Collect(d.client.RoleBindings(ctx, userId)); err != nil {
+				return fmt.Errorf("failed to query role bindings of user %q: %w", u.Email, err)
+			}
+		}
+
+		existingRoles := make(map[string]*projections.UserRoleBinding)
+		for _, rb := range roleBindings {
+			existingRoles[roleBindingKey(rb.Role, rb.Scope, rb.Resource)] = rb
+		}
+		desiredRoles := make(map[string]bool)
+		for _, r := range u.Roles {
+			resource := d.resourceId(r.Scope, r.Resource)
+			key := roleBindingKey(r.Role, r.Scope, resource)
+			if desiredRoles[key] {
+				continue
+			}
+			desiredRoles[key] = true
+			if _, ok := existingRoles[key]; ok {
+				continue
+			}
+
+			data := &cmdData.CreateUserRoleBindingCommandData{UserId: userId.String(), Role: r.Role, Scope: r.Scope}
+			if resource != "" {
+				data.Resource = wrapperspb.String(resource)
+			}
+			d.plan.add(ActionCreate, KindRoleBinding, d.roleBindingName(u.Email, r.Role, r.Scope, resource), client.BatchCommand{
+				AggregateId: uuid.New(),
+				CommandType: commandTypes.CreateUserRoleBinding,
+				Data:        data,
+			})
+		}
+		for _, rb := range roleBindings {
+			if !desiredRoles[roleBindingKey(rb.Role, rb.Scope, rb.Resource)] {
+				d.delete(&d.roleBindingDeletions, KindRoleBinding, d.roleBindingName(u.Email, rb.Role, rb.Scope, rb.Resource), commandTypes.DeleteUserRoleBinding, rb.Id)
+			}
+		}
+	}
+
+	if !d.prune {
+		return nil
+	}
+	for _, u := range d.users {
+		// System users are managed by Monoskope itself and users provisioned via SCIM by the identity provider
+		if _, ok := users.AvailableSystemUsers[uuid.MustParse(u.Id)]; ok || u.Source == common.UserSource_SCIM || desiredEmails[u.Email] {
+			continue
+		}
+		roleBindings, err := client.Collect(d.client.RoleBindings(ctx, uuid.MustParse(u.Id)))
+		if err != nil {
+			return fmt.Errorf("failed to query role bindings of user %q: %w", u.Email, err)
+		}
+		for _, rb := range roleBindings {
+			d.delete(&d.roleBindingDeletions, KindRoleBinding, d.roleBindingName(u.Email, rb.Role, rb.Scope, rb.Resource), commandTypes.DeleteUserRoleBinding, rb.Id)
+		}
+		d.delete(&d.userDeletions, KindUser, u.Email, commandTypes.DeleteUser, u.Id)
+	}
+	return nil
+}
+
+func (d *differ) diffTenantClusterBindings(ctx context.Context, desired []*TenantClusterBinding) error {
+	existing := make(map[string]*projections.TenantClusterBinding)
+	var bindings []*projections.TenantClusterBinding
+	for _, t := range d.tenants {
+		tenantBindings, err := client.Collect(d.client.TenantClusterBindings(ctx, uuid.MustParse(t.Id)))
+		if err != nil {
+			return fmt.Errorf("failed to query cluster bindings of tenant %q: %w", t.Name, err)
+		}
+		for _, b := range tenantBindings {
+			existing[bindingKey(b.TenantId, b.ClusterId, b.ClusterSelector)] = b
+		}
+		bindings = append(bindings, tenantBindings...)
+	}
+
+	desiredBindings := make(map[string]bool)
+	for _, b := range desired {
+		tenantId := d.tenantIds[b.Tenant].String()
+		clusterId := ""
+		if b.Cluster != "" {
+			clusterId = d.clusterIds[b.Cluster].String()
+		}
+		key := bindingKey(tenantId, clusterId, b.ClusterSelector)
+		if desiredBindings[key] {
+			continue
+		}
+		desiredBindings[key] = true
+		if _, ok := existing[key]; ok {
+			continue
+		}
+		d.plan.add(ActionCreate, KindTenantClusterBinding, d.bindingName(tenantId, clusterId, b.ClusterSelector), client.BatchCommand{
+			AggregateId: uuid.New(),
+			CommandType: commandTypes.CreateTenantClusterBinding,
+			Data: &cmdData.CreateTenantClusterBindingCommandData{
+				TenantId:        tenantId,
+				ClusterId:       clusterId,
+				ClusterSelector: b.ClusterSelector,
+			},
+		})
+	}
+
+	for _, b := range bindings {
+		if !desiredBindings[bindingKey(b.TenantId, b.ClusterId, b.ClusterSelector)] {
+			d.delete(&d.bindingDeletions, KindTenantClusterBinding, d.bindingName(b.TenantId, b.ClusterId, b.ClusterSelector), commandTypes.DeleteTenantClusterBinding, b.Id)
+		}
+	}
+	return nil
+}
+
+// update adds an update to the plan if any field of the data is set.
+func (d *differ) update(kind, name, id string, commandType es.CommandType, data proto.Message) {
+	if proto.Size(data) == 0 {
+		return
+	}
+	d.plan.add(ActionUpdate, kind, name, client.BatchCommand{AggregateId: uuid.MustParse(id), CommandType: commandType, Data: data})
+}
+
+// resourceId returns the id of the tenant or cluster with the given name for roles of these scopes.
+func (d *differ) resourceId(scope, name string) string {
+	switch scope {
+	case string(scopes.Tenant):
+		return d.tenantIds[name].String()
+	case string(scopes.Cluster):
+		return d.clusterIds[name].String()
+	}
+	return ""
+}
+
+func (d *differ) tenantById(id string) *projections.Tenant {
+	for _, t := range d.tenants {
+		if t.Id == id {
+			return t
+		}
+	}
+	return nil
+}
+
+// tenantName returns the name of the tenant with the given id, either a desired or an existing one.
+func (d *differ) tenantName(id string) string {
+	for name, tenantId := range d.tenantIds {
+		if tenantId.String() == id {
+			return name
+		}
+	}
+	if t := d.tenantById(id); t != nil {
+		return t.Name
+	}
+	return id
+}
+
+// clusterName returns the name of the cluster with the given id, either a desired or an existing one.
+func (d *differ) clusterName(id string) string {
+	for name, clusterId := range d.clusterIds {
+		if clusterId.String() == id {
+			return name
+		}
+	}
+	for _, c := range d.clusters {
+		if c.Id == id {
+			return c.Name
+		}
+	}
+	return id
+}
+
+func (d *differ) roleBindingName(email, role, scope, resource string) string {
+	switch scope {
+	case string(scopes.Tenant):
+		resource = d.tenantName(resource)
+	case string(scopes.Cluster):
+		resource = d.clusterName(resource)
+	}
+	if resource == "" {
+		return fmt.Sprintf("%s: %s/%s", email, scope, role)
+	}
+	return fmt.Sprintf("%s: %s/%s on %s", email, scope, role, resource)
+}
+
+func (d *differ) bindingName(tenantId, clusterId, clusterSelector string) string {
+	if clusterId != "" {
+		return fmt.Sprintf("%s to cluster %s", d.tenantName(tenantId), d.clusterName(clusterId))
+	}
+	return fmt.Sprintf("%s to clusters %q", d.tenantName(tenantId), clusterSelector)
+}
+
+func roleBindingKey(role, scope, resource string) string {
+	return fmt.Sprintf("%s/%s/%s", scope, role, resource)
+}
+
+func bindingKey(tenantId, clusterId, clusterSelector string) string {
+	return fmt.Sprintf("%s/%s/%s", tenantId, clusterId, clusterSelector)
+}
+
+func equalLabels(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+	return true
+}
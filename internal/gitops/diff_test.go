@@ -0,0 +1,164 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"context"
+
+	cmdData "github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
+	sdk "github.com/finleap-connect/monoskope/pkg/client"
+	"github.com/finleap-connect/monoskope/pkg/client/fake"
+	commandTypes "github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("internal/gitops", func() {
+	Context("Diff", func() {
+		ctx := context.Background()
+
+		var client *fake.Client
+		var state *State
+
+		BeforeEach(func() {
+			var err error
+			client = fake.NewClient()
+			state, err = NewStateFromFile(testState)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		changes := func(plan *Plan) []string {
+			var result []string
+			for _, change := range plan.Changes {
+				result = append(result, change.String())
+			}
+			return result
+		}
+
+		It("creates everything in order of references", func() {
+			plan, err := Diff(ctx, client, state, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes(plan)).To(Equal([]string{
+				"create tenant platform",
+				"create tenant payments",
+				"create cluster dev-1",
+				"create user jane.doe@monoskope.io",
+				"create role binding jane.doe@monoskope.io: tenant/admin on payments",
+				"create role binding jane.doe@monoskope.io: cluster/oncall on dev-1",
+				"create tenant cluster binding payments to cluster dev-1",
+				`create tenant cluster binding platform to clusters "env=dev"`,
+			}))
+
+			platformId := plan.Changes[0].Command.AggregateId
+			paymentsId := plan.Changes[1].Command.AggregateId
+			Expect(plan.Changes[1].Command.Data.(*cmdData.CreateTenantCommandData).ParentId).To(Equal(platformId.String()))
+			Expect(plan.Changes[4].Command.Data.(*cmdData.CreateUserRoleBindingCommandData).Resource.GetValue()).To(Equal(paymentsId.String()))
+			Expect(plan.Changes[4].Command.Data.(*cmdData.CreateUserRoleBindingCommandData).UserId).To(Equal(plan.Changes[3].Command.AggregateId.String()))
+		})
+		It("is empty if the state matches", func() {
+			Expect(Apply(ctx, client, &Plan{})).To(Succeed())
+
+			platformId, err := client.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "platform", Prefix: "pf", Contact: "platform@monoskope.io"})
+			Expect(err).ToNot(HaveOccurred())
+			paymentsId, err := client.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "payments", Prefix: "pay", ParentId: platformId.String()})
+			Expect(err).ToNot(HaveOccurred())
+			clusterId, err := client.CreateCluster(ctx, &cmdData.CreateCluster{
+				Name:             "dev-1",
+				ApiServerAddress: "https://dev-1.monoskope.io",
+				CaCertBundle:     []byte(state.Clusters[0].CaCertBundle),
+				Labels:           &cmdData.ClusterLabels{Values: map[string]string{"env": "dev"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			userId, err := client.CreateUser(ctx, "jane.doe@monoskope.io", "Jane Doe")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = client.GrantRole(ctx, userId, "admin", "tenant", paymentsId.String())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = client.GrantRole(ctx, userId, "oncall", "cluster", clusterId.String())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = client.BindTenantToCluster(ctx, paymentsId, clusterId)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = client.BindTenantToClusters(ctx, platformId, "env=dev")
+			Expect(err).ToNot(HaveOccurred())
+
+			plan, err := Diff(ctx, client, state, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.IsEmpty()).To(BeTrue())
+			Expect(plan.String()).To(Equal("No changes."))
+		})
+		It("updates changed fields only", func() {
+			platformId, err := client.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "platform", Prefix: "pf", Contact: "ops@monoskope.io"})
+			Expect(err).ToNot(HaveOccurred())
+
+			plan, err := Diff(ctx, client, state, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes(plan)[0]).To(Equal("update tenant platform"))
+
+			update := plan.Changes[0]
+			Expect(update.Command.AggregateId).To(Equal(platformId))
+			Expect(update.Command.CommandType).To(Equal(commandTypes.UpdateTenant))
+			data := update.Command.Data.(*cmdData.UpdateTenantCommandData)
+			Expect(data.Contact.GetValue()).To(Equal("platform@monoskope.io"))
+			Expect(data.Name).To(BeNil())
+			Expect(data.ParentId).To(BeNil())
+		})
+		It("fails to change the prefix of a tenant", func() {
+			_, err := client.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "platform", Prefix: "plat"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = Diff(ctx, client, state, false)
+			Expect(err).To(MatchError(ContainSubstring(`prefix of tenant "platform" can not be changed`)))
+		})
+		It("deletes obsolete objects only when pruning", func() {
+			parentId, err := client.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "legacy", Prefix: "leg"})
+			Expect(err).ToNot(HaveOccurred())
+			childId, err := client.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "legacy-child", Prefix: "legc", ParentId: parentId.String()})
+			Expect(err).ToNot(HaveOccurred())
+			userId, err := client.CreateUser(ctx, "john.doe@monoskope.io", "John Doe")
+			Expect(err).ToNot(HaveOccurred())
+			roleBindingId, err := client.GrantRole(ctx, userId, "user", "tenant", childId.String())
+			Expect(err).ToNot(HaveOccurred())
+			state = &State{}
+
+			plan, err := Diff(ctx, client, state, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.IsEmpty()).To(BeTrue())
+
+			plan, err = Diff(ctx, client, state, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes(plan)).To(Equal([]string{
+				"delete role binding john.doe@monoskope.io: tenant/user on legacy-child",
+				"delete user john.doe@monoskope.io",
+				"delete tenant legacy-child",
+				"delete tenant legacy",
+			}))
+			Expect(plan.Changes[0].Command.AggregateId).To(Equal(roleBindingId))
+			Expect(plan.Changes[3].Command.AggregateId).To(Equal(parentId))
+			Expect(plan.String()).To(HavePrefix("- delete role binding"))
+		})
+		It("applies plans in batches", func() {
+			plan := &Plan{Commit: "0123456789abcdef"}
+			for i := 0; i < MaxBatchSize+1; i++ {
+				plan.add(ActionCreate, KindUser, "user", sdk.BatchCommand{
+					AggregateId: uuid.New(),
+					CommandType: commandTypes.CreateUser,
+					Data:        &cmdData.CreateUserCommandData{Email: "jane.doe@monoskope.io", Name: "Jane Doe"},
+				})
+			}
+			Expect(Apply(ctx, client, plan)).To(Succeed())
+			Expect(client.Commands()).To(HaveLen(MaxBatchSize + 1))
+		})
+	})
+})
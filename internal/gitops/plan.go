@@ -0,0 +1,105 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/finleap-connect/monoskope/pkg/client"
+)
+
+// Action is the kind of a change.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Kinds of objects changed by a plan.
+const (
+	KindTenant               = "tenant"
+	KindCluster              = "cluster"
+	KindUser                 = "user"
+	KindRoleBinding          = "role binding"
+	KindTenantClusterBinding = "tenant cluster binding"
+)
+
+// Change is a single change of a plan.
+type Change struct {
+	// Action is the kind of the change
+	Action Action
+	// Kind is the kind of the changed object, e.g. "tenant"
+	Kind string
+	// Name identifies the changed object for humans
+	Name string
+	// Command is the command executing the change
+	Command client.BatchCommand
+}
+
+// String returns a human readable description of the change.
+func (c *Change) String() string {
+	return fmt.Sprintf("%s %s %s", c.Action, c.Kind, c.Name)
+}
+
+// Plan are the changes required to reconcile Monoskope with the desired state in the order they have to be executed.
+type Plan struct {
+	// Commit is the hash of the commit the desired state has been read from, empty if unknown
+	Commit string
+	// Changes are the changes to execute
+	Changes []*Change
+}
+
+// IsEmpty returns true if Monoskope matches the desired state.
+func (p *Plan) IsEmpty() bool {
+	return len(p.Changes) == 0
+}
+
+// Commands returns the commands executing the changes of the plan.
+func (p *Plan) Commands() []client.BatchCommand {
+	cmds := make([]client.BatchCommand, 0, len(p.Changes))
+	for _, change := range p.Changes {
+		cmds = append(cmds, change.Command)
+	}
+	return cmds
+}
+
+// String returns a human readable description of the plan with one change per line.
+func (p *Plan) String() string {
+	if p.IsEmpty() {
+		return "No changes."
+	}
+	var sb strings.Builder
+	for _, change := range p.Changes {
+		switch change.Action {
+		case ActionCreate:
+			sb.WriteString("+ ")
+		case ActionUpdate:
+			sb.WriteString("~ ")
+		case ActionDelete:
+			sb.WriteString("- ")
+		}
+		sb.WriteString(change.String())
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// add appends a change to the plan.
+func (p *Plan) add(action Action, kind, name string, cmd client.BatchCommand) {
+	p.Changes = append(p.Changes, &Change{Action: action, Kind: kind, Name: name, Command: cmd})
+}
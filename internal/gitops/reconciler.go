@@ -0,0 +1,122 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/finleap-connect/monoskope/pkg/client"
+	"github.com/finleap-connect/monoskope/pkg/domain/metadata"
+	"github.com/finleap-connect/monoskope/pkg/git"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+)
+
+// MaxBatchSize is the maximum number of commands executed at once. Plans with more changes are applied in several batches.
+const MaxBatchSize = 100
+
+// Reconciler reconciles Monoskope with the desired state defined in a git repo.
+type Reconciler struct {
+	log       logger.Logger
+	config    *Config
+	client    client.Client
+	gitClient *git.GitClient
+	mutex     sync.Mutex
+}
+
+// NewReconciler creates a new Reconciler configured via the given config.
+// The git client has to be cloned already.
+func NewReconciler(config *Config, c client.Client, gitClient *git.GitClient) *Reconciler {
+	return &Reconciler{log: logger.WithName("GitOpsReconciler"), config: config, client: c, gitClient: gitClient}
+}
+
+// Plan pulls the latest desired state and computes the changes required to reconcile Monoskope with it.
+func (r *Reconciler) Plan(ctx context.Context) (*Plan, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.plan(ctx)
+}
+
+// Reconcile pulls the latest desired state and applies the changes required to reconcile Monoskope with it.
+func (r *Reconciler) Reconcile(ctx context.Context) (*Plan, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	plan, err := r.plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if plan.IsEmpty() {
+		r.log.Info("Nothing to reconcile.", "commit", plan.Commit)
+		return plan, nil
+	}
+
+	r.log.Info("Applying changes...", "commit", plan.Commit, "changes", len(plan.Changes))
+	if err := Apply(ctx, r.client, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (r *Reconciler) plan(ctx context.Context) (*Plan, error) {
+	r.log.Info("Pulling latest changes..")
+	if err := r.gitClient.Pull(ctx); err != nil {
+		return nil, err
+	}
+	commit, err := r.gitClient.Head(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := NewStateFromFilePath(filepath.Join(r.gitClient.GetLocalDirectory(), r.config.Path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read desired state: %w", err)
+	}
+
+	r.log.Info("Computing changes...", "commit", commit)
+	plan, err := Diff(ctx, r.client, state, r.config.Prune)
+	if err != nil {
+		return nil, err
+	}
+	plan.Commit = commit
+	return plan, nil
+}
+
+// Apply executes the changes of the plan. The commit of the plan is recorded in the metadata of the emitted events.
+// Each batch of up to MaxBatchSize changes is executed atomically.
+func Apply(ctx context.Context, c client.Client, plan *Plan) error {
+	if plan.Commit != "" {
+		ctx = metadata.WithGitOpsCommit(ctx, plan.Commit)
+	}
+
+	cmds := plan.Commands()
+	for start := 0; start < len(cmds); start += MaxBatchSize {
+		end := start + MaxBatchSize
+		if end > len(cmds) {
+			end = len(cmds)
+		}
+		if _, err := c.ExecuteBatch(ctx, cmds[start:end], false); err != nil {
+			var batchErr *client.BatchError
+			if errors.As(err, &batchErr) {
+				return fmt.Errorf("failed to %s: %w", plan.Changes[start+batchErr.Index], batchErr.Err)
+			}
+			return err
+		}
+	}
+	return nil
+}
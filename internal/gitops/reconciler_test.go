@@ -0,0 +1,90 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/finleap-connect/monoskope/pkg/client/fake"
+	"github.com/finleap-connect/monoskope/pkg/git"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("internal/gitops", func() {
+	Context("Reconciler", func() {
+		ctx := context.Background()
+
+		var originDir string
+		var origin *gogit.Repository
+		var gitClient *git.GitClient
+
+		commitState := func(data []byte) string {
+			Expect(os.WriteFile(filepath.Join(originDir, DefaultPath), data, 0644)).To(Succeed())
+			wt, err := origin.Worktree()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = wt.Add(DefaultPath)
+			Expect(err).ToNot(HaveOccurred())
+			hash, err := wt.Commit("update desired state", &gogit.CommitOptions{Author: &object.Signature{Name: "test"}})
+			Expect(err).ToNot(HaveOccurred())
+			return hash.String()
+		}
+
+		BeforeEach(func() {
+			var err error
+			originDir, err = os.MkdirTemp("", "m8-gitops-origin")
+			Expect(err).ToNot(HaveOccurred())
+			origin, err = gogit.PlainInit(originDir, false)
+			Expect(err).ToNot(HaveOccurred())
+			commitState([]byte{})
+
+			gitConfig, err := git.NewGitConfig(originDir, &git.GitAuthor{Name: "test", Email: "test@monoskope.io"})
+			Expect(err).ToNot(HaveOccurred())
+			gitClient, err = git.NewGitClient(gitConfig)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(gitClient.Clone(ctx)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(gitClient.Close()).To(Succeed())
+			Expect(os.RemoveAll(originDir)).To(Succeed())
+		})
+
+		It("reconciles the latest commit", func() {
+			client := fake.NewClient()
+			reconciler := NewReconciler(&Config{Path: DefaultPath}, client, gitClient)
+
+			plan, err := reconciler.Reconcile(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.IsEmpty()).To(BeTrue())
+
+			commit := commitState(testState)
+			plan, err = reconciler.Plan(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.Commit).To(Equal(commit))
+			Expect(plan.Changes).To(HaveLen(8))
+			Expect(client.Commands()).To(BeEmpty())
+
+			plan, err = reconciler.Reconcile(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.Commit).To(Equal(commit))
+			Expect(client.Commands()).To(HaveLen(8))
+		})
+	})
+})
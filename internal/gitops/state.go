@@ -0,0 +1,181 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
+	"gopkg.in/yaml.v2"
+)
+
+// State is the desired state of Monoskope as defined in the repository.
+type State struct {
+	// Tenants are the desired tenants
+	Tenants []*Tenant `yaml:"tenants"`
+	// Clusters are the desired clusters
+	Clusters []*Cluster `yaml:"clusters"`
+	// Users are the desired users and their roles
+	Users []*User `yaml:"users"`
+	// TenantClusterBindings are the desired bindings of tenants to clusters
+	TenantClusterBindings []*TenantClusterBinding `yaml:"tenantClusterBindings"`
+}
+
+// Tenant is the desired state of a tenant, identified by its name.
+type Tenant struct {
+	Name   string `yaml:"name"`
+	Prefix string `yaml:"prefix"`
+	// Parent is the name of the parent tenant, optional
+	Parent             string `yaml:"parent"`
+	CostCentre         string `yaml:"costCentre"`
+	Contact            string `yaml:"contact"`
+	MaxClusterBindings uint32 `yaml:"maxClusterBindings"`
+}
+
+// Cluster is the desired state of a cluster, identified by its name.
+type Cluster struct {
+	Name             string            `yaml:"name"`
+	ApiServerAddress string            `yaml:"apiServerAddress"`
+	CaCertBundle     string            `yaml:"caCertBundle"`
+	Description      string            `yaml:"description"`
+	Labels           map[string]string `yaml:"labels"`
+}
+
+// User is the desired state of a user, identified by its email address.
+type User struct {
+	Email string  `yaml:"email"`
+	Name  string  `yaml:"name"`
+	Roles []*Role `yaml:"roles"`
+}
+
+// Role is a role bound to a user.
+type Role struct {
+	Role  string `yaml:"role"`
+	Scope string `yaml:"scope"`
+	// Resource is the name of the tenant or cluster for roles of these scopes
+	Resource string `yaml:"resource"`
+}
+
+// TenantClusterBinding is a binding of a tenant to a cluster or to all clusters matching a label selector.
+type TenantClusterBinding struct {
+	// Tenant is the name of the tenant
+	Tenant string `yaml:"tenant"`
+	// Cluster is the name of the cluster, either cluster or clusterSelector has to be set
+	Cluster         string `yaml:"cluster"`
+	ClusterSelector string `yaml:"clusterSelector"`
+}
+
+// NewStateFromFilePath reads the desired state from a given yaml file path
+func NewStateFromFilePath(name string) (*State, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewStateFromFile(data)
+}
+
+// NewStateFromFile reads the desired state from a given yaml file
+func NewStateFromFile(data []byte) (*State, error) {
+	state := &State{}
+	if err := yaml.UnmarshalStrict(data, state); err != nil {
+		return nil, err
+	}
+	// PEM bundles are usually written as literal blocks ending with a line break
+	for _, c := range state.Clusters {
+		c.CaCertBundle = strings.TrimSpace(c.CaCertBundle)
+	}
+	if err := state.validate(); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// validate checks that names are unique and that all references can be resolved within the state.
+// The data itself is validated by Monoskope when the commands are executed.
+func (s *State) validate() error {
+	tenants := make(map[string]*Tenant)
+	for _, t := range s.Tenants {
+		if _, ok := tenants[t.Name]; ok {
+			return fmt.Errorf("tenant %q is defined more than once", t.Name)
+		}
+		tenants[t.Name] = t
+	}
+	for _, t := range s.Tenants {
+		if t.Parent == "" {
+			continue
+		}
+		if _, ok := tenants[t.Parent]; !ok {
+			return fmt.Errorf("parent %q of tenant %q is not defined", t.Parent, t.Name)
+		}
+	}
+	for _, t := range s.Tenants {
+		// Detect cycles by walking up the hierarchy
+		visited := map[string]bool{t.Name: true}
+		for parent := t.Parent; parent != ""; parent = tenants[parent].Parent {
+			if visited[parent] {
+				return fmt.Errorf("tenant %q is its own ancestor", t.Name)
+			}
+			visited[parent] = true
+		}
+	}
+
+	clusters := make(map[string]bool)
+	for _, c := range s.Clusters {
+		if clusters[c.Name] {
+			return fmt.Errorf("cluster %q is defined more than once", c.Name)
+		}
+		clusters[c.Name] = true
+	}
+
+	users := make(map[string]bool)
+	for _, u := range s.Users {
+		if users[u.Email] {
+			return fmt.Errorf("user %q is defined more than once", u.Email)
+		}
+		users[u.Email] = true
+
+		for _, r := range u.Roles {
+			switch r.Scope {
+			case string(scopes.Tenant):
+				if _, ok := tenants[r.Resource]; !ok {
+					return fmt.Errorf("tenant %q of role %q of user %q is not defined", r.Resource, r.Role, u.Email)
+				}
+			case string(scopes.Cluster):
+				if !clusters[r.Resource] {
+					return fmt.Errorf("cluster %q of role %q of user %q is not defined", r.Resource, r.Role, u.Email)
+				}
+			default:
+				if r.Resource != "" {
+					return fmt.Errorf("role %q of user %q must not have a resource in scope %q", r.Role, u.Email, r.Scope)
+				}
+			}
+		}
+	}
+
+	for _, b := range s.TenantClusterBindings {
+		if _, ok := tenants[b.Tenant]; !ok {
+			return fmt.Errorf("tenant %q of cluster binding is not defined", b.Tenant)
+		}
+		if (b.Cluster == "") == (b.ClusterSelector == "") {
+			return fmt.Errorf("cluster binding of tenant %q must have either a cluster or a cluster selector", b.Tenant)
+		}
+		if b.Cluster != "" && !clusters[b.Cluster] {
+			return fmt.Errorf("cluster %q of cluster binding of tenant %q is not defined", b.Cluster, b.Tenant)
+		}
+	}
+	return nil
+}
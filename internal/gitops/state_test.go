@@ -0,0 +1,58 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	_ "embed"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+//go:embed test_state.yaml
+var testState []byte
+
+var _ = Describe("internal/gitops", func() {
+	Context("State", func() {
+		It("NewStateFromFile() reads the desired state", func() {
+			state, err := NewStateFromFile(testState)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Tenants).To(HaveLen(2))
+			Expect(state.Clusters).To(HaveLen(1))
+			Expect(state.Clusters[0].Labels).To(HaveKeyWithValue("env", "dev"))
+			Expect(state.Clusters[0].CaCertBundle).To(HaveSuffix("-----END CERTIFICATE-----"))
+			Expect(state.Users[0].Roles).To(HaveLen(2))
+			Expect(state.TenantClusterBindings).To(HaveLen(2))
+		})
+		It("NewStateFromFile() fails for unknown fields", func() {
+			_, err := NewStateFromFile([]byte("tenants:\n  - name: platform\n    prefx: pf\n"))
+			Expect(err).To(HaveOccurred())
+		})
+		It("NewStateFromFile() fails for undefined references", func() {
+			_, err := NewStateFromFile([]byte("tenants:\n  - name: payments\n    prefix: pay\n    parent: platform\n"))
+			Expect(err).To(MatchError(ContainSubstring(`parent "platform" of tenant "payments" is not defined`)))
+
+			_, err = NewStateFromFile([]byte("users:\n  - email: jane.doe@monoskope.io\n    name: Jane Doe\n    roles:\n      - role: admin\n        scope: cluster\n        resource: dev-1\n"))
+			Expect(err).To(MatchError(ContainSubstring(`cluster "dev-1" of role "admin" of user "jane.doe@monoskope.io" is not defined`)))
+
+			_, err = NewStateFromFile([]byte("tenantClusterBindings:\n  - tenant: platform\n    cluster: dev-1\n"))
+			Expect(err).To(MatchError(ContainSubstring(`tenant "platform" of cluster binding is not defined`)))
+		})
+		It("NewStateFromFile() fails for cyclic tenant hierarchies", func() {
+			_, err := NewStateFromFile([]byte("tenants:\n  - name: a-tenant\n    prefix: a\n    parent: b-tenant\n  - name: b-tenant\n    prefix: b\n    parent: a-tenant\n"))
+			Expect(err).To(MatchError(ContainSubstring("is its own ancestor")))
+		})
+	})
+})
@@ -0,0 +1,85 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sauthz
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	rbacChangeGrant  = "grant"
+	rbacChangeRevoke = "revoke"
+	rbacChangeUpdate = "update"
+
+	// maxUsersInSubject is the maximum number of users named in the subject of commit messages
+	maxUsersInSubject = 3
+	defaultSubject    = "Reconciliation of Monoskope based RBAC."
+)
+
+// rbacChange is a change of the cluster role binding of a user within a cluster
+type rbacChange struct {
+	action      string
+	cluster     string
+	user        string
+	clusterRole string
+}
+
+// String describes the change for humans
+func (c *rbacChange) String() string {
+	switch c.action {
+	case rbacChangeGrant:
+		return fmt.Sprintf("grant %s on %s to %s", c.clusterRole, c.cluster, c.user)
+	case rbacChangeRevoke:
+		return fmt.Sprintf("revoke %s on %s from %s", c.clusterRole, c.cluster, c.user)
+	default:
+		return fmt.Sprintf("update %s on %s of %s", c.clusterRole, c.cluster, c.user)
+	}
+}
+
+// newCommitMessage creates a commit message naming the users whose roles changed in the subject and listing all changes in the body
+func newCommitMessage(changes []*rbacChange) string {
+	if len(changes) == 0 {
+		return defaultSubject
+	}
+
+	var users []string
+	seen := make(map[string]bool)
+	for _, change := range changes {
+		if !seen[change.user] {
+			seen[change.user] = true
+			users = append(users, change.user)
+		}
+	}
+	sort.Strings(users)
+
+	var subject string
+	switch {
+	case len(users) == 1:
+		subject = users[0]
+	case len(users) <= maxUsersInSubject:
+		subject = fmt.Sprintf("%s and %s", strings.Join(users[:len(users)-1], ", "), users[len(users)-1])
+	default:
+		subject = fmt.Sprintf("%s and %d more users", strings.Join(users[:maxUsersInSubject], ", "), len(users)-maxUsersInSubject)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Update RBAC of %s\n\n", subject)
+	for _, change := range changes {
+		fmt.Fprintf(&sb, "- %s\n", change)
+	}
+	return sb.String()
+}
@@ -16,6 +16,7 @@ package k8sauthz
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"time"
 
@@ -29,10 +30,14 @@ const (
 	DefaultTimeout        = 60 * time.Second
 	DefaultInterval       = 10 * time.Minute
 	DefaultUsernamePrefix = "oidc:"
+	DefaultBranchPrefix   = "m8-k8sauthz/"
 )
 
 var (
-	ErrRepositoryIsRequired = errors.New("repository is required")
+	ErrRepositoryIsRequired     = errors.New("repository is required")
+	ErrForgeIsRequired          = errors.New("forge is required for pull requests")
+	ErrMultipleDefaultTargets   = errors.New("only one target may have no clusters")
+	ErrClusterInMultipleTargets = errors.New("cluster is routed to multiple targets")
 )
 
 type ClusterRoleMapping struct {
@@ -58,6 +63,28 @@ type Config struct {
 	Clusters []string `yaml:"clusters"`
 	// SubDir is the relative path within the repo where to reconcile yamls
 	SubDir string `yaml:"subdir"`
+	// Targets is an optional field to route the RBAC of clusters to different repositories or paths. Replaces allClusters, clusters and subdir if set.
+	Targets []*Target `yaml:"targets"`
+	// PullRequest is an optional field to open merge requests for changes instead of committing them to the branch directly.
+	PullRequest *PullRequestConfig `yaml:"pullRequest"`
+}
+
+// Target routes the RBAC of clusters to a repository and path.
+type Target struct {
+	// Clusters is an optional field to specify the clusters routed to this target. All clusters not routed to another target if empty.
+	Clusters []string `yaml:"clusters"`
+	// Repository is an optional field to specify the git config of the repository. Defaults to the repository of the config.
+	Repository *git.GitConfig `yaml:"repository"`
+	// SubDir is the relative path within the repo where to reconcile yamls
+	SubDir string `yaml:"subdir"`
+}
+
+// PullRequestConfig configures how merge requests are opened.
+type PullRequestConfig struct {
+	// Forge is the forge hosting the repositories
+	Forge *git.ForgeConfig `yaml:"forge"`
+	// BranchPrefix is the prefix of the branches changes are pushed to. Defaults to m8-k8sauthz/.
+	BranchPrefix string `yaml:"branchPrefix"`
 }
 
 // NewConfigFromFile creates a new GitRepoReconcilerConfig from a given yaml file path
@@ -91,11 +118,30 @@ func NewConfigFromFile(data []byte) (*Config, error) {
 
 // validate validates the configuration
 func (c *Config) validate() error {
-	if c.Repository == nil {
-		return ErrRepositoryIsRequired
+	defaultTargets := 0
+	clusters := make(map[string]bool)
+	for _, target := range c.getTargets() {
+		if target.Repository == nil {
+			return ErrRepositoryIsRequired
+		}
+		if err := target.Repository.Validate(); err != nil {
+			return err
+		}
+		if len(target.Clusters) == 0 {
+			defaultTargets++
+		}
+		for _, cluster := range target.Clusters {
+			if clusters[cluster] {
+				return fmt.Errorf("%w: %s", ErrClusterInMultipleTargets, cluster)
+			}
+			clusters[cluster] = true
+		}
 	}
-	if err := c.Repository.Validate(); err != nil {
-		return err
+	if defaultTargets > 1 {
+		return ErrMultipleDefaultTargets
+	}
+	if c.PullRequest != nil && c.PullRequest.Forge == nil {
+		return ErrForgeIsRequired
 	}
 	return nil
 }
@@ -109,13 +155,64 @@ func (conf *Config) setDefaults() error {
 		interval := DefaultInterval
 		conf.Interval = &interval
 	}
-	if conf.Repository.Timeout == nil {
-		timeout := DefaultTimeout
-		conf.Repository.Timeout = &timeout
+	for _, target := range conf.Targets {
+		if target.Repository == nil {
+			target.Repository = conf.Repository
+		}
+	}
+	// Targets of the same repository and branch share its configuration, so that it is cloned and pushed to once
+	repositories := make(map[string]*git.GitConfig)
+	for _, target := range conf.getTargets() {
+		if target.Repository == nil {
+			continue
+		}
+		key := fmt.Sprintf("%s#%s", target.Repository.URL, target.Repository.ReferenceName)
+		if repository, ok := repositories[key]; ok {
+			target.Repository = repository
+		} else {
+			repositories[key] = target.Repository
+		}
+	}
+	for _, target := range conf.getTargets() {
+		if target.Repository != nil && target.Repository.Timeout == nil {
+			timeout := DefaultTimeout
+			target.Repository.Timeout = &timeout
+		}
+	}
+	if conf.PullRequest != nil && len(conf.PullRequest.BranchPrefix) == 0 {
+		conf.PullRequest.BranchPrefix = DefaultBranchPrefix
 	}
 	return nil
 }
 
+// getTargets returns the configured targets, a single target made up of repository, subdir and clusters if none are configured
+func (conf *Config) getTargets() []*Target {
+	if len(conf.Targets) == 0 {
+		target := &Target{Repository: conf.Repository, SubDir: conf.SubDir}
+		if !conf.AllClusters {
+			target.Clusters = conf.Clusters
+		}
+		conf.Targets = []*Target{target}
+	}
+	return conf.Targets
+}
+
+// getTarget returns the target the given cluster is routed to, nil if none
+func (conf *Config) getTarget(cluster string) *Target {
+	var defaultTarget *Target
+	for _, target := range conf.getTargets() {
+		if len(target.Clusters) == 0 {
+			defaultTarget = target
+		}
+		for _, c := range target.Clusters {
+			if c == cluster {
+				return target
+			}
+		}
+	}
+	return defaultTarget
+}
+
 func (conf *Config) getClusterRoleMapping(scope, role string) string {
 	for _, m := range conf.Mappings {
 		if m.Scope == scope && m.Role == role {
@@ -17,6 +17,7 @@ package k8sauthz
 import (
 	_ "embed"
 	"os"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -38,5 +39,78 @@ var _ = Describe("internal/k8sauthz", func() {
 			Expect(len(conf.Mappings)).To(BeNumerically("==", 2))
 			Expect(conf.AllClusters).To(BeTrue())
 		})
+		It("NewConfigFromFile() reads targets and pull request config", func() {
+			conf, err := NewConfigFromFile([]byte(`
+repository:
+  url: https://monoskope.io/rbac.git
+  author:
+    name: test
+    email: test@monoskope.io
+targets:
+  - clusters: [prod-eu, prod-us]
+    repository:
+      url: https://monoskope.io/prod.git
+      author:
+        name: test
+        email: test@monoskope.io
+  - subdir: rbac
+pullRequest:
+  forge:
+    type: gitlab
+    envPrefix: git
+`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.getTargets()).To(HaveLen(2))
+			Expect(conf.getTarget("prod-us").Repository.URL).To(Equal("https://monoskope.io/prod.git"))
+			Expect(conf.getTarget("dev").SubDir).To(Equal("rbac"))
+			Expect(conf.getTarget("dev").Repository).To(Equal(conf.Repository))
+			Expect(*conf.getTarget("dev").Repository.Timeout).To(Equal(DefaultTimeout))
+			Expect(conf.PullRequest.BranchPrefix).To(Equal(DefaultBranchPrefix))
+		})
+		It("NewConfigFromFile() routes only the configured clusters without allClusters", func() {
+			os.Setenv("test1.basic.username", "test1")
+			os.Setenv("test1.basic.password", "testpw")
+
+			conf, err := NewConfigFromFile(append(test_config, []byte("clusters: [dev]\nallClusters: false\n")...))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.getTarget("dev")).ToNot(BeNil())
+			Expect(conf.getTarget("dev").SubDir).To(Equal("rbac/test"))
+			Expect(conf.getTarget("prod")).To(BeNil())
+		})
+		It("NewConfigFromFile() shares repositories listed by multiple targets", func() {
+			repository := "    repository:\n      url: https://monoskope.io/rbac.git\n      author:\n        name: test\n"
+			conf, err := NewConfigFromFile([]byte("targets:\n  - clusters: [dev]\n    subdir: dev\n" + repository + "  - clusters: [prod]\n    subdir: prod\n" + repository +
+				"  - clusters: [test]\n" + strings.Replace(repository, "url:", "referenceName: refs/heads/test\n      url:", 1)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.getTarget("prod").Repository).To(BeIdenticalTo(conf.getTarget("dev").Repository))
+			Expect(conf.getTarget("test").Repository).ToNot(BeIdenticalTo(conf.getTarget("dev").Repository))
+		})
+		It("NewConfigFromFile() fails for ambiguous targets", func() {
+			repository := "    repository:\n      url: https://monoskope.io/rbac.git\n      author:\n        name: test\n"
+			_, err := NewConfigFromFile([]byte("targets:\n  - clusters: [dev]\n" + repository + "  - clusters: [dev]\n" + repository))
+			Expect(err).To(MatchError(ErrClusterInMultipleTargets))
+
+			_, err = NewConfigFromFile([]byte("targets:\n  - subdir: a\n" + repository + "  - subdir: b\n" + repository))
+			Expect(err).To(Equal(ErrMultipleDefaultTargets))
+
+			_, err = NewConfigFromFile([]byte("targets:\n  - subdir: a\n    clusters: [dev]\n"))
+			Expect(err).To(Equal(ErrRepositoryIsRequired))
+
+			_, err = NewConfigFromFile([]byte("pullRequest: {}\ntargets:\n  - subdir: a\n" + repository))
+			Expect(err).To(Equal(ErrForgeIsRequired))
+		})
+		It("newCommitMessage() names users and lists changes", func() {
+			Expect(newCommitMessage(nil)).To(Equal(defaultSubject))
+			Expect(newCommitMessage([]*rbacChange{
+				{action: rbacChangeGrant, cluster: "cluster-a", user: "jane-doe", clusterRole: "view"},
+				{action: rbacChangeRevoke, cluster: "cluster-a", user: "john-doe", clusterRole: "edit"},
+			})).To(Equal("Update RBAC of jane-doe and john-doe\n\n- grant view on cluster-a to jane-doe\n- revoke edit on cluster-a from john-doe\n"))
+
+			var changes []*rbacChange
+			for _, user := range []string{"a", "b", "c", "d", "e"} {
+				changes = append(changes, &rbacChange{action: rbacChangeUpdate, cluster: "cluster-a", user: user, clusterRole: "view"})
+			}
+			Expect(newCommitMessage(changes)).To(HavePrefix("Update RBAC of a, b, c and 2 more users\n\n- update view on cluster-a of a\n"))
+		})
 	})
 })
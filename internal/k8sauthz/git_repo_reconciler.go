@@ -16,14 +16,19 @@ package k8sauthz
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
 	api_projections "github.com/finleap-connect/monoskope/pkg/api/domain/projections"
+	domainErrors "github.com/finleap-connect/monoskope/pkg/domain/errors"
 	"github.com/finleap-connect/monoskope/pkg/domain/projections"
 	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
 	"github.com/finleap-connect/monoskope/pkg/git"
@@ -43,24 +48,44 @@ type GitRepoReconciler struct {
 	config          *Config
 	users           repositories.UserRepository
 	clusterAccesses repositories.ClusterAccessRepository
+	roles           repositories.RoleRepository
 	gitClient       *git.GitClient
-	dir             string
+	targets         []*Target
+	forge           git.Forge
+	proposed        string // hash of the changes proposed by the open merge request
 	mutex           sync.Mutex
 }
 
 // NewGitRepoReconciler creates a new GitRepoReconciler configured via the given config.
+// It reconciles the RBAC of the clusters routed to the given targets, all targets of the config if none are given.
+// The targets must share the repository of the git client.
 func NewGitRepoReconciler(
 	config *Config,
 	userRepo repositories.UserRepository,
 	clusterAccessRepo repositories.ClusterAccessRepository,
+	roleRepo repositories.RoleRepository,
 	gitClient *git.GitClient,
+	targets ...*Target,
 ) *GitRepoReconciler {
-	return &GitRepoReconciler{logger.WithName("GitRepoReconciler"), config, userRepo, clusterAccessRepo, gitClient, filepath.Join(gitClient.GetLocalDirectory(), config.SubDir), sync.Mutex{}}
+	if len(targets) == 0 {
+		targets = config.getTargets()
+	}
+	return &GitRepoReconciler{log: logger.WithName("GitRepoReconciler"), config: config, users: userRepo, clusterAccesses: clusterAccessRepo, roles: roleRepo, gitClient: gitClient, targets: targets}
+}
+
+// WithForge sets the forge to open merge requests with if pull requests are configured.
+func (r *GitRepoReconciler) WithForge(forge git.Forge) *GitRepoReconciler {
+	r.forge = forge
+	return r
 }
 
 func (r *GitRepoReconciler) Reconcile(ctx context.Context) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
+	return r.reconcile(ctx)
+}
+
+func (r *GitRepoReconciler) reconcile(ctx context.Context) error {
 	r.log.Info("Started reconciling...")
 
 	r.log.Info("Pulling latest changes..")
@@ -73,22 +98,18 @@ func (r *GitRepoReconciler) Reconcile(ctx context.Context) error {
 		return fmt.Errorf("error reconciling users: %w", err)
 	}
 
-	r.log.Info("Committing changes...")
-	if err := r.gitClient.AddAllAndCommit(ctx, "Reconciliation of Monoskope based RBAC."); err != nil {
-		return fmt.Errorf("failed to commit: %w", err)
-	}
-
-	r.log.Info("Pushing changes to git repo...")
-	if err := r.gitClient.Push(ctx); err != nil && err != gogit.NoErrAlreadyUpToDate {
-		r.log.Error(err, "Reconciling finished with errors.")
-		return err
-	}
-	return nil
+	return r.publish(ctx)
 }
 
 func (r *GitRepoReconciler) ReconcileUser(ctx context.Context, user *projections.User) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
+
+	// Merge requests should contain all changes to not conflict with each other
+	if r.config.PullRequest != nil {
+		return r.reconcile(ctx)
+	}
+
 	r.log.Info("Start reconciling user...", "user", user.Email)
 
 	r.log.Info("Pulling latest changes..")
@@ -101,8 +122,26 @@ func (r *GitRepoReconciler) ReconcileUser(ctx context.Context, user *projections
 		return fmt.Errorf("error reconciling user: %w", err)
 	}
 
+	return r.publish(ctx)
+}
+
+// publish commits and pushes the changes to the branch or opens a merge request for them if pull requests are configured
+func (r *GitRepoReconciler) publish(ctx context.Context) error {
+	if err := r.gitClient.AddAll(ctx); err != nil {
+		return fmt.Errorf("failed to stage changes: %w", err)
+	}
+	status, err := r.gitClient.Status(ctx)
+	if err != nil {
+		return err
+	}
+	changes := r.getChanges(status)
+
+	if r.config.PullRequest != nil {
+		return r.openMergeRequest(ctx, status, changes)
+	}
+
 	r.log.Info("Committing changes...")
-	if err := r.gitClient.AddAllAndCommit(ctx, "Reconciliation of Monoskope based RBAC."); err != nil {
+	if err := r.gitClient.Commit(ctx, newCommitMessage(changes)); err != nil {
 		return fmt.Errorf("failed to commit: %w", err)
 	}
 
@@ -114,20 +153,189 @@ func (r *GitRepoReconciler) ReconcileUser(ctx context.Context, user *projections
 	return nil
 }
 
-// removeAll cleans up a directory keeping all hidden files and directories
-func (r *GitRepoReconciler) removeAll() error {
-	return filepath.WalkDir(r.dir, func(path string, d fs.DirEntry, err error) error {
-		if strings.HasPrefix(filepath.Base(path), ".") {
-			return nil
+// openMergeRequest pushes the changes to the branch of the reconciler and opens a merge request for them.
+// The branch is replaced by every change, so that the open merge request always proposes all changes.
+// Once there are no changes anymore, e.g. because the merge request has been merged, the branch is deleted.
+func (r *GitRepoReconciler) openMergeRequest(ctx context.Context, status gogit.Status, changes []*rbacChange) error {
+	if r.forge == nil {
+		return ErrForgeIsRequired
+	}
+
+	base, err := r.gitClient.Branch(ctx)
+	if err != nil {
+		return err
+	}
+	branch := r.config.PullRequest.BranchPrefix + base
+
+	if status.IsClean() {
+		r.log.Info("Nothing changed.")
+		r.proposed = ""
+		exists, err := r.gitClient.RemoteBranchExists(ctx, branch)
+		if err != nil || !exists {
+			return err
 		}
-		if filepath.Ext(path) == ".yaml" {
-			r.log.V(logger.DebugLevel).Info("Deleting...", "path", path)
-			if err := os.Remove(path); err != nil {
-				return fmt.Errorf("failed to delete file: %w", err)
-			}
+		r.log.Info("Deleting branch of superseded merge request...", "branch", branch)
+		return r.gitClient.DeleteRemoteBranch(ctx, branch)
+	}
+
+	hash, err := r.hashChanges(status)
+	if err != nil {
+		return err
+	}
+	if hash == r.proposed {
+		r.log.Info("Merge request for changes has been opened already.", "branch", branch)
+		return r.gitClient.Checkout(ctx, base)
+	}
+
+	r.log.Info("Pushing changes to branch...", "branch", branch)
+	msg := newCommitMessage(changes)
+	err = r.pushBranch(ctx, branch, msg)
+
+	// Restore the branch to reconcile next time
+	if checkoutErr := r.gitClient.Checkout(ctx, base); checkoutErr != nil {
+		return checkoutErr
+	}
+	if deleteErr := r.gitClient.DeleteBranch(ctx, branch); deleteErr != nil {
+		return deleteErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to push changes: %w", err)
+	}
+
+	title, description, _ := strings.Cut(msg, "\n\n")
+	url, err := r.forge.OpenMergeRequest(ctx, &git.MergeRequest{
+		RepositoryURL: r.targets[0].Repository.URL,
+		SourceBranch:  branch,
+		TargetBranch:  base,
+		Title:         title,
+		Description:   description,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to open merge request: %w", err)
+	}
+	r.proposed = hash
+	r.log.Info("Opened merge request.", "url", url)
+	return nil
+}
+
+func (r *GitRepoReconciler) pushBranch(ctx context.Context, branch, msg string) error {
+	if err := r.gitClient.CheckoutNewBranch(ctx, branch); err != nil {
+		return err
+	}
+	if err := r.gitClient.Commit(ctx, msg); err != nil {
+		return err
+	}
+	return r.gitClient.PushBranch(ctx, branch)
+}
+
+// hashChanges returns a short hash of the staged changes
+func (r *GitRepoReconciler) hashChanges(status gogit.Status) (string, error) {
+	paths := make([]string, 0, len(status))
+	for path := range status {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	h := sha256.New()
+	for _, path := range paths {
+		fmt.Fprintf(h, "%c %s\n", status[path].Staging, path)
+		if status[path].Staging == gogit.Deleted {
+			continue
 		}
-		return nil
+		data, err := os.ReadFile(filepath.Join(r.gitClient.GetLocalDirectory(), path))
+		if err != nil {
+			return "", fmt.Errorf("failed to read file: %w", err)
+		}
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12], nil
+}
+
+// getChanges returns the changes of cluster role bindings staged
+func (r *GitRepoReconciler) getChanges(status gogit.Status) []*rbacChange {
+	var changes []*rbacChange
+	for path, fileStatus := range status {
+		change := r.getChange(path)
+		if change == nil {
+			continue
+		}
+		switch fileStatus.Staging {
+		case gogit.Added:
+			change.action = rbacChangeGrant
+		case gogit.Deleted:
+			change.action = rbacChangeRevoke
+		case gogit.Unmodified:
+			continue
+		default:
+			change.action = rbacChangeUpdate
+		}
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].String() < changes[j].String()
 	})
+	return changes
+}
+
+// getChange returns the change of the cluster role binding at the given path relative to the repo, nil if the path is not one of a cluster role binding
+func (r *GitRepoReconciler) getChange(path string) *rbacChange {
+	var rel string
+	matched := -1
+	for _, target := range r.targets {
+		subDir := filepath.ToSlash(filepath.Clean(target.SubDir))
+		if subDir == "." {
+			subDir = ""
+		}
+		if len(subDir) <= matched {
+			continue
+		}
+		if subDir == "" {
+			rel, matched = path, 0
+		} else if strings.HasPrefix(path, subDir+"/") {
+			rel, matched = strings.TrimPrefix(path, subDir+"/"), len(subDir)
+		}
+	}
+	parts := strings.Split(rel, "/")
+	if matched < 0 || len(parts) != 3 || filepath.Ext(parts[2]) != ".yaml" {
+		return nil
+	}
+	return &rbacChange{cluster: parts[0], user: parts[1], clusterRole: strings.TrimSuffix(parts[2], ".yaml")}
+}
+
+// getDir returns the directory to reconcile the yamls of the target in
+func (r *GitRepoReconciler) getDir(target *Target) string {
+	return filepath.Join(r.gitClient.GetLocalDirectory(), target.SubDir)
+}
+
+// isTarget returns true if the target is reconciled by this reconciler
+func (r *GitRepoReconciler) isTarget(target *Target) bool {
+	for _, t := range r.targets {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
+
+// removeAll cleans up the directories of all targets keeping all hidden files and directories
+func (r *GitRepoReconciler) removeAll() error {
+	for _, target := range r.targets {
+		if err := filepath.WalkDir(r.getDir(target), func(path string, d fs.DirEntry, err error) error {
+			if strings.HasPrefix(filepath.Base(path), ".") {
+				return nil
+			}
+			if filepath.Ext(path) == ".yaml" {
+				r.log.V(logger.DebugLevel).Info("Deleting...", "path", path)
+				if err := os.Remove(path); err != nil {
+					return fmt.Errorf("failed to delete file: %w", err)
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (r *GitRepoReconciler) reconcileUsers(ctx context.Context) error {
@@ -180,8 +388,13 @@ func (r *GitRepoReconciler) createClusterRolesForUser(ctx context.Context, user
 	r.log.V(logger.DebugLevel).Info("Reconciling bindings...", "user", user.Email, "clusterAccesses", len(clusterAccesses))
 
 	for _, clusterAccess := range clusterAccesses {
+		target := r.config.getTarget(clusterAccess.Cluster.Name)
+		if !r.isTarget(target) {
+			continue
+		}
+
 		r.log.V(logger.DebugLevel).Info("Reconciling binding...", "user", user.Email, "cluster", clusterAccess.Cluster.Name)
-		path := filepath.Join(r.dir, clusterAccess.Cluster.Name, sanitizedName)
+		path := filepath.Join(r.getDir(target), clusterAccess.Cluster.Name, sanitizedName)
 
 		// Create user sub dir
 		if err := os.MkdirAll(path, defaultDirectoryMode); err != nil {
@@ -191,7 +404,11 @@ func (r *GitRepoReconciler) createClusterRolesForUser(ctx context.Context, user
 
 		// Reconcile bindings for existing users
 		for _, clusterAccessRole := range clusterAccess.ClusterRoles {
-			if clusterRole := r.config.getClusterRoleMapping(clusterAccessRole.Scope.String(), clusterAccessRole.Role); clusterRole != "" {
+			clusterRole, err := r.getClusterRole(ctx, clusterAccessRole)
+			if err != nil {
+				return err
+			}
+			if clusterRole != "" {
 				if err := r.createClusterRoleBinding(ctx, path, clusterRole, user, clusterAccess.Cluster, sanitizedName); err != nil {
 					return err
 				}
@@ -201,6 +418,22 @@ func (r *GitRepoReconciler) createClusterRolesForUser(ctx context.Context, user
 	return nil
 }
 
+// getClusterRole returns the cluster role configured for the given role or falls back to the cluster role of a defined role
+func (r *GitRepoReconciler) getClusterRole(ctx context.Context, clusterAccessRole *api_projections.ClusterRole) (string, error) {
+	if clusterRole := r.config.getClusterRoleMapping(clusterAccessRole.Scope.String(), clusterAccessRole.Role); clusterRole != "" {
+		return clusterRole, nil
+	}
+
+	role, err := r.roles.ByName(ctx, clusterAccessRole.Role)
+	if errors.Is(err, domainErrors.ErrRoleNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get role: %w", err)
+	}
+	return role.K8SClusterRole, nil
+}
+
 func (r *GitRepoReconciler) createClusterRoleBinding(ctx context.Context, dir, clusterRoleName string, user *projections.User, cluster *api_projections.Cluster, sanitizedName string) error {
 	filePath := filepath.Join(dir, fmt.Sprintf("%s.yaml", clusterRoleName))
 	r.log.V(logger.DebugLevel).Info("Creating cluster role binding...", "path", filePath)
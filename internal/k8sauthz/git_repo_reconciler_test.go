@@ -17,13 +17,20 @@ package k8sauthz
 import (
 	"context"
 	_ "embed"
+	"path/filepath"
 	"time"
 
 	mock_repositories "github.com/finleap-connect/monoskope/internal/test/domain/repositories"
 	api_projections "github.com/finleap-connect/monoskope/pkg/api/domain/projections"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
 	"github.com/finleap-connect/monoskope/pkg/domain/projections"
+	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
+	es_repos "github.com/finleap-connect/monoskope/pkg/eventsourcing/repositories"
 	"github.com/finleap-connect/monoskope/pkg/git"
+	"github.com/finleap-connect/monoskope/pkg/git/fake"
 	"github.com/finleap-connect/monoskope/pkg/k8s"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -59,6 +66,14 @@ var _ = Describe("internal/k8sauthz", func() {
 			userRepo := mock_repositories.NewMockUserRepository(mockCtrl)
 			clusterAccessRepo := mock_repositories.NewMockClusterAccessRepository(mockCtrl)
 
+			auditorRole := projections.NewRoleProjection(uuid.New())
+			auditorRole.Name = "auditor"
+			auditorRole.Scopes = []string{string(scopes.Tenant)}
+			auditorRole.K8SClusterRole = "view"
+			inMemoryRoleRepo := es_repos.NewInMemoryRepository[*projections.Role]()
+			Expect(inMemoryRoleRepo.Upsert(context.Background(), auditorRole)).To(Succeed())
+			roleRepo := repositories.NewRoleRepository(inMemoryRoleRepo)
+
 			clusterAccessProjectionA := &api_projections.ClusterAccessV2{
 				Cluster: &api_projections.Cluster{
 					Id:   uuid.NewString(),
@@ -66,6 +81,7 @@ var _ = Describe("internal/k8sauthz", func() {
 				},
 				ClusterRoles: []*api_projections.ClusterRole{
 					{Scope: api_projections.ClusterRole_CLUSTER, Role: "admin"},
+					{Scope: api_projections.ClusterRole_TENANT, Role: "auditor"},
 				},
 			}
 			clusterAccessProjectionB := &api_projections.ClusterAccessV2{
@@ -110,11 +126,112 @@ var _ = Describe("internal/k8sauthz", func() {
 				},
 			}
 			Expect(config.setDefaults()).To(Succeed())
-			reconciler := NewGitRepoReconciler(config, userRepo, clusterAccessRepo, testEnv.gitClient)
+			reconciler := NewGitRepoReconciler(config, userRepo, clusterAccessRepo, roleRepo, testEnv.gitClient)
 			Expect(reconciler.Reconcile(context.Background())).To(Succeed())
+			Expect(filepath.Join(testEnv.gitClient.GetLocalDirectory(), config.SubDir, "cluster-a", "test-a", "view.yaml")).To(BeAnExistingFile())
 
 			clusterAccessRepo.EXPECT().GetClustersAccessibleByUserIdV2(context.Background(), userA.ID()).Return([]*api_projections.ClusterAccessV2{clusterAccessProjectionA}, nil)
 			Expect(reconciler.ReconcileUser(context.Background(), userA)).To(Succeed())
 		})
+
+		It("Reconcile() opens merge requests for the clusters of its targets", func() {
+			userRepo := mock_repositories.NewMockUserRepository(mockCtrl)
+			clusterAccessRepo := mock_repositories.NewMockClusterAccessRepository(mockCtrl)
+			roleRepo := repositories.NewRoleRepository(es_repos.NewInMemoryRepository[*projections.Role]())
+
+			clusterAccesses := []*api_projections.ClusterAccessV2{
+				{
+					Cluster:      &api_projections.Cluster{Id: uuid.NewString(), Name: "cluster-pr-a"},
+					ClusterRoles: []*api_projections.ClusterRole{{Scope: api_projections.ClusterRole_CLUSTER, Role: "admin"}},
+				},
+				{
+					Cluster:      &api_projections.Cluster{Id: uuid.NewString(), Name: "cluster-pr-b"},
+					ClusterRoles: []*api_projections.ClusterRole{{Scope: api_projections.ClusterRole_CLUSTER, Role: "admin"}},
+				},
+				{
+					Cluster:      &api_projections.Cluster{Id: uuid.NewString(), Name: "cluster-elsewhere"},
+					ClusterRoles: []*api_projections.ClusterRole{{Scope: api_projections.ClusterRole_CLUSTER, Role: "admin"}},
+				},
+			}
+			userRepo.EXPECT().AllWith(context.Background(), true).Return([]*projections.User{userA}, nil).AnyTimes()
+			clusterAccessRepo.EXPECT().GetClustersAccessibleByUserIdV2(context.Background(), userA.ID()).DoAndReturn(func(context.Context, uuid.UUID) ([]*api_projections.ClusterAccessV2, error) {
+				return clusterAccesses, nil
+			}).AnyTimes()
+
+			gitConfig, err := git.NewGitConfig(testEnv.repoOriginDir, &git.GitAuthor{Name: "test", Email: "test@monoskope.io"})
+			Expect(err).ToNot(HaveOccurred())
+			gitClient, err := git.NewGitClient(gitConfig)
+			Expect(err).ToNot(HaveOccurred())
+			defer gitClient.Close()
+			Expect(gitClient.Clone(context.Background())).To(Succeed())
+
+			config := &Config{
+				Repository:     gitConfig,
+				UsernamePrefix: "m8-",
+				Mappings: []*ClusterRoleMapping{
+					{Scope: api_projections.ClusterRole_CLUSTER.String(), Role: string(k8s.AdminRole), ClusterRole: "cluster-admin"},
+				},
+				Targets: []*Target{
+					{Clusters: []string{"cluster-pr-a"}, SubDir: "pr/a"},
+					{Clusters: []string{"cluster-pr-b"}, SubDir: "pr/b"},
+					{Clusters: []string{"cluster-elsewhere"}, Repository: &git.GitConfig{URL: "https://monoskope.io/elsewhere.git"}},
+				},
+				PullRequest: &PullRequestConfig{Forge: &git.ForgeConfig{}},
+			}
+			Expect(config.setDefaults()).To(Succeed())
+			forge := fake.NewForge()
+			reconciler := NewGitRepoReconciler(config, userRepo, clusterAccessRepo, roleRepo, gitClient, config.Targets[:2]...).WithForge(forge)
+
+			Expect(reconciler.Reconcile(context.Background())).To(Succeed())
+			Expect(forge.MergeRequests()).To(HaveLen(1))
+			mr := forge.MergeRequests()[0]
+			Expect(mr.RepositoryURL).To(Equal(testEnv.repoOriginDir))
+			Expect(mr.SourceBranch).To(Equal(DefaultBranchPrefix + "master"))
+			Expect(mr.TargetBranch).To(Equal("master"))
+			Expect(mr.Title).To(Equal("Update RBAC of test-a"))
+			Expect(mr.Description).To(Equal("- grant cluster-admin on cluster-pr-a to test-a\n- grant cluster-admin on cluster-pr-b to test-a\n"))
+
+			// Changes are only pushed to the new branch
+			origin, err := gogit.PlainOpen(testEnv.repoOriginDir)
+			Expect(err).ToNot(HaveOccurred())
+			ref, err := origin.Reference(plumbing.NewBranchReferenceName(mr.SourceBranch), true)
+			Expect(err).ToNot(HaveOccurred())
+			commit, err := origin.CommitObject(ref.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = commit.File("pr/a/cluster-pr-a/test-a/cluster-admin.yaml")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = commit.File("pr/b/cluster-pr-b/test-a/cluster-admin.yaml")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filepath.Join(gitClient.GetLocalDirectory(), "pr")).ToNot(BeADirectory())
+
+			// The same changes are proposed only once
+			Expect(reconciler.ReconcileUser(context.Background(), userA)).To(Succeed())
+			Expect(forge.MergeRequests()).To(HaveLen(1))
+			Expect(forge.Updates()).To(BeZero())
+
+			// Further changes replace the proposed ones in the open merge request
+			clusterAccesses = clusterAccesses[1:]
+			Expect(reconciler.Reconcile(context.Background())).To(Succeed())
+			Expect(forge.MergeRequests()).To(HaveLen(1))
+			Expect(forge.Updates()).To(Equal(1))
+			mr = forge.MergeRequests()[0]
+			Expect(mr.SourceBranch).To(Equal(DefaultBranchPrefix + "master"))
+			Expect(mr.Description).To(Equal("- grant cluster-admin on cluster-pr-b to test-a\n"))
+
+			ref, err = origin.Reference(plumbing.NewBranchReferenceName(mr.SourceBranch), true)
+			Expect(err).ToNot(HaveOccurred())
+			commit, err = origin.CommitObject(ref.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = commit.File("pr/a/cluster-pr-a/test-a/cluster-admin.yaml")
+			Expect(err).To(HaveOccurred())
+			_, err = commit.File("pr/b/cluster-pr-b/test-a/cluster-admin.yaml")
+			Expect(err).ToNot(HaveOccurred())
+
+			// The branch of a superseded merge request is deleted
+			clusterAccesses = nil
+			Expect(reconciler.Reconcile(context.Background())).To(Succeed())
+			_, err = origin.Reference(plumbing.NewBranchReferenceName(mr.SourceBranch), true)
+			Expect(err).To(MatchError(plumbing.ErrReferenceNotFound))
+		})
 	})
 })
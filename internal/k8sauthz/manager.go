@@ -27,19 +27,21 @@ import (
 
 type Manager struct {
 	log                     logger.Logger
-	gitClient               *git.GitClient
-	reconciler              *GitRepoReconciler
+	gitClients              []*git.GitClient
+	reconcilers             []*GitRepoReconciler
 	userRepository          repositories.UserRepository
 	clusterAccessRepository repositories.ClusterAccessRepository
+	roleRepository          repositories.RoleRepository
 	eg                      errgroup.Group
 	quitChannels            []chan struct{}
 }
 
-func NewManager(userRepository repositories.UserRepository, clusterAccessRepository repositories.ClusterAccessRepository) *Manager {
+func NewManager(userRepository repositories.UserRepository, clusterAccessRepository repositories.ClusterAccessRepository, roleRepository repositories.RoleRepository) *Manager {
 	m := &Manager{
 		log:                     logger.WithName("GitRepoManager"),
 		userRepository:          userRepository,
 		clusterAccessRepository: clusterAccessRepository,
+		roleRepository:          roleRepository,
 	}
 	m.userRepository.RegisterObserver(m)
 	return m
@@ -47,24 +49,47 @@ func NewManager(userRepository repositories.UserRepository, clusterAccessReposit
 
 func (m *Manager) Run(ctx context.Context, conf *Config) error {
 	m.log.Info("Starting reconciliation loops...")
-	gitClient, err := git.NewGitClient(conf.Repository)
-	if err != nil {
-		return err
+
+	var forge git.Forge
+	if conf.PullRequest != nil {
+		var err error
+		if forge, err = git.NewForge(conf.PullRequest.Forge); err != nil {
+			return err
+		}
 	}
-	m.gitClient = gitClient
 
-	// Clone repo
-	m.log.Info("Cloning repo...", "url", conf.Repository.URL)
-	if err := gitClient.Clone(ctx); err != nil {
-		return err
+	// Targets sharing a repository are reconciled by the same reconciler, see Config.setDefaults
+	var repositories []*git.GitConfig
+	targetsByRepository := make(map[*git.GitConfig][]*Target)
+	for _, target := range conf.getTargets() {
+		if _, ok := targetsByRepository[target.Repository]; !ok {
+			repositories = append(repositories, target.Repository)
+		}
+		targetsByRepository[target.Repository] = append(targetsByRepository[target.Repository], target)
 	}
 
-	m.log.Info("Configuring reconciler...", "url", conf.Repository.URL)
-	m.reconciler = NewGitRepoReconciler(conf, m.userRepository, m.clusterAccessRepository, gitClient)
+	for _, repository := range repositories {
+		gitClient, err := git.NewGitClient(repository)
+		if err != nil {
+			return err
+		}
+		m.gitClients = append(m.gitClients, gitClient)
+
+		// Clone repo
+		m.log.Info("Cloning repo...", "url", repository.URL)
+		if err := gitClient.Clone(ctx); err != nil {
+			return err
+		}
+
+		m.log.Info("Configuring reconciler...", "url", repository.URL)
+		m.reconcilers = append(m.reconcilers, NewGitRepoReconciler(conf, m.userRepository, m.clusterAccessRepository, m.roleRepository, gitClient, targetsByRepository[repository]...).WithForge(forge))
+	}
 
 	// initial reconcile
-	if err := m.reconciler.Reconcile(ctx); err != nil {
-		m.log.Error(err, "Failed running reconciliation loop.")
+	for _, reconciler := range m.reconcilers {
+		if err := reconciler.Reconcile(ctx); err != nil {
+			m.log.Error(err, "Failed running reconciliation loop.")
+		}
 	}
 
 	// schedule reconcile loop
@@ -75,13 +100,16 @@ func (m *Manager) Run(ctx context.Context, conf *Config) error {
 		for {
 			select {
 			case <-ticker.C:
-				m.eg.Go(func() error {
-					err := m.reconciler.Reconcile(ctx)
-					if err != nil {
-						m.log.Error(err, "Failed running reconciliation loop.")
-					}
-					return err
-				})
+				for _, reconciler := range m.reconcilers {
+					reconciler := reconciler
+					m.eg.Go(func() error {
+						err := reconciler.Reconcile(ctx)
+						if err != nil {
+							m.log.Error(err, "Failed running reconciliation loop.")
+						}
+						return err
+					})
+				}
 			case <-quit:
 				ticker.Stop()
 				return
@@ -93,8 +121,10 @@ func (m *Manager) Run(ctx context.Context, conf *Config) error {
 
 func (m *Manager) Notify(ctx context.Context, u *projections.User) {
 	m.log.V(logger.DebugLevel).Info("Received notification from repo for user.", "user", u.Email)
-	if err := m.reconciler.ReconcileUser(ctx, u); err != nil {
-		m.log.Error(err, "Failed to reconcile user.")
+	for _, reconciler := range m.reconcilers {
+		if err := reconciler.ReconcileUser(ctx, u); err != nil {
+			m.log.Error(err, "Failed to reconcile user.")
+		}
 	}
 }
 
@@ -110,8 +140,10 @@ func (m *Manager) Close() error {
 	}
 
 	m.log.Info("Cleaning up...")
-	if err := m.gitClient.Close(); err != nil {
-		m.log.Error(err, "Encountered errors cleaning up.")
+	for _, gitClient := range m.gitClients {
+		if err := gitClient.Close(); err != nil {
+			m.log.Error(err, "Encountered errors cleaning up.")
+		}
 	}
 
 	return m.eg.Wait()
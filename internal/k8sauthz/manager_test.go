@@ -22,6 +22,8 @@ import (
 	mock_repositories "github.com/finleap-connect/monoskope/internal/test/domain/repositories"
 	api_projections "github.com/finleap-connect/monoskope/pkg/api/domain/projections"
 	"github.com/finleap-connect/monoskope/pkg/domain/projections"
+	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
+	es_repos "github.com/finleap-connect/monoskope/pkg/eventsourcing/repositories"
 	"github.com/finleap-connect/monoskope/pkg/git"
 	"github.com/finleap-connect/monoskope/pkg/k8s"
 	"github.com/golang/mock/gomock"
@@ -115,7 +117,7 @@ var _ = Describe("internal/k8sauthz", func() {
 				},
 			}
 
-			m := NewManager(userRepo, clusterAccessRepo)
+			m := NewManager(userRepo, clusterAccessRepo, repositories.NewRoleRepository(es_repos.NewInMemoryRepository[*projections.Role]()))
 
 			// expected calls to mocks
 			userRepo.EXPECT().AllWith(context.Background(), true).Return([]*projections.User{userA, userB, userC}, nil).AnyTimes()
@@ -37,11 +37,14 @@ func getRabbitConf(name, msgbusPrefix string) (*esMessaging.RabbitEventBusConfig
 	return rabbitConf, nil
 }
 
-func NewEventBusConsumer(name, msgbusPrefix string) (eventsourcing.EventBusConsumer, error) {
+func NewEventBusConsumer(name, msgbusPrefix string, opts ...func(*esMessaging.RabbitEventBusConfig)) (eventsourcing.EventBusConsumer, error) {
 	rabbitConf, err := getRabbitConf(name, msgbusPrefix)
 	if err != nil {
 		return nil, err
 	}
+	for _, opt := range opts {
+		opt(rabbitConf)
+	}
 	return NewEventBusConsumerFromConfig(rabbitConf)
 }
 
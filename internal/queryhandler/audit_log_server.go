@@ -17,24 +17,24 @@ package queryhandler
 import (
 	"context"
 	"io"
-	"strings"
 	"time"
 
-	"github.com/finleap-connect/monoskope/internal/gateway/auth"
 	doApi "github.com/finleap-connect/monoskope/pkg/api/domain"
 	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/audit/export"
 	"github.com/finleap-connect/monoskope/pkg/audit/formatters/audit"
 	"github.com/finleap-connect/monoskope/pkg/audit/formatters/event"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
-	fConsts "github.com/finleap-connect/monoskope/pkg/domain/constants/formatters"
 	"github.com/finleap-connect/monoskope/pkg/domain/errors"
 	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
+	"github.com/finleap-connect/monoskope/pkg/domain/snapshots"
 	grpcUtil "github.com/finleap-connect/monoskope/pkg/grpc"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
-	"k8s.io/utils/strings/slices"
 )
 
 // auditLogServer is the implementation of the auditLogService API
@@ -56,9 +56,9 @@ func NewAuditLogServer(esClient esApi.EventStoreClient, efRegistry event.EventFo
 }
 
 // NewAuditLogClient returns a new configured instance of AuditLogClient along with the connection used
-func NewAuditLogClient(ctx context.Context, queryHandlerAddr string) (*grpc.ClientConn, doApi.AuditLogClient, error) {
+func NewAuditLogClient(ctx context.Context, queryHandlerAddr string, tlsLoader *m8tls.TLSConfigLoader) (*grpc.ClientConn, doApi.AuditLogClient, error) {
 	conn, err := grpcUtil.
-		NewGrpcConnectionFactoryWithInsecure(queryHandlerAddr).
+		NewInternalGrpcConnectionFactory(queryHandlerAddr, tlsLoader).
 		WithOpenTelemetry().
 		ConnectWithTimeout(ctx, 10*time.Second)
 	if err != nil {
@@ -70,6 +70,8 @@ func NewAuditLogClient(ctx context.Context, queryHandlerAddr string) (*grpc.Clie
 
 // GetByDateRange returns human-readable events within the specified data range
 func (s *auditLogServer) GetByDateRange(request *doApi.GetAuditLogByDateRangeRequest, stream doApi.AuditLog_GetByDateRangeServer) error {
+	cache := snapshots.NewCache()
+	ctx := snapshots.WithCache(stream.Context(), cache)
 	eventFilter := &esApi.EventFilter{MinTimestamp: request.MinTimestamp, MaxTimestamp: request.MaxTimestamp}
 	eventsStream, err := s.esClient.Retrieve(stream.Context(), eventFilter)
 	if err != nil {
@@ -85,7 +87,8 @@ func (s *auditLogServer) GetByDateRange(request *doApi.GetAuditLogByDateRangeReq
 			return errors.TranslateToGrpcError(err)
 		}
 
-		hre := s.auditFormatter.NewHumanReadableEvent(stream.Context(), e)
+		cache.Observe(e)
+		hre := s.auditFormatter.NewHumanReadableEvent(ctx, e)
 		err = stream.Send(hre)
 		if err != nil {
 			return errors.TranslateToGrpcError(err)
@@ -97,14 +100,43 @@ func (s *auditLogServer) GetByDateRange(request *doApi.GetAuditLogByDateRangeReq
 
 // GetByUser returns human-readable events caused by others actions on the given user
 func (s *auditLogServer) GetByUser(request *doApi.GetByUserRequest, stream doApi.AuditLog_GetByUserServer) error {
+	ctx := snapshots.WithCache(stream.Context(), snapshots.NewCache())
 	users, err := s.userRepo.ByEmailIncludingDeleted(stream.Context(), request.Email.GetValue())
 	if err != nil {
 		return errors.TranslateToGrpcError(err)
 	}
 
+	if len(users) == 0 {
+		return nil
+	}
+
+	// role bindings reference the user only when created, so their ids are collected first to include later changes as well
+	var bindingFilters []*esApi.EventFilter
+	for _, user := range users {
+		bindingFilters = append(bindingFilters, &esApi.EventFilter{UserId: wrapperspb.String(user.Id), AggregateType: wrapperspb.String(aggregates.UserRoleBinding.String()), EventType: wrapperspb.String(events.UserRoleBindingCreated.String()), MaxTimestamp: request.DateRange.MaxTimestamp})
+	}
+	bindingsStream, err := s.esClient.RetrieveOr(stream.Context(), &esApi.EventFilters{
+		Filters: bindingFilters,
+	})
+	if err != nil {
+		return errors.TranslateToGrpcError(err)
+	}
+
 	var filter []*esApi.EventFilter
-	filter = append(filter, &esApi.EventFilter{AggregateType: wrapperspb.String(aggregates.UserRoleBinding.String()), MinTimestamp: request.DateRange.MinTimestamp, MaxTimestamp: request.DateRange.MaxTimestamp})
+	for {
+		e, err := bindingsStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+		filter = append(filter, &esApi.EventFilter{AggregateId: wrapperspb.String(e.AggregateId), AggregateType: wrapperspb.String(aggregates.UserRoleBinding.String()), MinTimestamp: request.DateRange.MinTimestamp, MaxTimestamp: request.DateRange.MaxTimestamp})
+	}
+
+	issuers := make(map[string]bool)
 	for _, user := range users {
+		issuers[user.Id] = true
 		filter = append(filter, &esApi.EventFilter{AggregateId: wrapperspb.String(user.Id), AggregateType: wrapperspb.String(aggregates.User.String()), MinTimestamp: request.DateRange.MinTimestamp, MaxTimestamp: request.DateRange.MaxTimestamp})
 	}
 
@@ -124,16 +156,9 @@ func (s *auditLogServer) GetByUser(request *doApi.GetByUserRequest, stream doApi
 			return errors.TranslateToGrpcError(err)
 		}
 
-		hre := s.auditFormatter.NewHumanReadableEvent(stream.Context(), e)
-		skip := false
-		for _, user := range users {
-			if !strings.Contains(hre.Details, fConsts.Quote(user.Email)) || hre.IssuerId == user.Id {
-				skip = true // skip e.g. UserRoleBindings that doesn't affect the given user or where created by him
-			}
-		}
-
-		if skip {
-			continue
+		hre := s.auditFormatter.NewHumanReadableEvent(ctx, e)
+		if issuers[hre.IssuerId] {
+			continue // skip actions of the given user on itself
 		}
 
 		err = stream.Send(hre)
@@ -150,18 +175,24 @@ func (s *auditLogServer) GetUserActions(request *doApi.GetUserActionsRequest, st
 	if request.DateRange.MaxTimestamp.AsTime().Sub(request.DateRange.MinTimestamp.AsTime()) > time.Hour*24*365 {
 		return errors.TranslateToGrpcError(errors.ErrInvalidArgument("date range cannot exceed one year")) // see PR #90
 	}
+	ctx := snapshots.WithCache(stream.Context(), snapshots.NewCache())
 
 	users, err := s.userRepo.ByEmailIncludingDeleted(stream.Context(), request.Email.GetValue())
 	if err != nil {
 		return errors.TranslateToGrpcError(err)
 	}
-	var userIds []string
+	if len(users) == 0 {
+		return nil
+	}
+
+	var filter []*esApi.EventFilter
 	for _, user := range users {
-		userIds = append(userIds, user.Id)
+		filter = append(filter, &esApi.EventFilter{IssuerId: wrapperspb.String(user.Id), MinTimestamp: request.DateRange.MinTimestamp, MaxTimestamp: request.DateRange.MaxTimestamp})
 	}
 
-	eventFilter := &esApi.EventFilter{MinTimestamp: request.DateRange.MinTimestamp, MaxTimestamp: request.DateRange.MaxTimestamp}
-	eventsStream, err := s.esClient.Retrieve(stream.Context(), eventFilter)
+	eventsStream, err := s.esClient.RetrieveOr(stream.Context(), &esApi.EventFilters{
+		Filters: filter,
+	})
 	if err != nil {
 		return errors.TranslateToGrpcError(err)
 	}
@@ -175,12 +206,7 @@ func (s *auditLogServer) GetUserActions(request *doApi.GetUserActionsRequest, st
 			return errors.TranslateToGrpcError(err)
 		}
 
-		eventIssuerId := e.Metadata[auth.HeaderAuthId]
-		if !slices.Contains(userIds, eventIssuerId) {
-			continue
-		}
-
-		hre := s.auditFormatter.NewHumanReadableEvent(stream.Context(), e)
+		hre := s.auditFormatter.NewHumanReadableEvent(ctx, e)
 		err = stream.Send(hre)
 		if err != nil {
 			return errors.TranslateToGrpcError(err)
@@ -192,7 +218,8 @@ func (s *auditLogServer) GetUserActions(request *doApi.GetUserActionsRequest, st
 
 // GetUsersOverview returns users overview at the specified timestamp, tenants/clusters they belong to, and their roles
 func (s *auditLogServer) GetUsersOverview(request *doApi.GetUsersOverviewRequest, stream doApi.AuditLog_GetUsersOverviewServer) error {
-	eventsStream, err := s.esClient.Retrieve(stream.Context(), &esApi.EventFilter{MaxTimestamp: request.Timestamp})
+	ctx := snapshots.WithCache(stream.Context(), snapshots.NewCache())
+	eventsStream, err := s.esClient.Retrieve(stream.Context(), &esApi.EventFilter{MaxTimestamp: request.Timestamp, AggregateType: wrapperspb.String(aggregates.User.String())})
 	if err != nil {
 		return errors.TranslateToGrpcError(err)
 	}
@@ -209,7 +236,7 @@ func (s *auditLogServer) GetUsersOverview(request *doApi.GetUsersOverviewRequest
 			continue
 		}
 
-		uo := s.auditFormatter.NewUserOverview(stream.Context(), uuid.MustParse(e.AggregateId), request.Timestamp.AsTime())
+		uo := s.auditFormatter.NewUserOverview(ctx, uuid.MustParse(e.AggregateId), request.Timestamp.AsTime())
 		err = stream.Send(uo)
 		if err != nil {
 			return errors.TranslateToGrpcError(err)
@@ -218,3 +245,124 @@ func (s *auditLogServer) GetUsersOverview(request *doApi.GetUsersOverviewRequest
 
 	return nil
 }
+
+// Query returns structured audit events matching all of the given filters
+func (s *auditLogServer) Query(request *doApi.AuditLogQueryRequest, stream doApi.AuditLog_QueryServer) error {
+	ctx := snapshots.WithCache(stream.Context(), snapshots.NewCache())
+	filter := &esApi.EventFilter{
+		MinTimestamp:  request.GetDateRange().GetMinTimestamp(),
+		MaxTimestamp:  request.GetDateRange().GetMaxTimestamp(),
+		AggregateType: request.GetAggregateType(),
+		AggregateId:   request.GetAggregateId(),
+		EventType:     request.GetEventType(),
+		TenantId:      request.GetTenantId(),
+	}
+	filters := []*esApi.EventFilter{filter}
+
+	if request.GetActorEmail() != nil {
+		users, err := s.userRepo.ByEmailIncludingDeleted(stream.Context(), request.GetActorEmail().GetValue())
+		if err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+		if len(users) == 0 {
+			return nil
+		}
+
+		// one filter per user ever registered with the given email address
+		filters = nil
+		for _, user := range users {
+			userFilter := proto.Clone(filter).(*esApi.EventFilter)
+			userFilter.IssuerId = wrapperspb.String(user.Id)
+			filters = append(filters, userFilter)
+		}
+	}
+
+	eventsStream, err := s.esClient.RetrieveOr(stream.Context(), &esApi.EventFilters{
+		Filters: filters,
+	})
+	if err != nil {
+		return errors.TranslateToGrpcError(err)
+	}
+
+	for {
+		e, err := eventsStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+
+		err = stream.Send(s.auditFormatter.NewAuditEvent(ctx, e))
+		if err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+	}
+
+	return nil
+}
+
+// Export returns the audit events or users overviews of the given date range in the requested format
+func (s *auditLogServer) Export(request *doApi.AuditLogExportRequest, stream doApi.AuditLog_ExportServer) error {
+	cache := snapshots.NewCache()
+	ctx := snapshots.WithCache(stream.Context(), cache)
+	writer, err := export.NewWriter(&exportStreamWriter{stream: stream}, request.GetFormat())
+	if err != nil {
+		return errors.TranslateToGrpcError(errors.ErrInvalidArgument(err.Error()))
+	}
+
+	if err := writer.WriteHeader(request.GetContent()); err != nil {
+		return errors.TranslateToGrpcError(errors.ErrInvalidArgument(err.Error()))
+	}
+
+	filter := &esApi.EventFilter{MaxTimestamp: request.GetDateRange().GetMaxTimestamp()}
+	switch request.GetContent() {
+	case doApi.AuditLogExportRequest_EVENTS:
+		filter.MinTimestamp = request.GetDateRange().GetMinTimestamp()
+	case doApi.AuditLogExportRequest_USERS_OVERVIEW:
+		filter.AggregateType = wrapperspb.String(aggregates.User.String())
+	}
+
+	eventsStream, err := s.esClient.Retrieve(stream.Context(), filter)
+	if err != nil {
+		return errors.TranslateToGrpcError(err)
+	}
+
+	for {
+		e, err := eventsStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+
+		switch request.GetContent() {
+		case doApi.AuditLogExportRequest_EVENTS:
+			cache.Observe(e)
+			err = writer.WriteAuditEvent(s.auditFormatter.NewAuditEvent(ctx, e))
+		case doApi.AuditLogExportRequest_USERS_OVERVIEW:
+			if e.Type != events.UserCreated.String() {
+				continue
+			}
+			err = writer.WriteUserOverview(s.auditFormatter.NewUserOverview(ctx, uuid.MustParse(e.AggregateId), request.GetDateRange().GetMaxTimestamp().AsTime()))
+		}
+		if err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+	}
+
+	return nil
+}
+
+// exportStreamWriter sends everything written to it as chunks over the export stream
+type exportStreamWriter struct {
+	stream doApi.AuditLog_ExportServer
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(wrapperspb.Bytes(p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/metadata"
 	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
 	grpcUtil "github.com/finleap-connect/monoskope/pkg/grpc"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -45,9 +46,9 @@ func NewClusterAccessServer(clusterAccessRepo repositories.ClusterAccessReposito
 	}
 }
 
-func NewClusterAccessClient(ctx context.Context, queryHandlerAddr string) (*grpc.ClientConn, api.ClusterAccessClient, error) {
+func NewClusterAccessClient(ctx context.Context, queryHandlerAddr string, tlsLoader *m8tls.TLSConfigLoader) (*grpc.ClientConn, api.ClusterAccessClient, error) {
 	conn, err := grpcUtil.
-		NewGrpcConnectionFactoryWithInsecure(queryHandlerAddr).
+		NewInternalGrpcConnectionFactory(queryHandlerAddr, tlsLoader).
 		WithOpenTelemetry().
 		ConnectWithTimeout(ctx, 10*time.Second)
 	if err != nil {
@@ -23,6 +23,7 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/errors"
 	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
 	grpcUtil "github.com/finleap-connect/monoskope/pkg/grpc"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
@@ -32,19 +33,21 @@ import (
 type clusterServer struct {
 	api.UnimplementedClusterServer
 
-	repoCluster repositories.ClusterRepository
+	repoCluster    repositories.ClusterRepository
+	visibilityRepo repositories.VisibilityRepository
 }
 
 // NewClusterServiceServer returns a new configured instance of clusterServiceServer
-func NewClusterServer(clusterRepo repositories.ClusterRepository) *clusterServer {
+func NewClusterServer(clusterRepo repositories.ClusterRepository, visibilityRepo repositories.VisibilityRepository) *clusterServer {
 	return &clusterServer{
-		repoCluster: clusterRepo,
+		repoCluster:    clusterRepo,
+		visibilityRepo: visibilityRepo,
 	}
 }
 
-func NewClusterClient(ctx context.Context, queryHandlerAddr string) (*grpc.ClientConn, api.ClusterClient, error) {
+func NewClusterClient(ctx context.Context, queryHandlerAddr string, tlsLoader *m8tls.TLSConfigLoader) (*grpc.ClientConn, api.ClusterClient, error) {
 	conn, err := grpcUtil.
-		NewGrpcConnectionFactoryWithInsecure(queryHandlerAddr).
+		NewInternalGrpcConnectionFactory(queryHandlerAddr, tlsLoader).
 		WithOpenTelemetry().
 		ConnectWithTimeout(ctx, 10*time.Second)
 	if err != nil {
@@ -65,7 +68,7 @@ func (s *clusterServer) GetById(ctx context.Context, id *wrappers.StringValue) (
 	if err != nil {
 		return nil, err
 	}
-	return cluster.Proto(), nil
+	return s.toVisibleCluster(ctx, cluster.Proto())
 }
 
 // GetByName returns the cluster found by the given name.
@@ -74,17 +77,37 @@ func (s *clusterServer) GetByName(ctx context.Context, name *wrappers.StringValu
 	if err != nil {
 		return nil, errors.TranslateToGrpcError(err)
 	}
-	return cluster.Proto(), nil
+	return s.toVisibleCluster(ctx, cluster.Proto())
 }
 
-// GetAll returns all clusters.
-func (s *clusterServer) GetAll(request *api.GetAllRequest, stream api.Cluster_GetAllServer) error {
-	clusters, err := s.repoCluster.AllWith(stream.Context(), request.GetIncludeDeleted())
+// toVisibleCluster returns the cluster or not found if it is not visible to the caller.
+func (s *clusterServer) toVisibleCluster(ctx context.Context, cluster *projections.Cluster) (*projections.Cluster, error) {
+	visibility, err := getVisibility(ctx, s.visibilityRepo)
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+	if !visibility.CanSeeCluster(cluster.Id) {
+		return nil, errors.TranslateToGrpcError(errors.ErrClusterNotFound)
+	}
+	return cluster, nil
+}
+
+// GetAll returns all clusters matching the label selector of the request.
+func (s *clusterServer) GetAll(request *api.GetAllClustersRequest, stream api.Cluster_GetAllServer) error {
+	visibility, err := getVisibility(stream.Context(), s.visibilityRepo)
+	if err != nil {
+		return errors.TranslateToGrpcError(err)
+	}
+
+	clusters, err := s.repoCluster.AllWithLabelSelector(stream.Context(), request.GetIncludeDeleted(), request.GetLabelSelector())
 	if err != nil {
 		return errors.TranslateToGrpcError(err)
 	}
 
 	for _, c := range clusters {
+		if !visibility.CanSeeCluster(c.Id) {
+			continue
+		}
 		err := stream.Send(c.Proto())
 		if err != nil {
 			return errors.TranslateToGrpcError(err)
@@ -0,0 +1,62 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryhandler
+
+import (
+	api "github.com/finleap-connect/monoskope/pkg/api/domain"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/projections"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("internal/queryhandler/clusterServer", func() {
+	var (
+		d      *visibilityTestData
+		server *clusterServer
+	)
+
+	BeforeEach(func() {
+		d = newVisibilityTestData()
+		server = NewClusterServer(d.clusterRepo, d.visibilityRepo)
+	})
+
+	It("GetAll() returns all clusters to system admins", func() {
+		stream := newTestServerStream[*projections.Cluster](contextOf(d.admin))
+		Expect(server.GetAll(&api.GetAllClustersRequest{}, stream)).To(Succeed())
+		Expect(stream.sent).To(HaveLen(2))
+	})
+
+	It("GetAll() returns only clusters bound to own tenants to tenant members", func() {
+		stream := newTestServerStream[*projections.Cluster](contextOf(d.member))
+		Expect(server.GetAll(&api.GetAllClustersRequest{}, stream)).To(Succeed())
+		Expect(stream.sent).To(HaveLen(1))
+		Expect(stream.sent[0].Id).To(Equal(d.cluster.Id))
+	})
+
+	It("GetById() and GetByName() return not found for invisible clusters", func() {
+		_, err := server.GetById(contextOf(d.member), wrapperspb.String(d.otherCluster.Id))
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		_, err = server.GetByName(contextOf(d.member), wrapperspb.String(d.otherCluster.Name))
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		cluster, err := server.GetById(contextOf(d.member), wrapperspb.String(d.cluster.Id))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.Name).To(Equal(d.cluster.Name))
+	})
+})
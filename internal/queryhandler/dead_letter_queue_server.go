@@ -0,0 +1,87 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryhandler
+
+import (
+	"context"
+
+	api "github.com/finleap-connect/monoskope/pkg/api/domain"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// deadLetterQueueServer is the implementation of the DeadLetterQueue API
+type deadLetterQueueServer struct {
+	api.UnimplementedDeadLetterQueueServer
+
+	queue es.DeadLetterQueue
+}
+
+// NewDeadLetterQueueServer returns a new configured instance of deadLetterQueueServer
+func NewDeadLetterQueueServer(queue es.DeadLetterQueue) *deadLetterQueueServer {
+	return &deadLetterQueueServer{
+		queue: queue,
+	}
+}
+
+// GetAll returns all quarantined events.
+func (s *deadLetterQueueServer) GetAll(_ *emptypb.Empty, stream api.DeadLetterQueue_GetAllServer) error {
+	deadLetters, err := s.queue.GetDeadLetters(stream.Context())
+	if err != nil {
+		return errors.TranslateToGrpcError(err)
+	}
+
+	for _, deadLetter := range deadLetters {
+		err := stream.Send(newDeadLetterProto(deadLetter))
+		if err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+	}
+	return nil
+}
+
+// Retry hands the quarantined event with the given id to the handler which failed again.
+func (s *deadLetterQueueServer) Retry(ctx context.Context, id *wrappers.StringValue) (*emptypb.Empty, error) {
+	if err := s.queue.RetryDeadLetter(ctx, id.GetValue()); err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Discard removes the quarantined event with the given id.
+func (s *deadLetterQueueServer) Discard(ctx context.Context, id *wrappers.StringValue) (*emptypb.Empty, error) {
+	if err := s.queue.DiscardDeadLetter(ctx, id.GetValue()); err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// newDeadLetterProto converts es.DeadLetter to proto api.DeadLetter
+func newDeadLetterProto(deadLetter *es.DeadLetter) *api.DeadLetter {
+	protoDeadLetter := &api.DeadLetter{
+		Id:        deadLetter.ID,
+		Handler:   deadLetter.Handler,
+		Error:     deadLetter.Error,
+		Retries:   int32(deadLetter.Retries),
+		Timestamp: timestamppb.New(deadLetter.Timestamp),
+	}
+	if deadLetter.Event != nil {
+		protoDeadLetter.Event = es.NewProtoFromEvent(deadLetter.Event)
+	}
+	return protoDeadLetter
+}
@@ -0,0 +1,109 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryhandler
+
+import (
+	"context"
+	"time"
+
+	api "github.com/finleap-connect/monoskope/pkg/api/domain"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// testDeadLetterQueue keeps dead letters in memory
+type testDeadLetterQueue struct {
+	deadLetters map[string]*es.DeadLetter
+	retried     []string
+}
+
+func (q *testDeadLetterQueue) GetDeadLetters(context.Context) ([]*es.DeadLetter, error) {
+	var deadLetters []*es.DeadLetter
+	for _, deadLetter := range q.deadLetters {
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters, nil
+}
+
+func (q *testDeadLetterQueue) RetryDeadLetter(_ context.Context, id string) error {
+	if _, ok := q.deadLetters[id]; !ok {
+		return esErrors.ErrDeadLetterNotFound
+	}
+	delete(q.deadLetters, id)
+	q.retried = append(q.retried, id)
+	return nil
+}
+
+func (q *testDeadLetterQueue) DiscardDeadLetter(_ context.Context, id string) error {
+	if _, ok := q.deadLetters[id]; !ok {
+		return esErrors.ErrDeadLetterNotFound
+	}
+	delete(q.deadLetters, id)
+	return nil
+}
+
+var _ = Describe("internal/queryhandler/deadLetterQueueServer", func() {
+	var (
+		ctx    = context.Background()
+		queue  *testDeadLetterQueue
+		server *deadLetterQueueServer
+	)
+
+	BeforeEach(func() {
+		queue = &testDeadLetterQueue{
+			deadLetters: map[string]*es.DeadLetter{
+				"poison": {
+					ID:        "poison",
+					Handler:   "queryhandler",
+					Error:     "handler failed",
+					Retries:   3,
+					Timestamp: time.Now().UTC(),
+				},
+			},
+		}
+		server = NewDeadLetterQueueServer(queue)
+	})
+
+	It("GetAll() returns all dead letters", func() {
+		stream := newTestServerStream[*api.DeadLetter](ctx)
+		Expect(server.GetAll(&emptypb.Empty{}, stream)).To(Succeed())
+		Expect(stream.sent).To(HaveLen(1))
+		Expect(stream.sent[0].Id).To(Equal("poison"))
+		Expect(stream.sent[0].Handler).To(Equal("queryhandler"))
+		Expect(stream.sent[0].Retries).To(BeNumerically("==", 3))
+		Expect(stream.sent[0].Event).To(BeNil())
+	})
+
+	It("Retry() hands the dead letter to the handler again", func() {
+		_, err := server.Retry(ctx, wrapperspb.String("poison"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(queue.retried).To(ConsistOf("poison"))
+	})
+
+	It("Discard() removes the dead letter", func() {
+		_, err := server.Discard(ctx, wrapperspb.String("poison"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(queue.deadLetters).To(BeEmpty())
+
+		_, err = server.Discard(ctx, wrapperspb.String("poison"))
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+})
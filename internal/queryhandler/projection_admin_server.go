@@ -0,0 +1,119 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryhandler
+
+import (
+	"context"
+	"time"
+
+	api "github.com/finleap-connect/monoskope/pkg/api/domain"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	"github.com/finleap-connect/monoskope/pkg/domain/handler"
+	grpcUtil "github.com/finleap-connect/monoskope/pkg/grpc"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// projectionAdminServer is the implementation of the ProjectionAdmin API
+type projectionAdminServer struct {
+	api.UnimplementedProjectionAdminServer
+
+	rebuilders []handler.ProjectionRebuilder
+}
+
+// NewProjectionAdminServer returns a new configured instance of projectionAdminServer
+func NewProjectionAdminServer(rebuilders ...handler.ProjectionRebuilder) *projectionAdminServer {
+	return &projectionAdminServer{
+		rebuilders: rebuilders,
+	}
+}
+
+// NewProjectionAdminClient creates a ProjectionAdmin client which sends the auth token, with mutual TLS if a TLSConfigLoader is given and without TLS otherwise
+func NewProjectionAdminClient(ctx context.Context, queryHandlerAddr, authToken string, tlsLoader *m8tls.TLSConfigLoader) (*grpc.ClientConn, api.ProjectionAdminClient, error) {
+	return grpcUtil.NewInternalClientWithAuth(ctx, queryHandlerAddr, authToken, tlsLoader, api.NewProjectionAdminClient)
+}
+
+// Rebuild rebuilds the projections of the given aggregate type or of all aggregate types if empty.
+func (s *projectionAdminServer) Rebuild(aggregateType *wrappers.StringValue, stream api.ProjectionAdmin_RebuildServer) error {
+	rebuilders, err := s.selectRebuilders(aggregateType.GetValue())
+	if err != nil {
+		return errors.TranslateToGrpcError(err)
+	}
+
+	for _, rebuilder := range rebuilders {
+		started := time.Now()
+		projections, err := rebuilder.Rebuild(stream.Context())
+		if err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+
+		err = stream.Send(&api.ProjectionRebuildResult{
+			AggregateType: rebuilder.AggregateType().String(),
+			Projections:   uint64(projections),
+			Duration:      durationpb.New(time.Since(started)),
+		})
+		if err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+	}
+	return nil
+}
+
+// Check compares the versions of the projections of the given aggregate type or of all aggregate types if empty with the event store.
+func (s *projectionAdminServer) Check(aggregateType *wrappers.StringValue, stream api.ProjectionAdmin_CheckServer) error {
+	rebuilders, err := s.selectRebuilders(aggregateType.GetValue())
+	if err != nil {
+		return errors.TranslateToGrpcError(err)
+	}
+
+	for _, rebuilder := range rebuilders {
+		checked, drift, err := rebuilder.Check(stream.Context())
+		if err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+
+		result := &api.ProjectionCheckResult{
+			AggregateType:      rebuilder.AggregateType().String(),
+			CheckedProjections: uint64(checked),
+		}
+		for _, d := range drift {
+			result.Drift = append(result.Drift, &api.ProjectionDrift{
+				AggregateId:       d.AggregateID.String(),
+				ProjectionVersion: d.ProjectionVersion,
+				AggregateVersion:  d.AggregateVersion,
+			})
+		}
+
+		if err := stream.Send(result); err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+	}
+	return nil
+}
+
+// selectRebuilders returns the rebuilders of the given aggregate type or all if empty.
+func (s *projectionAdminServer) selectRebuilders(aggregateType string) ([]handler.ProjectionRebuilder, error) {
+	if aggregateType == "" {
+		return s.rebuilders, nil
+	}
+	for _, rebuilder := range s.rebuilders {
+		if rebuilder.AggregateType().String() == aggregateType {
+			return []handler.ProjectionRebuilder{rebuilder}, nil
+		}
+	}
+	return nil, errors.ErrProjectionsNotFound
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryhandler
+
+import (
+	"context"
+
+	api "github.com/finleap-connect/monoskope/pkg/api/domain"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	"github.com/finleap-connect/monoskope/pkg/domain/handler"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// testProjectionRebuilder counts rebuilds and reports fixed drift
+type testProjectionRebuilder struct {
+	aggregateType es.AggregateType
+	rebuilds      int
+	drift         []*handler.ProjectionDrift
+}
+
+func (r *testProjectionRebuilder) AggregateType() es.AggregateType {
+	return r.aggregateType
+}
+
+func (r *testProjectionRebuilder) Rebuild(context.Context) (int, error) {
+	r.rebuilds++
+	return 2, nil
+}
+
+func (r *testProjectionRebuilder) Check(context.Context) (int, []*handler.ProjectionDrift, error) {
+	return 2, r.drift, nil
+}
+
+var _ = Describe("internal/queryhandler/projectionAdminServer", func() {
+	var (
+		ctx                            = context.Background()
+		tenantRebuilder, userRebuilder *testProjectionRebuilder
+		server                         *projectionAdminServer
+	)
+
+	BeforeEach(func() {
+		tenantRebuilder = &testProjectionRebuilder{aggregateType: aggregates.Tenant}
+		userRebuilder = &testProjectionRebuilder{aggregateType: aggregates.User}
+		server = NewProjectionAdminServer(tenantRebuilder, userRebuilder)
+	})
+
+	It("Rebuild() rebuilds the projections of all aggregate types", func() {
+		stream := newTestServerStream[*api.ProjectionRebuildResult](ctx)
+		Expect(server.Rebuild(wrapperspb.String(""), stream)).To(Succeed())
+		Expect(stream.sent).To(HaveLen(2))
+		Expect(stream.sent[0].AggregateType).To(Equal(aggregates.Tenant.String()))
+		Expect(stream.sent[0].Projections).To(BeNumerically("==", 2))
+		Expect(tenantRebuilder.rebuilds).To(Equal(1))
+		Expect(userRebuilder.rebuilds).To(Equal(1))
+	})
+
+	It("Rebuild() rebuilds the projections of the given aggregate type only", func() {
+		stream := newTestServerStream[*api.ProjectionRebuildResult](ctx)
+		Expect(server.Rebuild(wrapperspb.String(aggregates.User.String()), stream)).To(Succeed())
+		Expect(stream.sent).To(HaveLen(1))
+		Expect(tenantRebuilder.rebuilds).To(Equal(0))
+		Expect(userRebuilder.rebuilds).To(Equal(1))
+	})
+
+	It("Rebuild() returns not found for unknown aggregate types", func() {
+		stream := newTestServerStream[*api.ProjectionRebuildResult](ctx)
+		err := server.Rebuild(wrapperspb.String("Unknown"), stream)
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("Check() reports drift", func() {
+		aggregateId := uuid.New()
+		tenantRebuilder.drift = []*handler.ProjectionDrift{
+			{AggregateType: aggregates.Tenant, AggregateID: aggregateId, ProjectionVersion: 1, AggregateVersion: 2},
+		}
+
+		stream := newTestServerStream[*api.ProjectionCheckResult](ctx)
+		Expect(server.Check(wrapperspb.String(aggregates.Tenant.String()), stream)).To(Succeed())
+		Expect(stream.sent).To(HaveLen(1))
+		Expect(stream.sent[0].CheckedProjections).To(BeNumerically("==", 2))
+		Expect(stream.sent[0].Drift).To(HaveLen(1))
+		Expect(stream.sent[0].Drift[0].AggregateId).To(Equal(aggregateId.String()))
+		Expect(stream.sent[0].Drift[0].ProjectionVersion).To(BeNumerically("==", 1))
+		Expect(stream.sent[0].Drift[0].AggregateVersion).To(BeNumerically("==", 2))
+	})
+})
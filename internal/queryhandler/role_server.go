@@ -0,0 +1,80 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryhandler
+
+import (
+	"context"
+	"time"
+
+	api "github.com/finleap-connect/monoskope/pkg/api/domain"
+	projections "github.com/finleap-connect/monoskope/pkg/api/domain/projections"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
+	grpcUtil "github.com/finleap-connect/monoskope/pkg/grpc"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc"
+)
+
+// roleServer is the implementation of the Role API
+type roleServer struct {
+	api.UnimplementedRoleServer
+
+	repoRole repositories.RoleRepository
+}
+
+// NewRoleServer returns a new configured instance of roleServer
+func NewRoleServer(roleRepo repositories.RoleRepository) *roleServer {
+	return &roleServer{
+		repoRole: roleRepo,
+	}
+}
+
+func NewRoleClient(ctx context.Context, queryHandlerAddr string, tlsLoader *m8tls.TLSConfigLoader) (*grpc.ClientConn, api.RoleClient, error) {
+	conn, err := grpcUtil.
+		NewInternalGrpcConnectionFactory(queryHandlerAddr, tlsLoader).
+		WithOpenTelemetry().
+		ConnectWithTimeout(ctx, 10*time.Second)
+	if err != nil {
+		return nil, nil, errors.TranslateToGrpcError(err)
+	}
+
+	return conn, api.NewRoleClient(conn), nil
+}
+
+// GetByName returns the role found by the given name.
+func (s *roleServer) GetByName(ctx context.Context, name *wrappers.StringValue) (*projections.Role, error) {
+	role, err := s.repoRole.ByName(ctx, name.GetValue())
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+	return role.Proto(), nil
+}
+
+// GetAll returns all defined roles.
+func (s *roleServer) GetAll(request *api.GetAllRequest, stream api.Role_GetAllServer) error {
+	roles, err := s.repoRole.AllWith(stream.Context(), request.GetIncludeDeleted())
+	if err != nil {
+		return errors.TranslateToGrpcError(err)
+	}
+
+	for _, r := range roles {
+		err := stream.Send(r.Proto())
+		if err != nil {
+			return errors.TranslateToGrpcError(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryhandler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
+	"github.com/finleap-connect/monoskope/pkg/domain/metadata"
+	"github.com/finleap-connect/monoskope/pkg/domain/projections"
+	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
+	es_repos "github.com/finleap-connect/monoskope/pkg/eventsourcing/repositories"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	_ "github.com/finleap-connect/monoskope/internal/test"
+)
+
+func TestQueryHandler(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "TestQueryHandler")
+}
+
+// testServerStream collects the messages sent by server streaming RPCs
+type testServerStream[T any] struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []T
+}
+
+func newTestServerStream[T any](ctx context.Context) *testServerStream[T] {
+	return &testServerStream[T]{ctx: ctx}
+}
+
+func (s *testServerStream[T]) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testServerStream[T]) Send(m T) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+// visibilityTestData consists of two tenants with one cluster and one member each and a system admin.
+type visibilityTestData struct {
+	admin, member, otherMember *projections.User
+	tenant, otherTenant        *projections.Tenant
+	cluster, otherCluster      *projections.Cluster
+
+	userRepo       repositories.UserRepository
+	tenantRepo     repositories.TenantRepository
+	tenantUserRepo repositories.TenantUserRepository
+	clusterRepo    repositories.ClusterRepository
+	visibilityRepo repositories.VisibilityRepository
+}
+
+func newVisibilityTestData() *visibilityTestData {
+	ctx := context.Background()
+	d := new(visibilityTestData)
+
+	newUser := func(name string) *projections.User {
+		user := projections.NewUserProjection(uuid.New())
+		user.Name = name
+		user.Email = name + "@monoskope.io"
+		return user
+	}
+	d.admin = newUser("admin")
+	d.member = newUser("member")
+	d.otherMember = newUser("other")
+
+	d.tenant = projections.NewTenantProjection(uuid.New())
+	d.tenant.Name = "tenant"
+	d.otherTenant = projections.NewTenantProjection(uuid.New())
+	d.otherTenant.Name = "other-tenant"
+
+	d.cluster = projections.NewClusterProjection(uuid.New())
+	d.cluster.Name = "cluster"
+	d.otherCluster = projections.NewClusterProjection(uuid.New())
+	d.otherCluster.Name = "other-cluster"
+
+	newRoleBinding := func(user *projections.User, role, scope, resource string) *projections.UserRoleBinding {
+		roleBinding := projections.NewUserRoleBinding(uuid.New())
+		roleBinding.UserId = user.Id
+		roleBinding.Role = role
+		roleBinding.Scope = scope
+		roleBinding.Resource = resource
+		return roleBinding
+	}
+	inMemoryRoleBindingRepo := es_repos.NewInMemoryRepository[*projections.UserRoleBinding]()
+	for _, roleBinding := range []*projections.UserRoleBinding{
+		newRoleBinding(d.admin, string(roles.Admin), string(scopes.System), ""),
+		newRoleBinding(d.member, string(roles.User), string(scopes.Tenant), d.tenant.Id),
+		newRoleBinding(d.otherMember, string(roles.User), string(scopes.Tenant), d.otherTenant.Id),
+		newRoleBinding(d.otherMember, string(roles.OnCall), string(scopes.Tenant), d.tenant.Id),
+	} {
+		Expect(inMemoryRoleBindingRepo.Upsert(ctx, roleBinding)).To(Succeed())
+	}
+
+	inMemoryUserRepo := es_repos.NewInMemoryRepository[*projections.User]()
+	for _, user := range []*projections.User{d.admin, d.member, d.otherMember} {
+		Expect(inMemoryUserRepo.Upsert(ctx, user)).To(Succeed())
+	}
+
+	inMemoryTenantRepo := es_repos.NewInMemoryRepository[*projections.Tenant]()
+	Expect(inMemoryTenantRepo.Upsert(ctx, d.tenant)).To(Succeed())
+	Expect(inMemoryTenantRepo.Upsert(ctx, d.otherTenant)).To(Succeed())
+
+	inMemoryClusterRepo := es_repos.NewInMemoryRepository[*projections.Cluster]()
+	Expect(inMemoryClusterRepo.Upsert(ctx, d.cluster)).To(Succeed())
+	Expect(inMemoryClusterRepo.Upsert(ctx, d.otherCluster)).To(Succeed())
+
+	newBinding := func(tenant *projections.Tenant, cluster *projections.Cluster) *projections.TenantClusterBinding {
+		binding := projections.NewTenantClusterBindingProjection(uuid.New())
+		binding.TenantId = tenant.Id
+		binding.ClusterId = cluster.Id
+		return binding
+	}
+	inMemoryTenantClusterBindingRepo := es_repos.NewInMemoryRepository[*projections.TenantClusterBinding]()
+	Expect(inMemoryTenantClusterBindingRepo.Upsert(ctx, newBinding(d.tenant, d.cluster))).To(Succeed())
+	Expect(inMemoryTenantClusterBindingRepo.Upsert(ctx, newBinding(d.otherTenant, d.otherCluster))).To(Succeed())
+
+	userRoleBindingRepo := repositories.NewUserRoleBindingRepository(inMemoryRoleBindingRepo)
+	d.userRepo = repositories.NewUserRepository(inMemoryUserRepo, userRoleBindingRepo)
+	d.tenantRepo = repositories.NewTenantRepository(inMemoryTenantRepo)
+	d.tenantUserRepo = repositories.NewTenantUserRepository(d.userRepo, userRoleBindingRepo, d.tenantRepo)
+	d.clusterRepo = repositories.NewClusterRepository(inMemoryClusterRepo)
+	clusterAccessRepo := repositories.NewClusterAccessRepository(
+		repositories.NewTenantClusterBindingRepository(inMemoryTenantClusterBindingRepo, d.clusterRepo),
+		d.clusterRepo,
+		userRoleBindingRepo,
+		d.tenantRepo,
+		repositories.NewRoleRepository(es_repos.NewInMemoryRepository[*projections.Role]()),
+	)
+	d.visibilityRepo = repositories.NewVisibilityRepository(userRoleBindingRepo, d.tenantRepo, clusterAccessRepo)
+
+	return d
+}
+
+// contextOf returns a context as received by the query handler for calls of the given user
+func contextOf(user *projections.User) context.Context {
+	metadataManager, err := metadata.NewDomainMetadataManager(context.Background())
+	Expect(err).NotTo(HaveOccurred())
+	metadataManager.SetUserInformation(&metadata.UserInformation{
+		Id:    user.ID(),
+		Name:  user.Name,
+		Email: user.Email,
+	})
+	return metadataManager.GetContext()
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/errors"
 	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
 	grpcUtil "github.com/finleap-connect/monoskope/pkg/grpc"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
@@ -32,21 +33,23 @@ import (
 type tenantServer struct {
 	api.UnimplementedTenantServer
 
-	repoTenant repositories.TenantRepository
-	repoUsers  repositories.TenantUserRepository
+	repoTenant     repositories.TenantRepository
+	repoUsers      repositories.TenantUserRepository
+	visibilityRepo repositories.VisibilityRepository
 }
 
 // NewTenantServiceServer returns a new configured instance of tenantServiceServer
-func NewTenantServer(tenantRepo repositories.TenantRepository, tenantUserRepo repositories.TenantUserRepository) *tenantServer {
+func NewTenantServer(tenantRepo repositories.TenantRepository, tenantUserRepo repositories.TenantUserRepository, visibilityRepo repositories.VisibilityRepository) *tenantServer {
 	return &tenantServer{
-		repoTenant: tenantRepo,
-		repoUsers:  tenantUserRepo,
+		repoTenant:     tenantRepo,
+		repoUsers:      tenantUserRepo,
+		visibilityRepo: visibilityRepo,
 	}
 }
 
-func NewTenantClient(ctx context.Context, queryHandlerAddr string) (*grpc.ClientConn, api.TenantClient, error) {
+func NewTenantClient(ctx context.Context, queryHandlerAddr string, tlsLoader *m8tls.TLSConfigLoader) (*grpc.ClientConn, api.TenantClient, error) {
 	conn, err := grpcUtil.
-		NewGrpcConnectionFactoryWithInsecure(queryHandlerAddr).
+		NewInternalGrpcConnectionFactory(queryHandlerAddr, tlsLoader).
 		WithOpenTelemetry().
 		ConnectWithTimeout(ctx, 10*time.Second)
 	if err != nil {
@@ -67,7 +70,7 @@ func (s *tenantServer) GetById(ctx context.Context, id *wrappers.StringValue) (*
 	if err != nil {
 		return nil, err
 	}
-	return tenant.Proto(), nil
+	return s.toVisibleTenant(ctx, tenant.Proto())
 }
 
 // GetByName returns the tenant found by the given name.
@@ -76,17 +79,37 @@ func (s *tenantServer) GetByName(ctx context.Context, name *wrappers.StringValue
 	if err != nil {
 		return nil, errors.TranslateToGrpcError(err)
 	}
-	return tenant.Proto(), nil
+	return s.toVisibleTenant(ctx, tenant.Proto())
+}
+
+// toVisibleTenant returns the tenant or not found if it is not visible to the caller.
+func (s *tenantServer) toVisibleTenant(ctx context.Context, tenant *projections.Tenant) (*projections.Tenant, error) {
+	visibility, err := getVisibility(ctx, s.visibilityRepo)
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+	if !visibility.CanSeeTenant(tenant.Id) {
+		return nil, errors.TranslateToGrpcError(errors.ErrTenantNotFound)
+	}
+	return tenant, nil
 }
 
 // GetAll returns all tenants.
 func (s *tenantServer) GetAll(request *api.GetAllRequest, stream api.Tenant_GetAllServer) error {
+	visibility, err := getVisibility(stream.Context(), s.visibilityRepo)
+	if err != nil {
+		return errors.TranslateToGrpcError(err)
+	}
+
 	tenants, err := s.repoTenant.AllWith(stream.Context(), request.GetIncludeDeleted())
 	if err != nil {
 		return errors.TranslateToGrpcError(err)
 	}
 
 	for _, t := range tenants {
+		if !visibility.CanSeeTenant(t.Id) {
+			continue
+		}
 		err := stream.Send(t.Proto())
 		if err != nil {
 			return errors.TranslateToGrpcError(err)
@@ -112,6 +135,10 @@ func (s *tenantServer) GetUsers(id *wrappers.StringValue, stream api.Tenant_GetU
 		return nil
 	}
 
+	if _, err := s.toVisibleTenant(stream.Context(), tenant.Proto()); err != nil {
+		return err
+	}
+
 	users, err := s.repoUsers.GetTenantUsersById(stream.Context(), uuid)
 	if err != nil {
 		return err
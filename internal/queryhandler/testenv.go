@@ -70,7 +70,7 @@ func NewTestEnvWithParent(testeEnv *test.TestEnv, eventStoreTestEnv *eventstore.
 		return nil, err
 	}
 
-	env.esConn, env.esClient, err = eventstore.NewEventStoreClient(ctx, env.eventStoreTestEnv.GetApiAddr())
+	env.esConn, env.esClient, err = eventstore.NewEventStoreClient(ctx, env.eventStoreTestEnv.GetApiAddr(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -98,9 +98,10 @@ func NewTestEnvWithParent(testeEnv *test.TestEnv, eventStoreTestEnv *eventstore.
 	)
 
 	env.grpcServer.RegisterService(func(s ggrpc.ServiceRegistrar) {
-		api.RegisterUserServer(s, NewUserServer(qhDomain.UserRepository))
-		api.RegisterTenantServer(s, NewTenantServer(qhDomain.TenantRepository, qhDomain.TenantUserRepository))
-		api.RegisterClusterServer(s, NewClusterServer(qhDomain.ClusterRepository))
+		api.RegisterUserServer(s, NewUserServer(qhDomain.UserRepository, qhDomain.VisibilityRepo))
+		api.RegisterTenantServer(s, NewTenantServer(qhDomain.TenantRepository, qhDomain.TenantUserRepository, qhDomain.VisibilityRepo))
+		api.RegisterRoleServer(s, NewRoleServer(qhDomain.RoleRepository))
+		api.RegisterClusterServer(s, NewClusterServer(qhDomain.ClusterRepository, qhDomain.VisibilityRepo))
 		api.RegisterClusterAccessServer(s, NewClusterAccessServer(qhDomain.ClusterAccessRepo, qhDomain.TenantClusterBindingRepository))
 		api.RegisterAuditLogServer(s, NewAuditLogServer(env.esClient, ef.DefaultEventFormatterRegistry, qhDomain.UserRepository))
 	})
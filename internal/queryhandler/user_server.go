@@ -23,19 +23,22 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 )
 
 // UserServer is the implementation of the TenantService API
 type UserServer struct {
 	api.UnimplementedUserServer
 
-	repo repositories.UserRepository
+	repo           repositories.UserRepository
+	visibilityRepo repositories.VisibilityRepository
 }
 
 // NewUserServer returns a new configured instance of UserServer
-func NewUserServer(userRepo repositories.UserRepository) *UserServer {
+func NewUserServer(userRepo repositories.UserRepository, visibilityRepo repositories.VisibilityRepository) *UserServer {
 	return &UserServer{
-		repo: userRepo,
+		repo:           userRepo,
+		visibilityRepo: visibilityRepo,
 	}
 }
 
@@ -50,7 +53,7 @@ func (s *UserServer) GetById(ctx context.Context, userId *wrappers.StringValue)
 	if err != nil {
 		return nil, errors.TranslateToGrpcError(err)
 	}
-	return user.Proto(), nil
+	return s.toVisibleUser(ctx, user.Proto())
 }
 
 // GetByEmail returns the user found by the given email address.
@@ -59,7 +62,35 @@ func (s *UserServer) GetByEmail(ctx context.Context, email *wrappers.StringValue
 	if err != nil {
 		return nil, errors.TranslateToGrpcError(err)
 	}
-	return user.Proto(), nil
+	return s.toVisibleUser(ctx, user.Proto())
+}
+
+// toVisibleUser returns the user with the role bindings visible to the caller or not found if the user is not visible at all.
+func (s *UserServer) toVisibleUser(ctx context.Context, user *projections.User) (*projections.User, error) {
+	visibility, err := getVisibility(ctx, s.visibilityRepo)
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+	return visibleUser(visibility, user)
+}
+
+// visibleUser returns the user with the role bindings of the given visibility or not found if the user is not visible at all.
+func visibleUser(visibility *repositories.Visibility, user *projections.User) (*projections.User, error) {
+	if !visibility.CanSeeUser(user.Id) {
+		return nil, errors.TranslateToGrpcError(errors.ErrUserNotFound)
+	}
+	if visibility.CanSeeAll() {
+		return user, nil
+	}
+
+	visibleUser := proto.Clone(user).(*projections.User)
+	visibleUser.Roles = nil
+	for _, role := range user.Roles {
+		if visibility.CanSeeRoleBinding(role.Scope, role.Resource) {
+			visibleUser.Roles = append(visibleUser.Roles, role)
+		}
+	}
+	return visibleUser, nil
 }
 
 func (s *UserServer) GetRoleBindingsById(userId *wrappers.StringValue, stream api.User_GetRoleBindingsByIdServer) error {
@@ -78,7 +109,12 @@ func (s *UserServer) GetRoleBindingsById(userId *wrappers.StringValue, stream ap
 		return nil
 	}
 
-	for _, role := range user.Roles {
+	visibleUser, err := s.toVisibleUser(stream.Context(), user.Proto())
+	if err != nil {
+		return err
+	}
+
+	for _, role := range visibleUser.Roles {
 		err := stream.Send(role)
 		if err != nil {
 			return errors.TranslateToGrpcError(err)
@@ -88,13 +124,25 @@ func (s *UserServer) GetRoleBindingsById(userId *wrappers.StringValue, stream ap
 }
 
 func (s *UserServer) GetAll(request *api.GetAllRequest, stream api.User_GetAllServer) error {
+	visibility, err := getVisibility(stream.Context(), s.visibilityRepo)
+	if err != nil {
+		return errors.TranslateToGrpcError(err)
+	}
+
 	users, err := s.repo.AllWith(stream.Context(), request.GetIncludeDeleted())
 	if err != nil {
 		return errors.TranslateToGrpcError(err)
 	}
 
 	for _, user := range users {
-		err := stream.Send(user.Proto())
+		if !visibility.CanSeeUser(user.Id) {
+			continue
+		}
+		visibleUser, err := visibleUser(visibility, user.Proto())
+		if err != nil {
+			return err
+		}
+		err = stream.Send(visibleUser)
 		if err != nil {
 			return errors.TranslateToGrpcError(err)
 		}
@@ -103,11 +151,33 @@ func (s *UserServer) GetAll(request *api.GetAllRequest, stream api.User_GetAllSe
 }
 
 func (s *UserServer) GetCount(ctx context.Context, request *api.GetCountRequest) (*api.GetCountResult, error) {
-	userCount, err := s.repo.GetCount(ctx, request.GetIncludeDeleted())
+	visibility, err := getVisibility(ctx, s.visibilityRepo)
 	if err != nil {
 		return nil, errors.TranslateToGrpcError(err)
 	}
+
+	if visibility.CanSeeAll() {
+		userCount, err := s.repo.GetCount(ctx, request.GetIncludeDeleted())
+		if err != nil {
+			return nil, errors.TranslateToGrpcError(err)
+		}
+		return &api.GetCountResult{
+			Count: int64(userCount),
+		}, nil
+	}
+
+	// only visible users are counted
+	users, err := s.repo.AllWith(ctx, request.GetIncludeDeleted())
+	if err != nil {
+		return nil, errors.TranslateToGrpcError(err)
+	}
+	var userCount int64
+	for _, user := range users {
+		if visibility.CanSeeUser(user.Id) {
+			userCount++
+		}
+	}
 	return &api.GetCountResult{
-		Count: int64(userCount),
-	}, err
+		Count: userCount,
+	}, nil
 }
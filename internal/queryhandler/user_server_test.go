@@ -0,0 +1,128 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryhandler
+
+import (
+	api "github.com/finleap-connect/monoskope/pkg/api/domain"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/projections"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("internal/queryhandler/UserServer", func() {
+	var (
+		d      *visibilityTestData
+		server *UserServer
+	)
+
+	BeforeEach(func() {
+		d = newVisibilityTestData()
+		server = NewUserServer(d.userRepo, d.visibilityRepo)
+	})
+
+	userNames := func(users []*projections.User) []string {
+		var names []string
+		for _, user := range users {
+			names = append(names, user.Name)
+		}
+		return names
+	}
+
+	It("GetAll() returns all users to system admins", func() {
+		stream := newTestServerStream[*projections.User](contextOf(d.admin))
+		Expect(server.GetAll(&api.GetAllRequest{}, stream)).To(Succeed())
+		Expect(userNames(stream.sent)).To(ConsistOf("admin", "member", "other"))
+	})
+
+	It("GetAll() returns only co-members to tenant members", func() {
+		stream := newTestServerStream[*projections.User](contextOf(d.member))
+		Expect(server.GetAll(&api.GetAllRequest{}, stream)).To(Succeed())
+		Expect(userNames(stream.sent)).To(ConsistOf("member", "other"))
+	})
+
+	It("GetAll() only contains role bindings of visible tenants", func() {
+		rolesOf := func(users []*projections.User, userId string) []*projections.UserRoleBinding {
+			for _, user := range users {
+				if user.Id == userId {
+					return user.Roles
+				}
+			}
+			return nil
+		}
+
+		// the co-member's projection carries role bindings of the tenant invisible to the member as well
+		user, err := server.GetById(contextOf(d.admin), wrapperspb.String(d.otherMember.Id))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(user.Roles).To(HaveLen(2))
+		d.otherMember.Roles = user.Roles
+
+		stream := newTestServerStream[*projections.User](contextOf(d.member))
+		Expect(server.GetAll(&api.GetAllRequest{}, stream)).To(Succeed())
+		roles := rolesOf(stream.sent, d.otherMember.Id)
+		Expect(roles).To(HaveLen(1))
+		Expect(roles[0].Resource).To(Equal(d.tenant.Id))
+
+		stream = newTestServerStream[*projections.User](contextOf(d.admin))
+		Expect(server.GetAll(&api.GetAllRequest{}, stream)).To(Succeed())
+		Expect(rolesOf(stream.sent, d.otherMember.Id)).To(HaveLen(2))
+	})
+
+	It("GetById() and GetByEmail() return not found for invisible users", func() {
+		_, err := server.GetById(contextOf(d.member), wrapperspb.String(d.admin.Id))
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		_, err = server.GetByEmail(contextOf(d.member), wrapperspb.String(d.admin.Email))
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		user, err := server.GetByEmail(contextOf(d.admin), wrapperspb.String(d.member.Email))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(user.Id).To(Equal(d.member.Id))
+	})
+
+	It("GetById() only contains role bindings of visible tenants", func() {
+		user, err := server.GetById(contextOf(d.member), wrapperspb.String(d.otherMember.Id))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(user.Roles).To(HaveLen(1))
+		Expect(user.Roles[0].Resource).To(Equal(d.tenant.Id))
+
+		user, err = server.GetById(contextOf(d.admin), wrapperspb.String(d.otherMember.Id))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(user.Roles).To(HaveLen(2))
+	})
+
+	It("GetRoleBindingsById() only returns role bindings of visible tenants", func() {
+		stream := newTestServerStream[*projections.UserRoleBinding](contextOf(d.member))
+		Expect(server.GetRoleBindingsById(wrapperspb.String(d.otherMember.Id), stream)).To(Succeed())
+		Expect(stream.sent).To(HaveLen(1))
+		Expect(stream.sent[0].Resource).To(Equal(d.tenant.Id))
+
+		stream = newTestServerStream[*projections.UserRoleBinding](contextOf(d.member))
+		err := server.GetRoleBindingsById(wrapperspb.String(d.admin.Id), stream)
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("GetCount() only counts visible users", func() {
+		count, err := server.GetCount(contextOf(d.member), &api.GetCountRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count.Count).To(BeNumerically("==", 2))
+
+		count, err = server.GetCount(contextOf(d.admin), &api.GetCountRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count.Count).To(BeNumerically("==", 3))
+	})
+})
@@ -0,0 +1,31 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryhandler
+
+import (
+	"context"
+
+	"github.com/finleap-connect/monoskope/pkg/domain/metadata"
+	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
+)
+
+// getVisibility returns which users, tenants and clusters are visible to the calling user
+func getVisibility(ctx context.Context, visibilityRepo repositories.VisibilityRepository) (*repositories.Visibility, error) {
+	metadataManager, err := metadata.NewDomainMetadataManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return visibilityRepo.GetVisibilityForUserId(ctx, metadataManager.GetUserInformation().Id)
+}
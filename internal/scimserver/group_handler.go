@@ -15,7 +15,9 @@
 package scimserver
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/elimity-com/scim"
@@ -38,12 +40,13 @@ import (
 type groupHandler struct {
 	cmdHandlerClient eventsourcing.CommandHandlerClient
 	userClient       domain.UserClient
+	roleClient       domain.RoleClient
 	log              logger.Logger
 }
 
-func NewGroupHandler(cmdHandlerClient eventsourcing.CommandHandlerClient, userClient domain.UserClient) scim.ResourceHandler {
+func NewGroupHandler(cmdHandlerClient eventsourcing.CommandHandlerClient, userClient domain.UserClient, roleClient domain.RoleClient) scim.ResourceHandler {
 	return &groupHandler{
-		cmdHandlerClient, userClient, logger.WithName("scim-group-handler"),
+		cmdHandlerClient, userClient, roleClient, logger.WithName("scim-group-handler"),
 	}
 }
 
@@ -71,7 +74,24 @@ func (h *groupHandler) Get(r *http.Request, id string) (scim.Resource, error) {
 func (h *groupHandler) GetAll(r *http.Request, params scim.ListRequestParams) (scim.Page, error) {
 	logDebug(h.log, r)
 
-	roleCount := len(roles.AvailableRoles)
+	ctx, err := users.CreateUserContextGrpc(r.Context(), users.SCIMServerUser)
+	if err != nil {
+		h.log.Error(err, "Failed to create grpc context.")
+		return scim.Page{}, scim_errors.ScimError{
+			Status: http.StatusInternalServerError,
+			Detail: err.Error(),
+		}
+	}
+
+	availableRoles, err := h.getAvailableRoles(ctx)
+	if err != nil {
+		h.log.Error(err, "Failed to get roles.")
+		return scim.Page{}, scim_errors.ScimError{
+			Status: http.StatusInternalServerError,
+			Detail: err.Error(),
+		}
+	}
+	roleCount := len(availableRoles)
 
 	// If count is less than one just return total count
 	if params.Count < 1 {
@@ -89,7 +109,7 @@ func (h *groupHandler) GetAll(r *http.Request, params scim.ListRequestParams) (s
 		}
 
 		// Read next
-		role := roles.AvailableRoles[i-1]
+		role := availableRoles[i-1]
 
 		// Skip users which are not in the current page
 		if i >= params.StartIndex {
@@ -153,8 +173,23 @@ func (h *groupHandler) Patch(r *http.Request, id string, operations []scim.Patch
 		}
 	}
 
-	role, ok := roles.AvailableRolesMap[roleId]
-	if !ok {
+	availableRoles, err := h.getAvailableRoles(ctx)
+	if err != nil {
+		h.log.Error(err, "Failed to get roles.")
+		return scim.Resource{}, scim_errors.ScimError{
+			Status: http.StatusInternalServerError,
+			Detail: err.Error(),
+		}
+	}
+
+	var role es.Role
+	for _, availableRole := range availableRoles {
+		if roles.IdFromRole(availableRole) == roleId {
+			role = availableRole
+			break
+		}
+	}
+	if role == "" {
 		err := fmt.Errorf("roleId '%s' does not exist", roleId)
 		h.log.Error(err, "Failed to get role by id")
 		return scim.Resource{}, scim_errors.ScimError{
@@ -213,6 +248,32 @@ func (h *groupHandler) Patch(r *http.Request, id string, operations []scim.Patch
 	return toScimGroup(role, members...), nil
 }
 
+// getAvailableRoles returns the built-in roles followed by the defined roles which can be bound system wide
+func (h *groupHandler) getAvailableRoles(ctx context.Context) ([]es.Role, error) {
+	availableRoles := append([]es.Role{}, roles.AvailableRoles...)
+
+	stream, err := h.roleClient.GetAll(ctx, &domain.GetAllRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		role, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, scope := range role.GetScopes() {
+			if scope == string(scopes.System) {
+				availableRoles = append(availableRoles, es.Role(role.GetName()))
+				break
+			}
+		}
+	}
+	return availableRoles, nil
+}
+
 // toScimGroup converts a projections.UserRoleBinding to it's scim.Resource representation
 func toScimGroup(role es.Role, members ...string) scim.Resource {
 	var memberAttribute []map[string]string
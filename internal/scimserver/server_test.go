@@ -46,6 +46,7 @@ var _ = Describe("internal/scimserver/Server", func() {
 			"test",
 			time.Minute*10,
 			[]gateway.AuthorizationScope{gateway.AuthorizationScope_WRITE_SCIM},
+			nil,
 		)
 		authToken, err := signer.GenerateSignedToken(token)
 		Expect(err).ToNot(HaveOccurred())
@@ -44,6 +44,8 @@ type TestEnv struct {
 	gatewayTestEnv        *gateway.TestEnv
 	userServiceConn       *ggrpc.ClientConn
 	userSvcClient         domainApi.UserClient
+	roleServiceConn       *ggrpc.ClientConn
+	roleSvcClient         domainApi.RoleClient
 	commandHandlerConn    *ggrpc.ClientConn
 	commandHandlerClient  esApi.CommandHandlerClient
 	scimServer            scim.Server
@@ -79,12 +81,17 @@ func NewTestEnv(testEnv *test.TestEnv) (*TestEnv, error) {
 		return nil, err
 	}
 
-	env.userServiceConn, env.userSvcClient, err = grpcUtil.NewClientWithAuthForward(ctx, env.queryHandlerTestEnv.GetApiAddr(), false, domainApi.NewUserClient)
+	env.userServiceConn, env.userSvcClient, err = grpcUtil.NewClientWithAuthForward(ctx, env.queryHandlerTestEnv.GetApiAddr(), nil, domainApi.NewUserClient)
 	if err != nil {
 		return nil, err
 	}
 
-	env.commandHandlerConn, env.commandHandlerClient, err = grpcUtil.NewClientWithAuthForward(ctx, env.commandHandlerTestEnv.GetApiAddr(), false, commandHandlerApi.NewCommandHandlerClient)
+	env.roleServiceConn, env.roleSvcClient, err = grpcUtil.NewClientWithAuthForward(ctx, env.queryHandlerTestEnv.GetApiAddr(), nil, domainApi.NewRoleClient)
+	if err != nil {
+		return nil, err
+	}
+
+	env.commandHandlerConn, env.commandHandlerClient, err = grpcUtil.NewClientWithAuthForward(ctx, env.commandHandlerTestEnv.GetApiAddr(), nil, commandHandlerApi.NewCommandHandlerClient)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +103,7 @@ func NewTestEnv(testEnv *test.TestEnv) (*TestEnv, error) {
 
 	providerConfig := NewProvierConfig()
 	userHandler := NewUserHandler(env.commandHandlerClient, env.userSvcClient)
-	groupHandler := NewGroupHandler(env.commandHandlerClient, env.userSvcClient)
+	groupHandler := NewGroupHandler(env.commandHandlerClient, env.userSvcClient, env.roleSvcClient)
 	env.scimServer = NewServer(providerConfig, userHandler, groupHandler)
 
 	// Start server
@@ -70,3 +70,43 @@ func (mr *MockCommandHandlerClientMockRecorder) Execute(arg0, arg1 interface{},
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCommandHandlerClient)(nil).Execute), varargs...)
 }
+
+// ExecuteBatch mocks base method.
+func (m *MockCommandHandlerClient) ExecuteBatch(arg0 context.Context, arg1 *eventsourcing.CommandBatch, arg2 ...grpc.CallOption) (*eventsourcing.CommandBatchReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecuteBatch", varargs...)
+	ret0, _ := ret[0].(*eventsourcing.CommandBatchReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteBatch indicates an expected call of ExecuteBatch.
+func (mr *MockCommandHandlerClientMockRecorder) ExecuteBatch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteBatch", reflect.TypeOf((*MockCommandHandlerClient)(nil).ExecuteBatch), varargs...)
+}
+
+// Validate mocks base method.
+func (m *MockCommandHandlerClient) Validate(arg0 context.Context, arg1 *commands.Command, arg2 ...grpc.CallOption) (*eventsourcing.ValidationReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Validate", varargs...)
+	ret0, _ := ret[0].(*eventsourcing.ValidationReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockCommandHandlerClientMockRecorder) Validate(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockCommandHandlerClient)(nil).Validate), varargs...)
+}
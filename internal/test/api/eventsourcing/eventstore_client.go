@@ -52,6 +52,26 @@ func (m *MockEventStoreClient) EXPECT() *MockEventStoreClientMockRecorder {
 	return m.recorder
 }
 
+// GetEventSchemas mocks base method.
+func (m *MockEventStoreClient) GetEventSchemas(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*eventsourcing.EventSchemas, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEventSchemas", varargs...)
+	ret0, _ := ret[0].(*eventsourcing.EventSchemas)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventSchemas indicates an expected call of GetEventSchemas.
+func (mr *MockEventStoreClientMockRecorder) GetEventSchemas(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSchemas", reflect.TypeOf((*MockEventStoreClient)(nil).GetEventSchemas), varargs...)
+}
+
 // Retrieve mocks base method.
 func (m *MockEventStoreClient) Retrieve(arg0 context.Context, arg1 *eventsourcing.EventFilter, arg2 ...grpc.CallOption) (eventsourcing.EventStore_RetrieveClient, error) {
 	m.ctrl.T.Helper()
@@ -112,6 +132,26 @@ func (mr *MockEventStoreClientMockRecorder) Store(arg0 interface{}, arg1 ...inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockEventStoreClient)(nil).Store), varargs...)
 }
 
+// VerifyIntegrity mocks base method.
+func (m *MockEventStoreClient) VerifyIntegrity(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*eventsourcing.IntegrityReport, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyIntegrity", varargs...)
+	ret0, _ := ret[0].(*eventsourcing.IntegrityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyIntegrity indicates an expected call of VerifyIntegrity.
+func (mr *MockEventStoreClientMockRecorder) VerifyIntegrity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyIntegrity", reflect.TypeOf((*MockEventStoreClient)(nil).VerifyIntegrity), varargs...)
+}
+
 // MockEventStore_StoreClient is a mock of EventStore_StoreClient interface.
 type MockEventStore_StoreClient struct {
 	ctrl     *gomock.Controller
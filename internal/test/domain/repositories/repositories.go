@@ -13,7 +13,7 @@
 // limitations under the License.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/finleap-connect/monoskope/pkg/domain/repositories (interfaces: UserRepository,ClusterRepository,ClusterAccessRepository)
+// Source: github.com/finleap-connect/monoskope/pkg/domain/repositories (interfaces: UserRepository,UserRoleBindingRepository,ClusterRepository,ClusterAccessRepository)
 
 // Package mock_repositories is a generated GoMock package.
 package mock_repositories
@@ -194,6 +194,157 @@ func (mr *MockUserRepositoryMockRecorder) Upsert(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockUserRepository)(nil).Upsert), arg0, arg1)
 }
 
+// MockUserRoleBindingRepository is a mock of UserRoleBindingRepository interface.
+type MockUserRoleBindingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRoleBindingRepositoryMockRecorder
+}
+
+// MockUserRoleBindingRepositoryMockRecorder is the mock recorder for MockUserRoleBindingRepository.
+type MockUserRoleBindingRepositoryMockRecorder struct {
+	mock *MockUserRoleBindingRepository
+}
+
+// NewMockUserRoleBindingRepository creates a new mock instance.
+func NewMockUserRoleBindingRepository(ctrl *gomock.Controller) *MockUserRoleBindingRepository {
+	mock := &MockUserRoleBindingRepository{ctrl: ctrl}
+	mock.recorder = &MockUserRoleBindingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRoleBindingRepository) EXPECT() *MockUserRoleBindingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method.
+func (m *MockUserRoleBindingRepository) All(arg0 context.Context) ([]*projections0.UserRoleBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", arg0)
+	ret0, _ := ret[0].([]*projections0.UserRoleBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// All indicates an expected call of All.
+func (mr *MockUserRoleBindingRepositoryMockRecorder) All(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockUserRoleBindingRepository)(nil).All), arg0)
+}
+
+// AllWith mocks base method.
+func (m *MockUserRoleBindingRepository) AllWith(arg0 context.Context, arg1 bool) ([]*projections0.UserRoleBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllWith", arg0, arg1)
+	ret0, _ := ret[0].([]*projections0.UserRoleBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllWith indicates an expected call of AllWith.
+func (mr *MockUserRoleBindingRepositoryMockRecorder) AllWith(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllWith", reflect.TypeOf((*MockUserRoleBindingRepository)(nil).AllWith), arg0, arg1)
+}
+
+// ById mocks base method.
+func (m *MockUserRoleBindingRepository) ById(arg0 context.Context, arg1 uuid.UUID) (*projections0.UserRoleBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ById", arg0, arg1)
+	ret0, _ := ret[0].(*projections0.UserRoleBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ById indicates an expected call of ById.
+func (mr *MockUserRoleBindingRepositoryMockRecorder) ById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ById", reflect.TypeOf((*MockUserRoleBindingRepository)(nil).ById), arg0, arg1)
+}
+
+// ByScopeAndResource mocks base method.
+func (m *MockUserRoleBindingRepository) ByScopeAndResource(arg0 context.Context, arg1 eventsourcing.Scope, arg2 uuid.UUID) ([]*projections0.UserRoleBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByScopeAndResource", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*projections0.UserRoleBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByScopeAndResource indicates an expected call of ByScopeAndResource.
+func (mr *MockUserRoleBindingRepositoryMockRecorder) ByScopeAndResource(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByScopeAndResource", reflect.TypeOf((*MockUserRoleBindingRepository)(nil).ByScopeAndResource), arg0, arg1, arg2)
+}
+
+// ByUserId mocks base method.
+func (m *MockUserRoleBindingRepository) ByUserId(arg0 context.Context, arg1 uuid.UUID) ([]*projections0.UserRoleBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByUserId", arg0, arg1)
+	ret0, _ := ret[0].([]*projections0.UserRoleBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByUserId indicates an expected call of ByUserId.
+func (mr *MockUserRoleBindingRepositoryMockRecorder) ByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByUserId", reflect.TypeOf((*MockUserRoleBindingRepository)(nil).ByUserId), arg0, arg1)
+}
+
+// ByUserIdScopeAndResource mocks base method.
+func (m *MockUserRoleBindingRepository) ByUserIdScopeAndResource(arg0 context.Context, arg1 uuid.UUID, arg2 eventsourcing.Scope, arg3 string) ([]*projections0.UserRoleBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByUserIdScopeAndResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*projections0.UserRoleBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByUserIdScopeAndResource indicates an expected call of ByUserIdScopeAndResource.
+func (mr *MockUserRoleBindingRepositoryMockRecorder) ByUserIdScopeAndResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByUserIdScopeAndResource", reflect.TypeOf((*MockUserRoleBindingRepository)(nil).ByUserIdScopeAndResource), arg0, arg1, arg2, arg3)
+}
+
+// DeregisterObserver mocks base method.
+func (m *MockUserRoleBindingRepository) DeregisterObserver(arg0 eventsourcing.RepositoryObserver[*projections0.UserRoleBinding]) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeregisterObserver", arg0)
+}
+
+// DeregisterObserver indicates an expected call of DeregisterObserver.
+func (mr *MockUserRoleBindingRepositoryMockRecorder) DeregisterObserver(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterObserver", reflect.TypeOf((*MockUserRoleBindingRepository)(nil).DeregisterObserver), arg0)
+}
+
+// RegisterObserver mocks base method.
+func (m *MockUserRoleBindingRepository) RegisterObserver(arg0 eventsourcing.RepositoryObserver[*projections0.UserRoleBinding]) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterObserver", arg0)
+}
+
+// RegisterObserver indicates an expected call of RegisterObserver.
+func (mr *MockUserRoleBindingRepositoryMockRecorder) RegisterObserver(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterObserver", reflect.TypeOf((*MockUserRoleBindingRepository)(nil).RegisterObserver), arg0)
+}
+
+// Upsert mocks base method.
+func (m *MockUserRoleBindingRepository) Upsert(arg0 context.Context, arg1 *projections0.UserRoleBinding) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockUserRoleBindingRepositoryMockRecorder) Upsert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockUserRoleBindingRepository)(nil).Upsert), arg0, arg1)
+}
+
 // MockClusterRepository is a mock of ClusterRepository interface.
 type MockClusterRepository struct {
 	ctrl     *gomock.Controller
@@ -247,6 +398,21 @@ func (mr *MockClusterRepositoryMockRecorder) AllWith(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllWith", reflect.TypeOf((*MockClusterRepository)(nil).AllWith), arg0, arg1)
 }
 
+// AllWithLabelSelector mocks base method.
+func (m *MockClusterRepository) AllWithLabelSelector(arg0 context.Context, arg1 bool, arg2 string) ([]*projections0.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllWithLabelSelector", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*projections0.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllWithLabelSelector indicates an expected call of AllWithLabelSelector.
+func (mr *MockClusterRepositoryMockRecorder) AllWithLabelSelector(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllWithLabelSelector", reflect.TypeOf((*MockClusterRepository)(nil).AllWithLabelSelector), arg0, arg1, arg2)
+}
+
 // ByClusterName mocks base method.
 func (m *MockClusterRepository) ByClusterName(arg0 context.Context, arg1 string) (*projections0.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,162 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package test_eventbus contains specs every implementation of an event bus has to satisfy.
+package test_eventbus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testEventCreated es.EventType = "TestEvent:Created"
+	testEventChanged es.EventType = "TestEvent:Changed"
+)
+
+// receivingHandler forwards all events it handles to a channel.
+type receivingHandler struct {
+	events chan es.Event
+}
+
+func newReceivingHandler() *receivingHandler {
+	return &receivingHandler{
+		events: make(chan es.Event, 100),
+	}
+}
+
+func (h *receivingHandler) HandleEvent(_ context.Context, event es.Event) error {
+	h.events <- event
+	return nil
+}
+
+// ItBehavesLikeAnEventBus adds the specs every pair of es.EventBusPublisher and es.EventBusConsumer
+// connected to the same bus has to satisfy.
+// The specs use aggregate types of their own and do not require newBus to return an unused bus.
+func ItBehavesLikeAnEventBus(newBus func() (es.EventBusPublisher, es.EventBusConsumer)) {
+	ctx := context.Background()
+	timeout := 30 * time.Second
+	quietPeriod := time.Second
+
+	var publisher es.EventBusPublisher
+	var consumer es.EventBusConsumer
+	var aggregateType, otherAggregateType es.AggregateType
+
+	newEvent := func(eventType es.EventType, aggregateType es.AggregateType) es.Event {
+		return es.NewEvent(ctx, eventType, nil, time.Now().UTC(), aggregateType, uuid.New(), 0)
+	}
+	publish := func(events ...es.Event) {
+		for _, event := range events {
+			Expect(publisher.PublishEvent(ctx, event)).To(Succeed())
+		}
+	}
+	expectReceived := func(handler *receivingHandler, expected es.Event) {
+		var event es.Event
+		Eventually(handler.events, timeout).Should(Receive(&event))
+		Expect(event.AggregateID()).To(Equal(expected.AggregateID()))
+		Expect(event.AggregateType()).To(Equal(expected.AggregateType()))
+		Expect(event.EventType()).To(Equal(expected.EventType()))
+	}
+
+	BeforeEach(func() {
+		publisher, consumer = newBus()
+		aggregateType = es.AggregateType(fmt.Sprintf("Test%s", uuid.New()))
+		otherAggregateType = es.AggregateType(fmt.Sprintf("Test%s", uuid.New()))
+	})
+	AfterEach(func() {
+		Expect(consumer.Close()).To(Succeed())
+		Expect(publisher.Close()).To(Succeed())
+	})
+
+	It("rejects handlers without matchers", func() {
+		Expect(consumer.AddHandler(ctx, newReceivingHandler())).To(Equal(esErrors.ErrMatcherMustNotBeNil))
+		Expect(consumer.AddHandler(ctx, nil, consumer.Matcher())).To(Equal(esErrors.ErrHandlerMustNotBeNil))
+	})
+	It("delivers events matching the aggregate type", func() {
+		handler := newReceivingHandler()
+		Expect(consumer.AddHandler(ctx, handler, consumer.Matcher().MatchAggregateType(aggregateType))).To(Succeed())
+
+		expected := newEvent(testEventCreated, aggregateType)
+		publish(newEvent(testEventCreated, otherAggregateType), expected)
+		expectReceived(handler, expected)
+		Consistently(handler.events, quietPeriod).ShouldNot(Receive())
+	})
+	It("delivers events matching the event type", func() {
+		handler := newReceivingHandler()
+		Expect(consumer.AddHandler(ctx, handler, consumer.Matcher().MatchAggregateType(aggregateType).MatchEventType(testEventChanged))).To(Succeed())
+
+		expected := newEvent(testEventChanged, aggregateType)
+		publish(newEvent(testEventCreated, aggregateType), newEvent(testEventChanged, otherAggregateType), expected)
+		expectReceived(handler, expected)
+		Consistently(handler.events, quietPeriod).ShouldNot(Receive())
+	})
+	It("delivers events matching any of the matchers", func() {
+		handler := newReceivingHandler()
+		Expect(consumer.AddHandler(ctx, handler,
+			consumer.Matcher().MatchAggregateType(aggregateType).MatchEventType(testEventCreated),
+			consumer.Matcher().MatchAggregateType(otherAggregateType),
+		)).To(Succeed())
+
+		first, second := newEvent(testEventCreated, aggregateType), newEvent(testEventChanged, otherAggregateType)
+		publish(newEvent(testEventChanged, aggregateType), first, second)
+		expectReceived(handler, first)
+		expectReceived(handler, second)
+		Consistently(handler.events, quietPeriod).ShouldNot(Receive())
+	})
+	It("delivers events to every handler", func() {
+		handlerA, handlerB := newReceivingHandler(), newReceivingHandler()
+		Expect(consumer.AddHandler(ctx, handlerA, consumer.Matcher().MatchAggregateType(aggregateType))).To(Succeed())
+		Expect(consumer.AddHandler(ctx, handlerB, consumer.Matcher().MatchAggregateType(aggregateType))).To(Succeed())
+
+		expected := newEvent(testEventCreated, aggregateType)
+		publish(expected)
+		expectReceived(handlerA, expected)
+		expectReceived(handlerB, expected)
+	})
+	It("distributes events among the workers of a work queue", func() {
+		workQueueName := fmt.Sprintf("test-%s", uuid.New())
+		handlerA, handlerB := newReceivingHandler(), newReceivingHandler()
+		Expect(consumer.AddWorker(ctx, handlerA, workQueueName, consumer.Matcher().MatchAggregateType(aggregateType))).To(Succeed())
+		Expect(consumer.AddWorker(ctx, handlerB, workQueueName, consumer.Matcher().MatchAggregateType(aggregateType))).To(Succeed())
+
+		eventCount := 10
+		for i := 0; i < eventCount; i++ {
+			publish(newEvent(testEventCreated, aggregateType))
+		}
+
+		received := make(map[uuid.UUID]int)
+		Eventually(func() int {
+			for {
+				select {
+				case event := <-handlerA.events:
+					received[event.AggregateID()]++
+				case event := <-handlerB.events:
+					received[event.AggregateID()]++
+				default:
+					return len(received)
+				}
+			}
+		}, timeout).Should(Equal(eventCount))
+		for _, count := range received {
+			Expect(count).To(Equal(1))
+		}
+	})
+}
@@ -0,0 +1,274 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package test_eventstore contains specs every implementation of es.EventStore has to satisfy.
+package test_eventstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/finleap-connect/monoskope/internal/gateway/auth"
+	testEd "github.com/finleap-connect/monoskope/pkg/api/eventsourcing/eventdata"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testEventCreated es.EventType = "TestEvent:Created"
+	testEventChanged es.EventType = "TestEvent:Changed"
+	testEventDeleted es.EventType = "TestEvent:Deleted"
+)
+
+// ItBehavesLikeAnEventStore adds the specs every es.EventStore has to satisfy.
+// The specs use aggregate types of their own and do not require newStore to return an empty store.
+func ItBehavesLikeAnEventStore(newStore func() es.EventStore) {
+	ctx := context.Background()
+	issuerId := uuid.New()
+	start := time.Now().UTC().Truncate(time.Second)
+
+	var store es.EventStore
+	var aggregateType, otherAggregateType es.AggregateType
+
+	newEvent := func(eventType es.EventType, aggregateType es.AggregateType, aggregateId uuid.UUID, version uint64, data es.EventData) es.Event {
+		return es.NewEventWithMetadata(eventType, data, start.Add(time.Duration(version)*time.Second), aggregateType, aggregateId, version, map[string]string{
+			auth.HeaderAuthId: issuerId.String(),
+		})
+	}
+	newTestEventData := func(something string) es.EventData {
+		return es.ToEventDataFromProto(&testEd.TestEventData{Hello: something})
+	}
+	newTestEvents := func(aggregateType es.AggregateType) []es.Event {
+		aggregateId := uuid.New()
+		return []es.Event{
+			newEvent(testEventCreated, aggregateType, aggregateId, 0, newTestEventData("create")),
+			newEvent(testEventChanged, aggregateType, aggregateId, 1, newTestEventData("change")),
+			newEvent(testEventDeleted, aggregateType, aggregateId, 2, newTestEventData("delete")),
+		}
+	}
+	load := func(query *es.StoreQuery) []es.Event {
+		if query.AggregateType == nil && query.AggregateId == nil {
+			query.AggregateType = &aggregateType
+		}
+		stream, err := store.Load(ctx, query)
+		Expect(err).ToNot(HaveOccurred())
+		return receiveAll(stream)
+	}
+
+	BeforeEach(func() {
+		store = newStore()
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		Expect(store.Open(ctxWithTimeout)).To(Succeed())
+
+		aggregateType = es.AggregateType(fmt.Sprintf("Test%s", uuid.New()))
+		otherAggregateType = es.AggregateType(fmt.Sprintf("Test%s", uuid.New()))
+	})
+	AfterEach(func() {
+		Expect(store.Close()).To(Succeed())
+	})
+
+	It("can save and load events", func() {
+		events := newTestEvents(aggregateType)
+		Expect(store.Save(ctx, events)).To(Succeed())
+		Expect(store.Save(ctx, newTestEvents(aggregateType))).To(Succeed())
+
+		aggregateId := events[0].AggregateID()
+		stored := load(&es.StoreQuery{AggregateId: &aggregateId})
+		Expect(stored).To(HaveLen(len(events)))
+		for i, event := range stored {
+			Expect(event.EventType()).To(Equal(events[i].EventType()))
+			Expect(event.AggregateType()).To(Equal(events[i].AggregateType()))
+			Expect(event.AggregateID()).To(Equal(events[i].AggregateID()))
+			Expect(event.AggregateVersion()).To(Equal(events[i].AggregateVersion()))
+			Expect(event.Timestamp().Equal(events[i].Timestamp())).To(BeTrue())
+			Expect(event.Metadata()).To(Equal(events[i].Metadata()))
+
+			data := new(testEd.TestEventData)
+			Expect(event.Data().ToProto(data)).To(Succeed())
+			expected := new(testEd.TestEventData)
+			Expect(events[i].Data().ToProto(expected)).To(Succeed())
+			Expect(data.Hello).To(Equal(expected.Hello))
+		}
+	})
+	It("loads events ordered by timestamp", func() {
+		first, second := uuid.New(), uuid.New()
+		Expect(store.Save(ctx, []es.Event{newEvent(testEventCreated, aggregateType, second, 1, newTestEventData("second"))})).To(Succeed())
+		Expect(store.Save(ctx, []es.Event{newEvent(testEventCreated, aggregateType, first, 0, newTestEventData("first"))})).To(Succeed())
+
+		stored := load(&es.StoreQuery{})
+		Expect(stored).To(HaveLen(2))
+		Expect(stored[0].AggregateID()).To(Equal(first))
+		Expect(stored[1].AggregateID()).To(Equal(second))
+	})
+	It("fails to save no events", func() {
+		Expect(store.Save(ctx, nil)).To(Equal(esErrors.ErrNoEventsToAppend))
+	})
+	It("fails to save events of different aggregates", func() {
+		aggregateId := uuid.New()
+		err := store.Save(ctx, []es.Event{
+			newEvent(testEventCreated, aggregateType, aggregateId, 0, newTestEventData("create")),
+			newEvent(testEventChanged, otherAggregateType, aggregateId, 1, newTestEventData("change")),
+		})
+		Expect(err).To(Equal(esErrors.ErrInvalidAggregateType))
+	})
+	It("fails to save events not in the right aggregate version order", func() {
+		aggregateId := uuid.New()
+		err := store.Save(ctx, []es.Event{
+			newEvent(testEventCreated, aggregateType, aggregateId, 0, newTestEventData("create")),
+			newEvent(testEventChanged, aggregateType, aggregateId, 2, newTestEventData("change")),
+		})
+		Expect(err).To(Equal(esErrors.ErrIncorrectAggregateVersion))
+	})
+	It("fails to save events of an aggregate version which already exists", func() {
+		events := newTestEvents(aggregateType)
+		Expect(store.Save(ctx, events[:2])).To(Succeed())
+
+		// conflicting batches are rejected as a whole
+		err := store.Save(ctx, events[1:])
+		Expect(err).To(Equal(esErrors.ErrAggregateVersionAlreadyExists))
+
+		aggregateId := events[0].AggregateID()
+		Expect(load(&es.StoreQuery{AggregateId: &aggregateId})).To(HaveLen(2))
+	})
+	It("can save events of several aggregates at once", func() {
+		batchStore, ok := store.(es.BatchEventStore)
+		if !ok {
+			Skip("store does not implement es.BatchEventStore")
+		}
+
+		events, otherEvents := newTestEvents(aggregateType), newTestEvents(otherAggregateType)
+		Expect(batchStore.SaveAll(ctx, []es.Event{events[0], otherEvents[0], events[1], otherEvents[1]})).To(Succeed())
+
+		aggregateId, otherAggregateId := events[0].AggregateID(), otherEvents[0].AggregateID()
+		Expect(load(&es.StoreQuery{AggregateId: &aggregateId})).To(HaveLen(2))
+		Expect(load(&es.StoreQuery{AggregateId: &otherAggregateId})).To(HaveLen(2))
+	})
+	It("fails to save events of several aggregates at once if one of them is invalid", func() {
+		batchStore, ok := store.(es.BatchEventStore)
+		if !ok {
+			Skip("store does not implement es.BatchEventStore")
+		}
+
+		events, otherEvents := newTestEvents(aggregateType), newTestEvents(otherAggregateType)
+		Expect(store.Save(ctx, otherEvents[:1])).To(Succeed())
+
+		// the conflicting version of the other aggregate rejects the events of both aggregates
+		err := batchStore.SaveAll(ctx, []es.Event{events[0], events[1], otherEvents[0]})
+		Expect(err).To(Equal(esErrors.ErrAggregateVersionAlreadyExists))
+		aggregateId := events[0].AggregateID()
+		Expect(load(&es.StoreQuery{AggregateId: &aggregateId})).To(BeEmpty())
+
+		err = batchStore.SaveAll(ctx, []es.Event{events[0], otherEvents[1], events[2]})
+		Expect(err).To(Equal(esErrors.ErrIncorrectAggregateVersion))
+		Expect(load(&es.StoreQuery{AggregateId: &aggregateId})).To(BeEmpty())
+	})
+	It("can filter events by aggregate type", func() {
+		Expect(store.Save(ctx, newTestEvents(aggregateType))).To(Succeed())
+		Expect(store.Save(ctx, newTestEvents(otherAggregateType))).To(Succeed())
+
+		stored := load(&es.StoreQuery{AggregateType: &otherAggregateType})
+		Expect(stored).To(HaveLen(3))
+		for _, event := range stored {
+			Expect(event.AggregateType()).To(Equal(otherAggregateType))
+		}
+	})
+	It("can filter events by aggregate version", func() {
+		Expect(store.Save(ctx, newTestEvents(aggregateType))).To(Succeed())
+
+		minVersion, maxVersion := uint64(1), uint64(1)
+		Expect(load(&es.StoreQuery{MinVersion: &minVersion})).To(HaveLen(2))
+		Expect(load(&es.StoreQuery{MaxVersion: &maxVersion})).To(HaveLen(2))
+		stored := load(&es.StoreQuery{MinVersion: &minVersion, MaxVersion: &maxVersion})
+		Expect(stored).To(HaveLen(1))
+		Expect(stored[0].AggregateVersion()).To(Equal(uint64(1)))
+	})
+	It("can filter events by timestamp", func() {
+		Expect(store.Save(ctx, newTestEvents(aggregateType))).To(Succeed())
+
+		minTimestamp, maxTimestamp := start.Add(time.Second), start.Add(time.Second)
+		Expect(load(&es.StoreQuery{MinTimestamp: &minTimestamp})).To(HaveLen(2))
+		Expect(load(&es.StoreQuery{MaxTimestamp: &maxTimestamp})).To(HaveLen(2))
+		stored := load(&es.StoreQuery{MinTimestamp: &minTimestamp, MaxTimestamp: &maxTimestamp})
+		Expect(stored).To(HaveLen(1))
+		Expect(stored[0].AggregateVersion()).To(Equal(uint64(1)))
+	})
+	It("can filter events by event type", func() {
+		Expect(store.Save(ctx, newTestEvents(aggregateType))).To(Succeed())
+
+		eventType := testEventChanged
+		stored := load(&es.StoreQuery{EventType: &eventType})
+		Expect(stored).To(HaveLen(1))
+		Expect(stored[0].EventType()).To(Equal(testEventChanged))
+	})
+	It("can filter events by issuer", func() {
+		Expect(store.Save(ctx, newTestEvents(aggregateType))).To(Succeed())
+
+		Expect(load(&es.StoreQuery{IssuerId: &issuerId})).To(HaveLen(3))
+		otherIssuerId := uuid.New()
+		Expect(load(&es.StoreQuery{IssuerId: &otherIssuerId})).To(BeEmpty())
+	})
+	It("can filter events by tenant", func() {
+		tenantId := uuid.New()
+		Expect(store.Save(ctx, []es.Event{newEvent(testEventCreated, aggregateType, tenantId, 0, newTestEventData("tenant"))})).To(Succeed())
+		Expect(store.Save(ctx, []es.Event{newEvent(testEventCreated, aggregateType, uuid.New(), 0, es.EventData(fmt.Sprintf(`{"tenantId":"%s"}`, tenantId)))})).To(Succeed())
+		Expect(store.Save(ctx, []es.Event{newEvent(testEventCreated, aggregateType, uuid.New(), 0, es.EventData(fmt.Sprintf(`{"resource":"%s"}`, tenantId)))})).To(Succeed())
+		Expect(store.Save(ctx, newTestEvents(aggregateType))).To(Succeed())
+
+		Expect(load(&es.StoreQuery{TenantId: &tenantId})).To(HaveLen(3))
+	})
+	It("can filter events by user", func() {
+		userId := uuid.New()
+		Expect(store.Save(ctx, []es.Event{newEvent(testEventCreated, aggregateType, userId, 0, newTestEventData("user"))})).To(Succeed())
+		Expect(store.Save(ctx, []es.Event{newEvent(testEventCreated, aggregateType, uuid.New(), 0, es.EventData(fmt.Sprintf(`{"userId":"%s"}`, userId)))})).To(Succeed())
+		Expect(store.Save(ctx, newTestEvents(aggregateType))).To(Succeed())
+
+		Expect(load(&es.StoreQuery{UserId: &userId})).To(HaveLen(2))
+	})
+	It("can load events by combining the queries with the logical OR", func() {
+		events := newTestEvents(aggregateType)
+		Expect(store.Save(ctx, events)).To(Succeed())
+		otherEvents := newTestEvents(otherAggregateType)
+		Expect(store.Save(ctx, otherEvents)).To(Succeed())
+		Expect(store.Save(ctx, newTestEvents(aggregateType))).To(Succeed())
+
+		aggregateId, otherAggregateId := events[0].AggregateID(), otherEvents[0].AggregateID()
+		minVersion := uint64(2)
+		stream, err := store.LoadOr(ctx, []*es.StoreQuery{
+			{AggregateId: &aggregateId},
+			{AggregateId: &otherAggregateId, MinVersion: &minVersion},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receiveAll(stream)).To(HaveLen(len(events) + 1))
+	})
+}
+
+// receiveAll receives events from the stream until it has been closed.
+func receiveAll(stream es.EventStreamReceiver) []es.Event {
+	var events []es.Event
+	for {
+		event, err := stream.Receive()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).ToNot(HaveOccurred())
+		events = append(events, event)
+	}
+	return events
+}
@@ -0,0 +1,180 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package test_repositories contains specs every implementation of es.Repository has to satisfy.
+package test_repositories
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// TestProjection is a minimal projection to test repositories with.
+type TestProjection struct {
+	Id           uuid.UUID
+	Name         string
+	LastModified time.Time
+	version      uint64
+}
+
+// NewTestProjection creates a TestProjection with the given id.
+func NewTestProjection(id uuid.UUID) *TestProjection {
+	return &TestProjection{
+		Id: id,
+	}
+}
+
+func (p *TestProjection) ID() uuid.UUID {
+	return p.Id
+}
+
+func (p *TestProjection) Version() uint64 {
+	return p.version
+}
+
+func (p *TestProjection) IncrementVersion() {
+	p.version++
+}
+
+// TestProjectionCodec stores TestProjections as JSON.
+type TestProjectionCodec struct{}
+
+func (TestProjectionCodec) Marshal(p *TestProjection) ([]byte, error) {
+	return json.Marshal(p)
+}
+
+func (TestProjectionCodec) Unmarshal(id uuid.UUID, version uint64, data []byte) (*TestProjection, error) {
+	p := NewTestProjection(id)
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+	p.version = version
+	return p, nil
+}
+
+func (TestProjectionCodec) Checkpoint(p *TestProjection) time.Time {
+	return p.LastModified
+}
+
+type testObserver struct {
+	notified []*TestProjection
+}
+
+func (o *testObserver) Notify(_ context.Context, p *TestProjection) {
+	o.notified = append(o.notified, p)
+}
+
+// ItBehavesLikeARepository adds the specs every es.SwappableRepository has to satisfy.
+// newRepository must return an empty repository.
+func ItBehavesLikeARepository(newRepository func() es.SwappableRepository[*TestProjection]) {
+	ctx := context.Background()
+
+	It("can read/write projections", func() {
+		repo := newRepository()
+		tp := NewTestProjection(uuid.New())
+		tp.IncrementVersion()
+
+		observer := new(testObserver)
+		repo.RegisterObserver(observer)
+		Expect(repo.Upsert(ctx, tp)).To(Succeed())
+		Expect(observer.notified).To(HaveLen(1))
+		Expect(observer.notified[0]).To(BeIdenticalTo(tp))
+
+		repo.DeregisterObserver(observer)
+		tp.Name = "updated"
+		Expect(repo.Upsert(ctx, tp)).To(Succeed())
+		Expect(observer.notified).To(HaveLen(1))
+
+		projection, err := repo.ById(ctx, tp.ID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(projection.ID()).To(Equal(tp.ID()))
+		Expect(projection.Version()).To(Equal(tp.Version()))
+		Expect(projection.Name).To(Equal("updated"))
+
+		projections, err := repo.All(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(projections).To(HaveLen(1))
+		Expect(projections[0].ID()).To(Equal(tp.ID()))
+	})
+
+	It("returns not found for unknown projections", func() {
+		_, err := newRepository().ById(ctx, uuid.New())
+		Expect(err).To(Equal(esErrors.ErrProjectionNotFound))
+	})
+
+	It("can swap projections", func() {
+		repo := newRepository()
+		Expect(repo.Upsert(ctx, NewTestProjection(uuid.New()))).To(Succeed())
+
+		tp := NewTestProjection(uuid.New())
+		rebuilt := newRepository()
+		Expect(rebuilt.Upsert(ctx, tp)).To(Succeed())
+
+		observer := new(testObserver)
+		repo.RegisterObserver(observer)
+		Expect(repo.Swap(ctx, rebuilt)).To(Succeed())
+		Expect(observer.notified).To(HaveLen(1))
+		Expect(observer.notified[0].ID()).To(Equal(tp.ID()))
+
+		projections, err := repo.All(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(projections).To(HaveLen(1))
+		Expect(projections[0].ID()).To(Equal(tp.ID()))
+	})
+}
+
+// ItBehavesLikeADurableRepository adds the specs every es.DurableRepository has to satisfy in addition to those of ItBehavesLikeARepository.
+// newRepository must return an empty repository, reopenRepository a new instance of the repository last returned by newRepository.
+func ItBehavesLikeADurableRepository(newRepository, reopenRepository func() es.DurableRepository[*TestProjection]) {
+	ctx := context.Background()
+
+	It("keeps projections across restarts", func() {
+		tp := NewTestProjection(uuid.New())
+		tp.Name = "durable"
+		tp.IncrementVersion()
+		tp.IncrementVersion()
+		Expect(newRepository().Upsert(ctx, tp)).To(Succeed())
+
+		projection, err := reopenRepository().ById(ctx, tp.ID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(projection.Name).To(Equal("durable"))
+		Expect(projection.Version()).To(BeNumerically("==", 2))
+	})
+
+	It("returns the timestamp of the latest event projected as checkpoint", func() {
+		repo := newRepository()
+
+		checkpoint, err := repo.Checkpoint(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkpoint.IsZero()).To(BeTrue())
+
+		latest := time.Now().UTC().Truncate(time.Millisecond)
+		for _, lastModified := range []time.Time{latest.Add(-time.Hour), latest} {
+			tp := NewTestProjection(uuid.New())
+			tp.LastModified = lastModified
+			Expect(repo.Upsert(ctx, tp)).To(Succeed())
+		}
+
+		checkpoint, err = reopenRepository().Checkpoint(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkpoint.Equal(latest)).To(BeTrue())
+	})
+}
@@ -112,6 +112,75 @@ func (m *CreateCluster) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetDescription()) > 500 {
+		err := CreateClusterValidationError{
+			field:  "Description",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLabels()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateClusterValidationError{
+					field:  "Labels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateClusterValidationError{
+					field:  "Labels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLabels()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateClusterValidationError{
+				field:  "Labels",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateClusterValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateClusterValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateClusterValidationError{
+				field:  "Annotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateClusterMultiError(errors)
 	}
@@ -279,6 +348,79 @@ func (m *UpdateCluster) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if wrapper := m.GetDescription(); wrapper != nil {
+
+		if utf8.RuneCountInString(wrapper.GetValue()) > 500 {
+			err := UpdateClusterValidationError{
+				field:  "Description",
+				reason: "value length must be at most 500 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLabels()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateClusterValidationError{
+					field:  "Labels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateClusterValidationError{
+					field:  "Labels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLabels()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateClusterValidationError{
+				field:  "Labels",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateClusterValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateClusterValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateClusterValidationError{
+				field:  "Annotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UpdateClusterMultiError(errors)
 	}
@@ -360,3 +502,327 @@ var _ interface {
 var _UpdateCluster_Name_Pattern = regexp.MustCompile("^[a-zA-Z][A-Za-z0-9_-]+$")
 
 var _UpdateCluster_ApiServerAddress_Pattern = regexp.MustCompile("^(https?://)?[^\\s/$.?#/_].[^\\s_]*$")
+
+// Validate checks the field values on ClusterLabels with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClusterLabels) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterLabels with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClusterLabelsMultiError, or
+// nil if none found.
+func (m *ClusterLabels) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterLabels) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetValues()) > 64 {
+		err := ClusterLabelsValidationError{
+			field:  "Values",
+			reason: "value must contain no more than 64 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetValues()))
+		i := 0
+		for key := range m.GetValues() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetValues()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) > 317 {
+				err := ClusterLabelsValidationError{
+					field:  fmt.Sprintf("Values[%v]", key),
+					reason: "value length must be at most 317 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_ClusterLabels_Values_Pattern.MatchString(key) {
+				err := ClusterLabelsValidationError{
+					field:  fmt.Sprintf("Values[%v]", key),
+					reason: "value does not match regex pattern \"^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 63 {
+				err := ClusterLabelsValidationError{
+					field:  fmt.Sprintf("Values[%v]", key),
+					reason: "value length must be at most 63 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ClusterLabelsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterLabelsMultiError is an error wrapping multiple validation errors
+// returned by ClusterLabels.ValidateAll() if the designated constraints
+// aren't met.
+type ClusterLabelsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterLabelsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterLabelsMultiError) AllErrors() []error { return m }
+
+// ClusterLabelsValidationError is the validation error returned by
+// ClusterLabels.Validate if the designated constraints aren't met.
+type ClusterLabelsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterLabelsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterLabelsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterLabelsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterLabelsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterLabelsValidationError) ErrorName() string { return "ClusterLabelsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterLabelsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterLabels.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterLabelsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterLabelsValidationError{}
+
+var _ClusterLabels_Values_Pattern = regexp.MustCompile("^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$")
+
+// Validate checks the field values on ClusterAnnotations with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClusterAnnotations) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterAnnotations with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClusterAnnotationsMultiError, or nil if none found.
+func (m *ClusterAnnotations) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterAnnotations) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetValues()) > 64 {
+		err := ClusterAnnotationsValidationError{
+			field:  "Values",
+			reason: "value must contain no more than 64 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetValues()))
+		i := 0
+		for key := range m.GetValues() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetValues()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) > 317 {
+				err := ClusterAnnotationsValidationError{
+					field:  fmt.Sprintf("Values[%v]", key),
+					reason: "value length must be at most 317 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_ClusterAnnotations_Values_Pattern.MatchString(key) {
+				err := ClusterAnnotationsValidationError{
+					field:  fmt.Sprintf("Values[%v]", key),
+					reason: "value does not match regex pattern \"^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 4096 {
+				err := ClusterAnnotationsValidationError{
+					field:  fmt.Sprintf("Values[%v]", key),
+					reason: "value length must be at most 4096 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ClusterAnnotationsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterAnnotationsMultiError is an error wrapping multiple validation errors
+// returned by ClusterAnnotations.ValidateAll() if the designated constraints
+// aren't met.
+type ClusterAnnotationsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterAnnotationsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterAnnotationsMultiError) AllErrors() []error { return m }
+
+// ClusterAnnotationsValidationError is the validation error returned by
+// ClusterAnnotations.Validate if the designated constraints aren't met.
+type ClusterAnnotationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterAnnotationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterAnnotationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterAnnotationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterAnnotationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterAnnotationsValidationError) ErrorName() string {
+	return "ClusterAnnotationsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClusterAnnotationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterAnnotations.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterAnnotationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterAnnotationsValidationError{}
+
+var _ClusterAnnotations_Values_Pattern = regexp.MustCompile("^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$")
@@ -0,0 +1,379 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.2
+// source: api/domain/commanddata/role.proto
+
+package commanddata
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Command data to define a new role
+type CreateRoleCommandData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique name of the role, e.g. "viewer"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Human readable description of the role
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Scopes the role can be bound to, e.g. "tenant"
+	Scopes *RoleScopes `protobuf:"bytes,3,opt,name=scopes,proto3" json:"scopes,omitempty"`
+	// Name of the Kubernetes ClusterRole users bound to this role get within
+	// clusters, e.g. "view". Empty if the role does not grant access to clusters.
+	K8SClusterRole string `protobuf:"bytes,4,opt,name=k8s_cluster_role,json=k8sClusterRole,proto3" json:"k8s_cluster_role,omitempty"`
+}
+
+func (x *CreateRoleCommandData) Reset() {
+	*x = CreateRoleCommandData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_domain_commanddata_role_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoleCommandData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleCommandData) ProtoMessage() {}
+
+func (x *CreateRoleCommandData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_domain_commanddata_role_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleCommandData.ProtoReflect.Descriptor instead.
+func (*CreateRoleCommandData) Descriptor() ([]byte, []int) {
+	return file_api_domain_commanddata_role_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRoleCommandData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoleCommandData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRoleCommandData) GetScopes() *RoleScopes {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateRoleCommandData) GetK8SClusterRole() string {
+	if x != nil {
+		return x.K8SClusterRole
+	}
+	return ""
+}
+
+// Command data to update a role
+type UpdateRoleCommandData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Human readable description of the role
+	Description *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// Scopes replacing the current scopes of the role if set
+	Scopes *RoleScopes `protobuf:"bytes,2,opt,name=scopes,proto3" json:"scopes,omitempty"`
+	// Name of the Kubernetes ClusterRole users bound to this role get within
+	// clusters, empty to not grant access to clusters
+	K8SClusterRole *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=k8s_cluster_role,json=k8sClusterRole,proto3" json:"k8s_cluster_role,omitempty"`
+}
+
+func (x *UpdateRoleCommandData) Reset() {
+	*x = UpdateRoleCommandData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_domain_commanddata_role_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoleCommandData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleCommandData) ProtoMessage() {}
+
+func (x *UpdateRoleCommandData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_domain_commanddata_role_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleCommandData.ProtoReflect.Descriptor instead.
+func (*UpdateRoleCommandData) Descriptor() ([]byte, []int) {
+	return file_api_domain_commanddata_role_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateRoleCommandData) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateRoleCommandData) GetScopes() *RoleScopes {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *UpdateRoleCommandData) GetK8SClusterRole() *wrapperspb.StringValue {
+	if x != nil {
+		return x.K8SClusterRole
+	}
+	return nil
+}
+
+// RoleScopes wraps scopes to distinguish between unset and empty scopes
+type RoleScopes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Scopes the role can be bound to, e.g. "tenant"
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *RoleScopes) Reset() {
+	*x = RoleScopes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_domain_commanddata_role_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleScopes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleScopes) ProtoMessage() {}
+
+func (x *RoleScopes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_domain_commanddata_role_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleScopes.ProtoReflect.Descriptor instead.
+func (*RoleScopes) Descriptor() ([]byte, []int) {
+	return file_api_domain_commanddata_role_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RoleScopes) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_api_domain_commanddata_role_proto protoreflect.FileDescriptor
+
+var file_api_domain_commanddata_role_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x61,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x02, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x18, 0x3c, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
+	0xf4, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x39, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x10, 0x6b, 0x38,
+	0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xfa, 0x42, 0x2b, 0x72, 0x29, 0x18, 0xfd, 0x01, 0x32, 0x24,
+	0x5e, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x2d, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x3a, 0x2e, 0x5d, 0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29,
+	0x3f, 0x29, 0x3f, 0x24, 0x52, 0x0e, 0x6b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x6f, 0x6c, 0x65, 0x22, 0x8a, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x48,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xf4, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x76, 0x0a, 0x10, 0x6b, 0x38, 0x73,
+	0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x2e, 0xfa, 0x42, 0x2b, 0x72, 0x29, 0x18, 0xfd, 0x01, 0x32, 0x24, 0x5e, 0x28, 0x5b,
+	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x2d, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x3a, 0x2e, 0x5d, 0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x29, 0x3f,
+	0x24, 0x52, 0x0e, 0x6b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x22, 0x3e, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x18, 0xfa, 0x42, 0x15, 0x92, 0x01, 0x12, 0x08, 0x01, 0x18, 0x01, 0x22, 0x0c, 0x72, 0x0a, 0x32,
+	0x08, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5d, 0x2b, 0x24, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x66, 0x69, 0x6e, 0x6c, 0x65, 0x61, 0x70, 0x2d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f,
+	0x6d, 0x6f, 0x6e, 0x6f, 0x73, 0x6b, 0x6f, 0x70, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_domain_commanddata_role_proto_rawDescOnce sync.Once
+	file_api_domain_commanddata_role_proto_rawDescData = file_api_domain_commanddata_role_proto_rawDesc
+)
+
+func file_api_domain_commanddata_role_proto_rawDescGZIP() []byte {
+	file_api_domain_commanddata_role_proto_rawDescOnce.Do(func() {
+		file_api_domain_commanddata_role_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_domain_commanddata_role_proto_rawDescData)
+	})
+	return file_api_domain_commanddata_role_proto_rawDescData
+}
+
+var file_api_domain_commanddata_role_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_domain_commanddata_role_proto_goTypes = []interface{}{
+	(*CreateRoleCommandData)(nil),  // 0: commanddata.CreateRoleCommandData
+	(*UpdateRoleCommandData)(nil),  // 1: commanddata.UpdateRoleCommandData
+	(*RoleScopes)(nil),             // 2: commanddata.RoleScopes
+	(*wrapperspb.StringValue)(nil), // 3: google.protobuf.StringValue
+}
+var file_api_domain_commanddata_role_proto_depIdxs = []int32{
+	2, // 0: commanddata.CreateRoleCommandData.scopes:type_name -> commanddata.RoleScopes
+	3, // 1: commanddata.UpdateRoleCommandData.description:type_name -> google.protobuf.StringValue
+	2, // 2: commanddata.UpdateRoleCommandData.scopes:type_name -> commanddata.RoleScopes
+	3, // 3: commanddata.UpdateRoleCommandData.k8s_cluster_role:type_name -> google.protobuf.StringValue
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_domain_commanddata_role_proto_init() }
+func file_api_domain_commanddata_role_proto_init() {
+	if File_api_domain_commanddata_role_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_domain_commanddata_role_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoleCommandData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_domain_commanddata_role_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleCommandData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_domain_commanddata_role_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleScopes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_domain_commanddata_role_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_domain_commanddata_role_proto_goTypes,
+		DependencyIndexes: file_api_domain_commanddata_role_proto_depIdxs,
+		MessageInfos:      file_api_domain_commanddata_role_proto_msgTypes,
+	}.Build()
+	File_api_domain_commanddata_role_proto = out.File
+	file_api_domain_commanddata_role_proto_rawDesc = nil
+	file_api_domain_commanddata_role_proto_goTypes = nil
+	file_api_domain_commanddata_role_proto_depIdxs = nil
+}
@@ -0,0 +1,554 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/domain/commanddata/role.proto
+
+package commanddata
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateRoleCommandData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoleCommandData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRoleCommandData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoleCommandDataMultiError, or nil if none found.
+func (m *CreateRoleCommandData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRoleCommandData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 60 {
+		err := CreateRoleCommandDataValidationError{
+			field:  "Name",
+			reason: "value length must be at most 60 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateRoleCommandData_Name_Pattern.MatchString(m.GetName()) {
+		err := CreateRoleCommandDataValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z][a-z0-9-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 500 {
+		err := CreateRoleCommandDataValidationError{
+			field:  "Description",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetScopes() == nil {
+		err := CreateRoleCommandDataValidationError{
+			field:  "Scopes",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetScopes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRoleCommandDataValidationError{
+					field:  "Scopes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRoleCommandDataValidationError{
+					field:  "Scopes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScopes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRoleCommandDataValidationError{
+				field:  "Scopes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetK8SClusterRole()) > 253 {
+		err := CreateRoleCommandDataValidationError{
+			field:  "K8SClusterRole",
+			reason: "value length must be at most 253 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateRoleCommandData_K8SClusterRole_Pattern.MatchString(m.GetK8SClusterRole()) {
+		err := CreateRoleCommandDataValidationError{
+			field:  "K8SClusterRole",
+			reason: "value does not match regex pattern \"^([a-z0-9]([-a-z0-9:.]*[a-z0-9])?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateRoleCommandDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRoleCommandDataMultiError is an error wrapping multiple validation
+// errors returned by CreateRoleCommandData.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRoleCommandDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRoleCommandDataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRoleCommandDataMultiError) AllErrors() []error { return m }
+
+// CreateRoleCommandDataValidationError is the validation error returned by
+// CreateRoleCommandData.Validate if the designated constraints aren't met.
+type CreateRoleCommandDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRoleCommandDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRoleCommandDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRoleCommandDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRoleCommandDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRoleCommandDataValidationError) ErrorName() string {
+	return "CreateRoleCommandDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRoleCommandDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRoleCommandData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRoleCommandDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRoleCommandDataValidationError{}
+
+var _CreateRoleCommandData_Name_Pattern = regexp.MustCompile("^[a-z][a-z0-9-]*$")
+
+var _CreateRoleCommandData_K8SClusterRole_Pattern = regexp.MustCompile("^([a-z0-9]([-a-z0-9:.]*[a-z0-9])?)?$")
+
+// Validate checks the field values on UpdateRoleCommandData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRoleCommandData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRoleCommandData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRoleCommandDataMultiError, or nil if none found.
+func (m *UpdateRoleCommandData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRoleCommandData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if wrapper := m.GetDescription(); wrapper != nil {
+
+		if utf8.RuneCountInString(wrapper.GetValue()) > 500 {
+			err := UpdateRoleCommandDataValidationError{
+				field:  "Description",
+				reason: "value length must be at most 500 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetScopes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRoleCommandDataValidationError{
+					field:  "Scopes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRoleCommandDataValidationError{
+					field:  "Scopes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScopes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRoleCommandDataValidationError{
+				field:  "Scopes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if wrapper := m.GetK8SClusterRole(); wrapper != nil {
+
+		if utf8.RuneCountInString(wrapper.GetValue()) > 253 {
+			err := UpdateRoleCommandDataValidationError{
+				field:  "K8SClusterRole",
+				reason: "value length must be at most 253 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UpdateRoleCommandData_K8SClusterRole_Pattern.MatchString(wrapper.GetValue()) {
+			err := UpdateRoleCommandDataValidationError{
+				field:  "K8SClusterRole",
+				reason: "value does not match regex pattern \"^([a-z0-9]([-a-z0-9:.]*[a-z0-9])?)?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateRoleCommandDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRoleCommandDataMultiError is an error wrapping multiple validation
+// errors returned by UpdateRoleCommandData.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRoleCommandDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRoleCommandDataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRoleCommandDataMultiError) AllErrors() []error { return m }
+
+// UpdateRoleCommandDataValidationError is the validation error returned by
+// UpdateRoleCommandData.Validate if the designated constraints aren't met.
+type UpdateRoleCommandDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRoleCommandDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRoleCommandDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRoleCommandDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRoleCommandDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRoleCommandDataValidationError) ErrorName() string {
+	return "UpdateRoleCommandDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRoleCommandDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRoleCommandData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRoleCommandDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRoleCommandDataValidationError{}
+
+var _UpdateRoleCommandData_K8SClusterRole_Pattern = regexp.MustCompile("^([a-z0-9]([-a-z0-9:.]*[a-z0-9])?)?$")
+
+// Validate checks the field values on RoleScopes with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleScopes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleScopes with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleScopesMultiError, or
+// nil if none found.
+func (m *RoleScopes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleScopes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetValues()) < 1 {
+		err := RoleScopesValidationError{
+			field:  "Values",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_RoleScopes_Values_Unique := make(map[string]struct{}, len(m.GetValues()))
+
+	for idx, item := range m.GetValues() {
+		_, _ = idx, item
+
+		if _, exists := _RoleScopes_Values_Unique[item]; exists {
+			err := RoleScopesValidationError{
+				field:  fmt.Sprintf("Values[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_RoleScopes_Values_Unique[item] = struct{}{}
+		}
+
+		if !_RoleScopes_Values_Pattern.MatchString(item) {
+			err := RoleScopesValidationError{
+				field:  fmt.Sprintf("Values[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-z]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RoleScopesMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleScopesMultiError is an error wrapping multiple validation errors
+// returned by RoleScopes.ValidateAll() if the designated constraints aren't met.
+type RoleScopesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleScopesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleScopesMultiError) AllErrors() []error { return m }
+
+// RoleScopesValidationError is the validation error returned by
+// RoleScopes.Validate if the designated constraints aren't met.
+type RoleScopesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleScopesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleScopesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleScopesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleScopesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleScopesValidationError) ErrorName() string { return "RoleScopesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleScopesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleScopes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleScopesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleScopesValidationError{}
+
+var _RoleScopes_Values_Pattern = regexp.MustCompile("^[a-z]+$")
@@ -46,6 +46,14 @@ type CreateTenantCommandData struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Prefix for namespaces and other resources related to the tenant
 	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Unique identifier of the parent tenant (UUID 128-bit number), optional
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Cost centre the tenant is accounted to
+	CostCentre string `protobuf:"bytes,4,opt,name=cost_centre,json=costCentre,proto3" json:"cost_centre,omitempty"`
+	// Contact of the tenant, e.g. an email address of the team
+	Contact string `protobuf:"bytes,5,opt,name=contact,proto3" json:"contact,omitempty"`
+	// Maximum number of cluster bindings of the tenant, 0 means unlimited
+	MaxClusterBindings uint32 `protobuf:"varint,6,opt,name=max_cluster_bindings,json=maxClusterBindings,proto3" json:"max_cluster_bindings,omitempty"`
 }
 
 func (x *CreateTenantCommandData) Reset() {
@@ -94,6 +102,34 @@ func (x *CreateTenantCommandData) GetPrefix() string {
 	return ""
 }
 
+func (x *CreateTenantCommandData) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateTenantCommandData) GetCostCentre() string {
+	if x != nil {
+		return x.CostCentre
+	}
+	return ""
+}
+
+func (x *CreateTenantCommandData) GetContact() string {
+	if x != nil {
+		return x.Contact
+	}
+	return ""
+}
+
+func (x *CreateTenantCommandData) GetMaxClusterBindings() uint32 {
+	if x != nil {
+		return x.MaxClusterBindings
+	}
+	return 0
+}
+
 // Command data to update a tenant
 type UpdateTenantCommandData struct {
 	state         protoimpl.MessageState
@@ -102,6 +138,14 @@ type UpdateTenantCommandData struct {
 
 	// New name for the tenant
 	Name *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// New parent tenant, an empty value removes the parent
+	ParentId *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// New cost centre the tenant is accounted to
+	CostCentre *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=cost_centre,json=costCentre,proto3" json:"cost_centre,omitempty"`
+	// New contact of the tenant
+	Contact *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=contact,proto3" json:"contact,omitempty"`
+	// New maximum number of cluster bindings of the tenant, 0 means unlimited
+	MaxClusterBindings *wrapperspb.UInt32Value `protobuf:"bytes,5,opt,name=max_cluster_bindings,json=maxClusterBindings,proto3" json:"max_cluster_bindings,omitempty"`
 }
 
 func (x *UpdateTenantCommandData) Reset() {
@@ -143,6 +187,34 @@ func (x *UpdateTenantCommandData) GetName() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *UpdateTenantCommandData) GetParentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *UpdateTenantCommandData) GetCostCentre() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CostCentre
+	}
+	return nil
+}
+
+func (x *UpdateTenantCommandData) GetContact() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *UpdateTenantCommandData) GetMaxClusterBindings() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxClusterBindings
+	}
+	return nil
+}
+
 var File_api_domain_commanddata_tenant_proto protoreflect.FileDescriptor
 
 var file_api_domain_commanddata_tenant_proto_rawDesc = []byte{
@@ -152,7 +224,7 @@ var file_api_domain_commanddata_tenant_proto_rawDesc = []byte{
 	0x74, 0x61, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x01, 0x0a, 0x17,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x02, 0x0a, 0x17,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
 	0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x03, 0x18, 0x96,
@@ -161,19 +233,48 @@ var file_api_domain_commanddata_tenant_proto_rawDesc = []byte{
 	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xfa,
 	0x42, 0x20, 0x72, 0x1e, 0x10, 0x02, 0x18, 0x0c, 0x32, 0x18, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
 	0x2d, 0x5a, 0x5d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
-	0x2b, 0x24, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x6d, 0x0a, 0x17, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x52, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x42, 0x20, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x10, 0x03, 0x18, 0x96, 0x01, 0x32, 0x14, 0x5e,
-	0x5b, 0x5e, 0x5c, 0x73, 0x5d, 0x2b, 0x28, 0x5c, 0x73, 0x2b, 0x5b, 0x5e, 0x5c, 0x73, 0x5d, 0x2b,
-	0x29, 0x2a, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6e, 0x6c, 0x65, 0x61, 0x70, 0x2d,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x73, 0x6b, 0x6f, 0x70,
-	0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x61, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x2b, 0x24, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa,
+	0x42, 0x08, 0x72, 0x06, 0xb0, 0x01, 0x01, 0xd0, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x18, 0x64, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x12, 0x22,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xc8, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x12, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x8f, 0x03, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x52, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x20, 0xfa, 0x42,
+	0x1d, 0x72, 0x1b, 0x10, 0x03, 0x18, 0x96, 0x01, 0x32, 0x14, 0x5e, 0x5b, 0x5e, 0x5c, 0x73, 0x5d,
+	0x2b, 0x28, 0x5c, 0x73, 0x2b, 0x5b, 0x5e, 0x5c, 0x73, 0x5d, 0x2b, 0x29, 0x2a, 0x24, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xb0, 0x01, 0x01, 0xd0,
+	0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0b,
+	0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x43, 0x65,
+	0x6e, 0x74, 0x72, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xc8, 0x01, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x4e, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6e, 0x6c, 0x65, 0x61, 0x70, 0x2d, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x73, 0x6b, 0x6f, 0x70, 0x65, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -193,14 +294,19 @@ var file_api_domain_commanddata_tenant_proto_goTypes = []interface{}{
 	(*CreateTenantCommandData)(nil), // 0: commanddata.CreateTenantCommandData
 	(*UpdateTenantCommandData)(nil), // 1: commanddata.UpdateTenantCommandData
 	(*wrapperspb.StringValue)(nil),  // 2: google.protobuf.StringValue
+	(*wrapperspb.UInt32Value)(nil),  // 3: google.protobuf.UInt32Value
 }
 var file_api_domain_commanddata_tenant_proto_depIdxs = []int32{
 	2, // 0: commanddata.UpdateTenantCommandData.name:type_name -> google.protobuf.StringValue
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: commanddata.UpdateTenantCommandData.parent_id:type_name -> google.protobuf.StringValue
+	2, // 2: commanddata.UpdateTenantCommandData.cost_centre:type_name -> google.protobuf.StringValue
+	2, // 3: commanddata.UpdateTenantCommandData.contact:type_name -> google.protobuf.StringValue
+	3, // 4: commanddata.UpdateTenantCommandData.max_cluster_bindings:type_name -> google.protobuf.UInt32Value
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_domain_commanddata_tenant_proto_init() }
@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _tenant_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on CreateTenantCommandData with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -101,6 +104,46 @@ func (m *CreateTenantCommandData) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetParentId() != "" {
+
+		if err := m._validateUuid(m.GetParentId()); err != nil {
+			err = CreateTenantCommandDataValidationError{
+				field:  "ParentId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetCostCentre()) > 100 {
+		err := CreateTenantCommandDataValidationError{
+			field:  "CostCentre",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContact()) > 200 {
+		err := CreateTenantCommandDataValidationError{
+			field:  "Contact",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxClusterBindings
+
 	if len(errors) > 0 {
 		return CreateTenantCommandDataMultiError(errors)
 	}
@@ -108,6 +151,14 @@ func (m *CreateTenantCommandData) validate(all bool) error {
 	return nil
 }
 
+func (m *CreateTenantCommandData) _validateUuid(uuid string) error {
+	if matched := _tenant_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // CreateTenantCommandDataMultiError is an error wrapping multiple validation
 // errors returned by CreateTenantCommandData.ValidateAll() if the designated
 // constraints aren't met.
@@ -233,6 +284,85 @@ func (m *UpdateTenantCommandData) validate(all bool) error {
 
 	}
 
+	if wrapper := m.GetParentId(); wrapper != nil {
+
+		if wrapper.GetValue() != "" {
+
+			if err := m._validateUuid(wrapper.GetValue()); err != nil {
+				err = UpdateTenantCommandDataValidationError{
+					field:  "ParentId",
+					reason: "value must be a valid UUID",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if wrapper := m.GetCostCentre(); wrapper != nil {
+
+		if utf8.RuneCountInString(wrapper.GetValue()) > 100 {
+			err := UpdateTenantCommandDataValidationError{
+				field:  "CostCentre",
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if wrapper := m.GetContact(); wrapper != nil {
+
+		if utf8.RuneCountInString(wrapper.GetValue()) > 200 {
+			err := UpdateTenantCommandDataValidationError{
+				field:  "Contact",
+				reason: "value length must be at most 200 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxClusterBindings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTenantCommandDataValidationError{
+					field:  "MaxClusterBindings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTenantCommandDataValidationError{
+					field:  "MaxClusterBindings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxClusterBindings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTenantCommandDataValidationError{
+				field:  "MaxClusterBindings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UpdateTenantCommandDataMultiError(errors)
 	}
@@ -240,6 +370,14 @@ func (m *UpdateTenantCommandData) validate(all bool) error {
 	return nil
 }
 
+func (m *UpdateTenantCommandData) _validateUuid(uuid string) error {
+	if matched := _tenant_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // UpdateTenantCommandDataMultiError is an error wrapping multiple validation
 // errors returned by UpdateTenantCommandData.ValidateAll() if the designated
 // constraints aren't met.
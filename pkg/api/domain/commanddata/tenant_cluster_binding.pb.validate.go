@@ -73,11 +73,26 @@ func (m *CreateTenantClusterBindingCommandData) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateUuid(m.GetClusterId()); err != nil {
-		err = CreateTenantClusterBindingCommandDataValidationError{
-			field:  "ClusterId",
-			reason: "value must be a valid UUID",
-			cause:  err,
+	if m.GetClusterId() != "" {
+
+		if err := m._validateUuid(m.GetClusterId()); err != nil {
+			err = CreateTenantClusterBindingCommandDataValidationError{
+				field:  "ClusterId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetClusterSelector()) > 1000 {
+		err := CreateTenantClusterBindingCommandDataValidationError{
+			field:  "ClusterSelector",
+			reason: "value length must be at most 1000 runes",
 		}
 		if !all {
 			return err
@@ -622,3 +622,463 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ClusterUpdatedV2ValidationError{}
+
+// Validate checks the field values on ClusterCreatedV4 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClusterCreatedV4) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterCreatedV4 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClusterCreatedV4MultiError, or nil if none found.
+func (m *ClusterCreatedV4) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterCreatedV4) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for ApiServerAddress
+
+	// no validation rules for CaCertificateBundle
+
+	// no validation rules for Description
+
+	// no validation rules for Labels
+
+	// no validation rules for Annotations
+
+	if len(errors) > 0 {
+		return ClusterCreatedV4MultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterCreatedV4MultiError is an error wrapping multiple validation errors
+// returned by ClusterCreatedV4.ValidateAll() if the designated constraints
+// aren't met.
+type ClusterCreatedV4MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterCreatedV4MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterCreatedV4MultiError) AllErrors() []error { return m }
+
+// ClusterCreatedV4ValidationError is the validation error returned by
+// ClusterCreatedV4.Validate if the designated constraints aren't met.
+type ClusterCreatedV4ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterCreatedV4ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterCreatedV4ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterCreatedV4ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterCreatedV4ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterCreatedV4ValidationError) ErrorName() string { return "ClusterCreatedV4ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterCreatedV4ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterCreatedV4.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterCreatedV4ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterCreatedV4ValidationError{}
+
+// Validate checks the field values on ClusterUpdatedV3 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClusterUpdatedV3) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterUpdatedV3 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClusterUpdatedV3MultiError, or nil if none found.
+func (m *ClusterUpdatedV3) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterUpdatedV3) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterUpdatedV3ValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterUpdatedV3ValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterUpdatedV3ValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetApiServerAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterUpdatedV3ValidationError{
+					field:  "ApiServerAddress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterUpdatedV3ValidationError{
+					field:  "ApiServerAddress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApiServerAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterUpdatedV3ValidationError{
+				field:  "ApiServerAddress",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CaCertificateBundle
+
+	if all {
+		switch v := interface{}(m.GetDescription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterUpdatedV3ValidationError{
+					field:  "Description",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterUpdatedV3ValidationError{
+					field:  "Description",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDescription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterUpdatedV3ValidationError{
+				field:  "Description",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLabels()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterUpdatedV3ValidationError{
+					field:  "Labels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterUpdatedV3ValidationError{
+					field:  "Labels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLabels()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterUpdatedV3ValidationError{
+				field:  "Labels",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterUpdatedV3ValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterUpdatedV3ValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterUpdatedV3ValidationError{
+				field:  "Annotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ClusterUpdatedV3MultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterUpdatedV3MultiError is an error wrapping multiple validation errors
+// returned by ClusterUpdatedV3.ValidateAll() if the designated constraints
+// aren't met.
+type ClusterUpdatedV3MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterUpdatedV3MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterUpdatedV3MultiError) AllErrors() []error { return m }
+
+// ClusterUpdatedV3ValidationError is the validation error returned by
+// ClusterUpdatedV3.Validate if the designated constraints aren't met.
+type ClusterUpdatedV3ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterUpdatedV3ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterUpdatedV3ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterUpdatedV3ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterUpdatedV3ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterUpdatedV3ValidationError) ErrorName() string { return "ClusterUpdatedV3ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterUpdatedV3ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterUpdatedV3.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterUpdatedV3ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterUpdatedV3ValidationError{}
+
+// Validate checks the field values on StringMap with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StringMap) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StringMap with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StringMapMultiError, or nil
+// if none found.
+func (m *StringMap) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StringMap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Values
+
+	if len(errors) > 0 {
+		return StringMapMultiError(errors)
+	}
+
+	return nil
+}
+
+// StringMapMultiError is an error wrapping multiple validation errors returned
+// by StringMap.ValidateAll() if the designated constraints aren't met.
+type StringMapMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StringMapMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StringMapMultiError) AllErrors() []error { return m }
+
+// StringMapValidationError is the validation error returned by
+// StringMap.Validate if the designated constraints aren't met.
+type StringMapValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StringMapValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StringMapValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StringMapValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StringMapValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StringMapValidationError) ErrorName() string { return "StringMapValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StringMapValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStringMap.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StringMapValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StringMapValidationError{}
@@ -61,6 +61,8 @@ func (m *TenantClusterBindingCreated) validate(all bool) error {
 
 	// no validation rules for ClusterId
 
+	// no validation rules for ClusterSelector
+
 	if len(errors) > 0 {
 		return TenantClusterBindingCreatedMultiError(errors)
 	}
@@ -0,0 +1,426 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/domain/eventdata/role.proto
+
+package eventdata
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RoleCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleCreated) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleCreatedMultiError, or
+// nil if none found.
+func (m *RoleCreated) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleCreated) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for K8SClusterRole
+
+	if len(errors) > 0 {
+		return RoleCreatedMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleCreatedMultiError is an error wrapping multiple validation errors
+// returned by RoleCreated.ValidateAll() if the designated constraints aren't met.
+type RoleCreatedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleCreatedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleCreatedMultiError) AllErrors() []error { return m }
+
+// RoleCreatedValidationError is the validation error returned by
+// RoleCreated.Validate if the designated constraints aren't met.
+type RoleCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleCreatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleCreatedValidationError) ErrorName() string { return "RoleCreatedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleCreatedValidationError{}
+
+// Validate checks the field values on RoleUpdated with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleUpdated) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleUpdated with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleUpdatedMultiError, or
+// nil if none found.
+func (m *RoleUpdated) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleUpdated) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDescription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleUpdatedValidationError{
+					field:  "Description",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleUpdatedValidationError{
+					field:  "Description",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDescription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleUpdatedValidationError{
+				field:  "Description",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetScopes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleUpdatedValidationError{
+					field:  "Scopes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleUpdatedValidationError{
+					field:  "Scopes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScopes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleUpdatedValidationError{
+				field:  "Scopes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetK8SClusterRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleUpdatedValidationError{
+					field:  "K8SClusterRole",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleUpdatedValidationError{
+					field:  "K8SClusterRole",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetK8SClusterRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleUpdatedValidationError{
+				field:  "K8SClusterRole",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RoleUpdatedMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleUpdatedMultiError is an error wrapping multiple validation errors
+// returned by RoleUpdated.ValidateAll() if the designated constraints aren't met.
+type RoleUpdatedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleUpdatedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleUpdatedMultiError) AllErrors() []error { return m }
+
+// RoleUpdatedValidationError is the validation error returned by
+// RoleUpdated.Validate if the designated constraints aren't met.
+type RoleUpdatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleUpdatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleUpdatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleUpdatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleUpdatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleUpdatedValidationError) ErrorName() string { return "RoleUpdatedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleUpdatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleUpdated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleUpdatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleUpdatedValidationError{}
+
+// Validate checks the field values on StringList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StringList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StringList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StringListMultiError, or
+// nil if none found.
+func (m *StringList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StringList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StringListMultiError(errors)
+	}
+
+	return nil
+}
+
+// StringListMultiError is an error wrapping multiple validation errors
+// returned by StringList.ValidateAll() if the designated constraints aren't met.
+type StringListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StringListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StringListMultiError) AllErrors() []error { return m }
+
+// StringListValidationError is the validation error returned by
+// StringList.Validate if the designated constraints aren't met.
+type StringListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StringListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StringListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StringListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StringListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StringListValidationError) ErrorName() string { return "StringListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StringListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStringList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StringListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StringListValidationError{}
@@ -140,6 +140,183 @@ func (x *TenantUpdated) GetName() *wrapperspb.StringValue {
 	return nil
 }
 
+type TenantCreatedV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the tenant
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Prefix of the tenant
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Unique identifier of the parent tenant (UUID 128-bit number)
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Cost centre the tenant is accounted to
+	CostCentre string `protobuf:"bytes,4,opt,name=cost_centre,json=costCentre,proto3" json:"cost_centre,omitempty"`
+	// Contact of the tenant
+	Contact string `protobuf:"bytes,5,opt,name=contact,proto3" json:"contact,omitempty"`
+	// Maximum number of cluster bindings of the tenant, 0 means unlimited
+	MaxClusterBindings uint32 `protobuf:"varint,6,opt,name=max_cluster_bindings,json=maxClusterBindings,proto3" json:"max_cluster_bindings,omitempty"`
+}
+
+func (x *TenantCreatedV2) Reset() {
+	*x = TenantCreatedV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_domain_eventdata_tenant_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantCreatedV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantCreatedV2) ProtoMessage() {}
+
+func (x *TenantCreatedV2) ProtoReflect() protoreflect.Message {
+	mi := &file_api_domain_eventdata_tenant_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantCreatedV2.ProtoReflect.Descriptor instead.
+func (*TenantCreatedV2) Descriptor() ([]byte, []int) {
+	return file_api_domain_eventdata_tenant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TenantCreatedV2) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantCreatedV2) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *TenantCreatedV2) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *TenantCreatedV2) GetCostCentre() string {
+	if x != nil {
+		return x.CostCentre
+	}
+	return ""
+}
+
+func (x *TenantCreatedV2) GetContact() string {
+	if x != nil {
+		return x.Contact
+	}
+	return ""
+}
+
+func (x *TenantCreatedV2) GetMaxClusterBindings() uint32 {
+	if x != nil {
+		return x.MaxClusterBindings
+	}
+	return 0
+}
+
+type TenantUpdatedV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// New name for the tenant
+	Name *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// New parent tenant, an empty value removes the parent
+	ParentId *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// New cost centre the tenant is accounted to
+	CostCentre *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=cost_centre,json=costCentre,proto3" json:"cost_centre,omitempty"`
+	// New contact of the tenant
+	Contact *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=contact,proto3" json:"contact,omitempty"`
+	// New maximum number of cluster bindings of the tenant, 0 means unlimited
+	MaxClusterBindings *wrapperspb.UInt32Value `protobuf:"bytes,5,opt,name=max_cluster_bindings,json=maxClusterBindings,proto3" json:"max_cluster_bindings,omitempty"`
+}
+
+func (x *TenantUpdatedV2) Reset() {
+	*x = TenantUpdatedV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_domain_eventdata_tenant_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantUpdatedV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUpdatedV2) ProtoMessage() {}
+
+func (x *TenantUpdatedV2) ProtoReflect() protoreflect.Message {
+	mi := &file_api_domain_eventdata_tenant_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUpdatedV2.ProtoReflect.Descriptor instead.
+func (*TenantUpdatedV2) Descriptor() ([]byte, []int) {
+	return file_api_domain_eventdata_tenant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TenantUpdatedV2) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *TenantUpdatedV2) GetParentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *TenantUpdatedV2) GetCostCentre() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CostCentre
+	}
+	return nil
+}
+
+func (x *TenantUpdatedV2) GetContact() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *TenantUpdatedV2) GetMaxClusterBindings() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxClusterBindings
+	}
+	return nil
+}
+
 var File_api_domain_eventdata_tenant_proto protoreflect.FileDescriptor
 
 var file_api_domain_eventdata_tenant_proto_rawDesc = []byte{
@@ -155,12 +332,45 @@ var file_api_domain_eventdata_tenant_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x3f,
-	0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6e,
-	0x6c, 0x65, 0x61, 0x70, 0x2d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x6f, 0x6e,
-	0x6f, 0x73, 0x6b, 0x6f, 0x70, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x64, 0x61, 0x74, 0x61, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc7,
+	0x01, 0x0a, 0x0f, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x56, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xc5, 0x02, 0x0a, 0x0f, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x56, 0x32, 0x12, 0x30, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x73,
+	0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x63, 0x6f,
+	0x73, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x12, 0x4e, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x6d, 0x61,
+	0x78, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66,
+	0x69, 0x6e, 0x6c, 0x65, 0x61, 0x70, 0x2d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d,
+	0x6f, 0x6e, 0x6f, 0x73, 0x6b, 0x6f, 0x70, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -175,19 +385,27 @@ func file_api_domain_eventdata_tenant_proto_rawDescGZIP() []byte {
 	return file_api_domain_eventdata_tenant_proto_rawDescData
 }
 
-var file_api_domain_eventdata_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_domain_eventdata_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_api_domain_eventdata_tenant_proto_goTypes = []interface{}{
 	(*TenantCreated)(nil),          // 0: eventdata.TenantCreated
 	(*TenantUpdated)(nil),          // 1: eventdata.TenantUpdated
-	(*wrapperspb.StringValue)(nil), // 2: google.protobuf.StringValue
+	(*TenantCreatedV2)(nil),        // 2: eventdata.TenantCreatedV2
+	(*TenantUpdatedV2)(nil),        // 3: eventdata.TenantUpdatedV2
+	(*wrapperspb.StringValue)(nil), // 4: google.protobuf.StringValue
+	(*wrapperspb.UInt32Value)(nil), // 5: google.protobuf.UInt32Value
 }
 var file_api_domain_eventdata_tenant_proto_depIdxs = []int32{
-	2, // 0: eventdata.TenantUpdated.name:type_name -> google.protobuf.StringValue
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4, // 0: eventdata.TenantUpdated.name:type_name -> google.protobuf.StringValue
+	4, // 1: eventdata.TenantUpdatedV2.name:type_name -> google.protobuf.StringValue
+	4, // 2: eventdata.TenantUpdatedV2.parent_id:type_name -> google.protobuf.StringValue
+	4, // 3: eventdata.TenantUpdatedV2.cost_centre:type_name -> google.protobuf.StringValue
+	4, // 4: eventdata.TenantUpdatedV2.contact:type_name -> google.protobuf.StringValue
+	5, // 5: eventdata.TenantUpdatedV2.max_cluster_bindings:type_name -> google.protobuf.UInt32Value
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_api_domain_eventdata_tenant_proto_init() }
@@ -220,6 +438,30 @@ func file_api_domain_eventdata_tenant_proto_init() {
 				return nil
 			}
 		}
+		file_api_domain_eventdata_tenant_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantCreatedV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_domain_eventdata_tenant_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantUpdatedV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -227,7 +469,7 @@ func file_api_domain_eventdata_tenant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_domain_eventdata_tenant_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -267,3 +267,360 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = TenantUpdatedValidationError{}
+
+// Validate checks the field values on TenantCreatedV2 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantCreatedV2) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantCreatedV2 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantCreatedV2MultiError, or nil if none found.
+func (m *TenantCreatedV2) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantCreatedV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Prefix
+
+	// no validation rules for ParentId
+
+	// no validation rules for CostCentre
+
+	// no validation rules for Contact
+
+	// no validation rules for MaxClusterBindings
+
+	if len(errors) > 0 {
+		return TenantCreatedV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantCreatedV2MultiError is an error wrapping multiple validation errors
+// returned by TenantCreatedV2.ValidateAll() if the designated constraints
+// aren't met.
+type TenantCreatedV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantCreatedV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantCreatedV2MultiError) AllErrors() []error { return m }
+
+// TenantCreatedV2ValidationError is the validation error returned by
+// TenantCreatedV2.Validate if the designated constraints aren't met.
+type TenantCreatedV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantCreatedV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantCreatedV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantCreatedV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantCreatedV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantCreatedV2ValidationError) ErrorName() string { return "TenantCreatedV2ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantCreatedV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantCreatedV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantCreatedV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantCreatedV2ValidationError{}
+
+// Validate checks the field values on TenantUpdatedV2 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantUpdatedV2) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantUpdatedV2 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantUpdatedV2MultiError, or nil if none found.
+func (m *TenantUpdatedV2) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantUpdatedV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantUpdatedV2ValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantUpdatedV2ValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantUpdatedV2ValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetParentId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantUpdatedV2ValidationError{
+					field:  "ParentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantUpdatedV2ValidationError{
+					field:  "ParentId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetParentId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantUpdatedV2ValidationError{
+				field:  "ParentId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCostCentre()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantUpdatedV2ValidationError{
+					field:  "CostCentre",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantUpdatedV2ValidationError{
+					field:  "CostCentre",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCostCentre()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantUpdatedV2ValidationError{
+				field:  "CostCentre",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetContact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantUpdatedV2ValidationError{
+					field:  "Contact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantUpdatedV2ValidationError{
+					field:  "Contact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantUpdatedV2ValidationError{
+				field:  "Contact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxClusterBindings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantUpdatedV2ValidationError{
+					field:  "MaxClusterBindings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantUpdatedV2ValidationError{
+					field:  "MaxClusterBindings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxClusterBindings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantUpdatedV2ValidationError{
+				field:  "MaxClusterBindings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TenantUpdatedV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantUpdatedV2MultiError is an error wrapping multiple validation errors
+// returned by TenantUpdatedV2.ValidateAll() if the designated constraints
+// aren't met.
+type TenantUpdatedV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantUpdatedV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantUpdatedV2MultiError) AllErrors() []error { return m }
+
+// TenantUpdatedV2ValidationError is the validation error returned by
+// TenantUpdatedV2.Validate if the designated constraints aren't met.
+type TenantUpdatedV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantUpdatedV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantUpdatedV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantUpdatedV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantUpdatedV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantUpdatedV2ValidationError) ErrorName() string { return "TenantUpdatedV2ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantUpdatedV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantUpdatedV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantUpdatedV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantUpdatedV2ValidationError{}
@@ -93,6 +93,12 @@ func (m *Cluster) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Description
+
+	// no validation rules for Labels
+
+	// no validation rules for Annotations
+
 	if len(errors) > 0 {
 		return ClusterMultiError(errors)
 	}
@@ -91,6 +91,14 @@ func (m *Tenant) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ParentId
+
+	// no validation rules for CostCentre
+
+	// no validation rules for Contact
+
+	// no validation rules for MaxClusterBindings
+
 	if len(errors) > 0 {
 		return TenantMultiError(errors)
 	}
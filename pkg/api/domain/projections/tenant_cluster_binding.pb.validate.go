@@ -92,6 +92,8 @@ func (m *TenantClusterBinding) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ClusterSelector
+
 	if len(errors) > 0 {
 		return TenantClusterBindingMultiError(errors)
 	}
@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _queryhandler_service_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on GetAllRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -137,6 +140,112 @@ var _ interface {
 	ErrorName() string
 } = GetAllRequestValidationError{}
 
+// Validate checks the field values on GetAllClustersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllClustersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllClustersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllClustersRequestMultiError, or nil if none found.
+func (m *GetAllClustersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllClustersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeDeleted
+
+	// no validation rules for LabelSelector
+
+	if len(errors) > 0 {
+		return GetAllClustersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllClustersRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAllClustersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllClustersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllClustersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllClustersRequestMultiError) AllErrors() []error { return m }
+
+// GetAllClustersRequestValidationError is the validation error returned by
+// GetAllClustersRequest.Validate if the designated constraints aren't met.
+type GetAllClustersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllClustersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllClustersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllClustersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllClustersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllClustersRequestValidationError) ErrorName() string {
+	return "GetAllClustersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllClustersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllClustersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllClustersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllClustersRequestValidationError{}
+
 // Validate checks the field values on GetClusterMappingRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1130,3 +1239,984 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetUsersOverviewRequestValidationError{}
+
+// Validate checks the field values on AuditLogQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditLogQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditLogQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditLogQueryRequestMultiError, or nil if none found.
+func (m *AuditLogQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditLogQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDateRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditLogQueryRequestValidationError{
+					field:  "DateRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditLogQueryRequestValidationError{
+					field:  "DateRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditLogQueryRequestValidationError{
+				field:  "DateRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if wrapper := m.GetAggregateType(); wrapper != nil {
+
+		if !_AuditLogQueryRequest_AggregateType_Pattern.MatchString(wrapper.GetValue()) {
+			err := AuditLogQueryRequestValidationError{
+				field:  "AggregateType",
+				reason: "value does not match regex pattern \"^[a-zA-Z][A-Za-z0-9_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if wrapper := m.GetAggregateId(); wrapper != nil {
+
+		if err := m._validateUuid(wrapper.GetValue()); err != nil {
+			err = AuditLogQueryRequestValidationError{
+				field:  "AggregateId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if wrapper := m.GetEventType(); wrapper != nil {
+
+		if !_AuditLogQueryRequest_EventType_Pattern.MatchString(wrapper.GetValue()) {
+			err := AuditLogQueryRequestValidationError{
+				field:  "EventType",
+				reason: "value does not match regex pattern \"^[a-zA-Z][A-Za-z0-9_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if wrapper := m.GetActorEmail(); wrapper != nil {
+
+		if err := m._validateEmail(wrapper.GetValue()); err != nil {
+			err = AuditLogQueryRequestValidationError{
+				field:  "ActorEmail",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if wrapper := m.GetTenantId(); wrapper != nil {
+
+		if err := m._validateUuid(wrapper.GetValue()); err != nil {
+			err = AuditLogQueryRequestValidationError{
+				field:  "TenantId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuditLogQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AuditLogQueryRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AuditLogQueryRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+func (m *AuditLogQueryRequest) _validateUuid(uuid string) error {
+	if matched := _queryhandler_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AuditLogQueryRequestMultiError is an error wrapping multiple validation
+// errors returned by AuditLogQueryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuditLogQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditLogQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditLogQueryRequestMultiError) AllErrors() []error { return m }
+
+// AuditLogQueryRequestValidationError is the validation error returned by
+// AuditLogQueryRequest.Validate if the designated constraints aren't met.
+type AuditLogQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditLogQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditLogQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditLogQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditLogQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditLogQueryRequestValidationError) ErrorName() string {
+	return "AuditLogQueryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditLogQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditLogQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditLogQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditLogQueryRequestValidationError{}
+
+var _AuditLogQueryRequest_AggregateType_Pattern = regexp.MustCompile("^[a-zA-Z][A-Za-z0-9_-]+$")
+
+var _AuditLogQueryRequest_EventType_Pattern = regexp.MustCompile("^[a-zA-Z][A-Za-z0-9_-]+$")
+
+// Validate checks the field values on AuditLogExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditLogExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditLogExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditLogExportRequestMultiError, or nil if none found.
+func (m *AuditLogExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditLogExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDateRange() == nil {
+		err := AuditLogExportRequestValidationError{
+			field:  "DateRange",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDateRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditLogExportRequestValidationError{
+					field:  "DateRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditLogExportRequestValidationError{
+					field:  "DateRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditLogExportRequestValidationError{
+				field:  "DateRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := AuditLogExportRequest_ExportFormat_name[int32(m.GetFormat())]; !ok {
+		err := AuditLogExportRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AuditLogExportRequest_ExportContent_name[int32(m.GetContent())]; !ok {
+		err := AuditLogExportRequestValidationError{
+			field:  "Content",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AuditLogExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditLogExportRequestMultiError is an error wrapping multiple validation
+// errors returned by AuditLogExportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuditLogExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditLogExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditLogExportRequestMultiError) AllErrors() []error { return m }
+
+// AuditLogExportRequestValidationError is the validation error returned by
+// AuditLogExportRequest.Validate if the designated constraints aren't met.
+type AuditLogExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditLogExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditLogExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditLogExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditLogExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditLogExportRequestValidationError) ErrorName() string {
+	return "AuditLogExportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditLogExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditLogExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditLogExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditLogExportRequestValidationError{}
+
+// Validate checks the field values on DeadLetter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeadLetter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeadLetter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeadLetterMultiError, or
+// nil if none found.
+func (m *DeadLetter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeadLetter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Handler
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	// no validation rules for Retries
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeadLetterMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeadLetterMultiError is an error wrapping multiple validation errors
+// returned by DeadLetter.ValidateAll() if the designated constraints aren't met.
+type DeadLetterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeadLetterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeadLetterMultiError) AllErrors() []error { return m }
+
+// DeadLetterValidationError is the validation error returned by
+// DeadLetter.Validate if the designated constraints aren't met.
+type DeadLetterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterValidationError) ErrorName() string { return "DeadLetterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterValidationError{}
+
+// Validate checks the field values on ProjectionRebuildResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProjectionRebuildResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectionRebuildResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectionRebuildResultMultiError, or nil if none found.
+func (m *ProjectionRebuildResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectionRebuildResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AggregateType
+
+	// no validation rules for Projections
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectionRebuildResultValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectionRebuildResultValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectionRebuildResultValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProjectionRebuildResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectionRebuildResultMultiError is an error wrapping multiple validation
+// errors returned by ProjectionRebuildResult.ValidateAll() if the designated
+// constraints aren't met.
+type ProjectionRebuildResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectionRebuildResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectionRebuildResultMultiError) AllErrors() []error { return m }
+
+// ProjectionRebuildResultValidationError is the validation error returned by
+// ProjectionRebuildResult.Validate if the designated constraints aren't met.
+type ProjectionRebuildResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectionRebuildResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectionRebuildResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectionRebuildResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectionRebuildResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectionRebuildResultValidationError) ErrorName() string {
+	return "ProjectionRebuildResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProjectionRebuildResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectionRebuildResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectionRebuildResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectionRebuildResultValidationError{}
+
+// Validate checks the field values on ProjectionCheckResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProjectionCheckResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectionCheckResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectionCheckResultMultiError, or nil if none found.
+func (m *ProjectionCheckResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectionCheckResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AggregateType
+
+	// no validation rules for CheckedProjections
+
+	for idx, item := range m.GetDrift() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProjectionCheckResultValidationError{
+						field:  fmt.Sprintf("Drift[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProjectionCheckResultValidationError{
+						field:  fmt.Sprintf("Drift[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectionCheckResultValidationError{
+					field:  fmt.Sprintf("Drift[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProjectionCheckResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectionCheckResultMultiError is an error wrapping multiple validation
+// errors returned by ProjectionCheckResult.ValidateAll() if the designated
+// constraints aren't met.
+type ProjectionCheckResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectionCheckResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectionCheckResultMultiError) AllErrors() []error { return m }
+
+// ProjectionCheckResultValidationError is the validation error returned by
+// ProjectionCheckResult.Validate if the designated constraints aren't met.
+type ProjectionCheckResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectionCheckResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectionCheckResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectionCheckResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectionCheckResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectionCheckResultValidationError) ErrorName() string {
+	return "ProjectionCheckResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProjectionCheckResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectionCheckResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectionCheckResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectionCheckResultValidationError{}
+
+// Validate checks the field values on ProjectionDrift with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ProjectionDrift) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectionDrift with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectionDriftMultiError, or nil if none found.
+func (m *ProjectionDrift) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectionDrift) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AggregateId
+
+	// no validation rules for ProjectionVersion
+
+	// no validation rules for AggregateVersion
+
+	if len(errors) > 0 {
+		return ProjectionDriftMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectionDriftMultiError is an error wrapping multiple validation errors
+// returned by ProjectionDrift.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectionDriftMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectionDriftMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectionDriftMultiError) AllErrors() []error { return m }
+
+// ProjectionDriftValidationError is the validation error returned by
+// ProjectionDrift.Validate if the designated constraints aren't met.
+type ProjectionDriftValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectionDriftValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectionDriftValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectionDriftValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectionDriftValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectionDriftValidationError) ErrorName() string { return "ProjectionDriftValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProjectionDriftValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectionDrift.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectionDriftValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectionDriftValidationError{}
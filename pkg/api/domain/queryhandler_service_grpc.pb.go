@@ -579,7 +579,7 @@ var Tenant_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ClusterClient interface {
 	// GetAll returns all known clusters
-	GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (Cluster_GetAllClient, error)
+	GetAll(ctx context.Context, in *GetAllClustersRequest, opts ...grpc.CallOption) (Cluster_GetAllClient, error)
 	// GetById returns a cluster by its UUID
 	GetById(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*projections.Cluster, error)
 	// GetByName returns a cluster by its name
@@ -594,7 +594,7 @@ func NewClusterClient(cc grpc.ClientConnInterface) ClusterClient {
 	return &clusterClient{cc}
 }
 
-func (c *clusterClient) GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (Cluster_GetAllClient, error) {
+func (c *clusterClient) GetAll(ctx context.Context, in *GetAllClustersRequest, opts ...grpc.CallOption) (Cluster_GetAllClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Cluster_ServiceDesc.Streams[0], "/domain.Cluster/GetAll", opts...)
 	if err != nil {
 		return nil, err
@@ -649,7 +649,7 @@ func (c *clusterClient) GetByName(ctx context.Context, in *wrapperspb.StringValu
 // for forward compatibility
 type ClusterServer interface {
 	// GetAll returns all known clusters
-	GetAll(*GetAllRequest, Cluster_GetAllServer) error
+	GetAll(*GetAllClustersRequest, Cluster_GetAllServer) error
 	// GetById returns a cluster by its UUID
 	GetById(context.Context, *wrapperspb.StringValue) (*projections.Cluster, error)
 	// GetByName returns a cluster by its name
@@ -661,7 +661,7 @@ type ClusterServer interface {
 type UnimplementedClusterServer struct {
 }
 
-func (UnimplementedClusterServer) GetAll(*GetAllRequest, Cluster_GetAllServer) error {
+func (UnimplementedClusterServer) GetAll(*GetAllClustersRequest, Cluster_GetAllServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetAll not implemented")
 }
 func (UnimplementedClusterServer) GetById(context.Context, *wrapperspb.StringValue) (*projections.Cluster, error) {
@@ -684,7 +684,7 @@ func RegisterClusterServer(s grpc.ServiceRegistrar, srv ClusterServer) {
 }
 
 func _Cluster_GetAll_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetAllRequest)
+	m := new(GetAllClustersRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -766,6 +766,160 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "api/domain/queryhandler_service.proto",
 }
 
+// RoleClient is the client API for Role service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoleClient interface {
+	// GetAll returns all roles defined in addition to the built-in roles.
+	GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (Role_GetAllClient, error)
+	// GetByName returns the role found by the given name.
+	GetByName(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*projections.Role, error)
+}
+
+type roleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoleClient(cc grpc.ClientConnInterface) RoleClient {
+	return &roleClient{cc}
+}
+
+func (c *roleClient) GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (Role_GetAllClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Role_ServiceDesc.Streams[0], "/domain.Role/GetAll", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &roleGetAllClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Role_GetAllClient interface {
+	Recv() (*projections.Role, error)
+	grpc.ClientStream
+}
+
+type roleGetAllClient struct {
+	grpc.ClientStream
+}
+
+func (x *roleGetAllClient) Recv() (*projections.Role, error) {
+	m := new(projections.Role)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *roleClient) GetByName(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*projections.Role, error) {
+	out := new(projections.Role)
+	err := c.cc.Invoke(ctx, "/domain.Role/GetByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoleServer is the server API for Role service.
+// All implementations must embed UnimplementedRoleServer
+// for forward compatibility
+type RoleServer interface {
+	// GetAll returns all roles defined in addition to the built-in roles.
+	GetAll(*GetAllRequest, Role_GetAllServer) error
+	// GetByName returns the role found by the given name.
+	GetByName(context.Context, *wrapperspb.StringValue) (*projections.Role, error)
+	mustEmbedUnimplementedRoleServer()
+}
+
+// UnimplementedRoleServer must be embedded to have forward compatible implementations.
+type UnimplementedRoleServer struct {
+}
+
+func (UnimplementedRoleServer) GetAll(*GetAllRequest, Role_GetAllServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+}
+func (UnimplementedRoleServer) GetByName(context.Context, *wrapperspb.StringValue) (*projections.Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByName not implemented")
+}
+func (UnimplementedRoleServer) mustEmbedUnimplementedRoleServer() {}
+
+// UnsafeRoleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoleServer will
+// result in compilation errors.
+type UnsafeRoleServer interface {
+	mustEmbedUnimplementedRoleServer()
+}
+
+func RegisterRoleServer(s grpc.ServiceRegistrar, srv RoleServer) {
+	s.RegisterService(&Role_ServiceDesc, srv)
+}
+
+func _Role_GetAll_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAllRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RoleServer).GetAll(m, &roleGetAllServer{stream})
+}
+
+type Role_GetAllServer interface {
+	Send(*projections.Role) error
+	grpc.ServerStream
+}
+
+type roleGetAllServer struct {
+	grpc.ServerStream
+}
+
+func (x *roleGetAllServer) Send(m *projections.Role) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Role_GetByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServer).GetByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/domain.Role/GetByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServer).GetByName(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Role_ServiceDesc is the grpc.ServiceDesc for Role service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Role_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "domain.Role",
+	HandlerType: (*RoleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetByName",
+			Handler:    _Role_GetByName_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetAll",
+			Handler:       _Role_GetAll_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/domain/queryhandler_service.proto",
+}
+
 // ClusterAccessClient is the client API for ClusterAccess service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -1144,6 +1298,11 @@ type AuditLogClient interface {
 	// GetUsersOverview returns users overview at the specified timestamp,
 	// tenants/clusters they belong to, and their roles
 	GetUsersOverview(ctx context.Context, in *GetUsersOverviewRequest, opts ...grpc.CallOption) (AuditLog_GetUsersOverviewClient, error)
+	// Query returns structured audit events matching all of the given filters
+	Query(ctx context.Context, in *AuditLogQueryRequest, opts ...grpc.CallOption) (AuditLog_QueryClient, error)
+	// Export returns the audit events or users overviews of the given date range
+	// serialized in the requested format, one record per message
+	Export(ctx context.Context, in *AuditLogExportRequest, opts ...grpc.CallOption) (AuditLog_ExportClient, error)
 }
 
 type auditLogClient struct {
@@ -1282,6 +1441,70 @@ func (x *auditLogGetUsersOverviewClient) Recv() (*audit.UserOverview, error) {
 	return m, nil
 }
 
+func (c *auditLogClient) Query(ctx context.Context, in *AuditLogQueryRequest, opts ...grpc.CallOption) (AuditLog_QueryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AuditLog_ServiceDesc.Streams[4], "/domain.AuditLog/Query", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &auditLogQueryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AuditLog_QueryClient interface {
+	Recv() (*audit.AuditEvent, error)
+	grpc.ClientStream
+}
+
+type auditLogQueryClient struct {
+	grpc.ClientStream
+}
+
+func (x *auditLogQueryClient) Recv() (*audit.AuditEvent, error) {
+	m := new(audit.AuditEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *auditLogClient) Export(ctx context.Context, in *AuditLogExportRequest, opts ...grpc.CallOption) (AuditLog_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AuditLog_ServiceDesc.Streams[5], "/domain.AuditLog/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &auditLogExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AuditLog_ExportClient interface {
+	Recv() (*wrapperspb.BytesValue, error)
+	grpc.ClientStream
+}
+
+type auditLogExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *auditLogExportClient) Recv() (*wrapperspb.BytesValue, error) {
+	m := new(wrapperspb.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AuditLogServer is the server API for AuditLog service.
 // All implementations must embed UnimplementedAuditLogServer
 // for forward compatibility
@@ -1298,6 +1521,11 @@ type AuditLogServer interface {
 	// GetUsersOverview returns users overview at the specified timestamp,
 	// tenants/clusters they belong to, and their roles
 	GetUsersOverview(*GetUsersOverviewRequest, AuditLog_GetUsersOverviewServer) error
+	// Query returns structured audit events matching all of the given filters
+	Query(*AuditLogQueryRequest, AuditLog_QueryServer) error
+	// Export returns the audit events or users overviews of the given date range
+	// serialized in the requested format, one record per message
+	Export(*AuditLogExportRequest, AuditLog_ExportServer) error
 	mustEmbedUnimplementedAuditLogServer()
 }
 
@@ -1317,6 +1545,12 @@ func (UnimplementedAuditLogServer) GetUserActions(*GetUserActionsRequest, AuditL
 func (UnimplementedAuditLogServer) GetUsersOverview(*GetUsersOverviewRequest, AuditLog_GetUsersOverviewServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetUsersOverview not implemented")
 }
+func (UnimplementedAuditLogServer) Query(*AuditLogQueryRequest, AuditLog_QueryServer) error {
+	return status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (UnimplementedAuditLogServer) Export(*AuditLogExportRequest, AuditLog_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
 func (UnimplementedAuditLogServer) mustEmbedUnimplementedAuditLogServer() {}
 
 // UnsafeAuditLogServer may be embedded to opt out of forward compatibility for this service.
@@ -1414,6 +1648,48 @@ func (x *auditLogGetUsersOverviewServer) Send(m *audit.UserOverview) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _AuditLog_Query_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AuditLogQueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuditLogServer).Query(m, &auditLogQueryServer{stream})
+}
+
+type AuditLog_QueryServer interface {
+	Send(*audit.AuditEvent) error
+	grpc.ServerStream
+}
+
+type auditLogQueryServer struct {
+	grpc.ServerStream
+}
+
+func (x *auditLogQueryServer) Send(m *audit.AuditEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AuditLog_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AuditLogExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuditLogServer).Export(m, &auditLogExportServer{stream})
+}
+
+type AuditLog_ExportServer interface {
+	Send(*wrapperspb.BytesValue) error
+	grpc.ServerStream
+}
+
+type auditLogExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *auditLogExportServer) Send(m *wrapperspb.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // AuditLog_ServiceDesc is the grpc.ServiceDesc for AuditLog service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1442,6 +1718,16 @@ var AuditLog_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _AuditLog_GetUsersOverview_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Query",
+			Handler:       _AuditLog_Query_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _AuditLog_Export_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api/domain/queryhandler_service.proto",
 }
@@ -1625,3 +1911,381 @@ var K8SAuthZ_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "api/domain/queryhandler_service.proto",
 }
+
+// DeadLetterQueueClient is the client API for DeadLetterQueue service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeadLetterQueueClient interface {
+	// GetAll returns all quarantined events
+	GetAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (DeadLetterQueue_GetAllClient, error)
+	// Retry hands the quarantined event with the given id to the handler which
+	// failed again and removes it from the queue on success
+	Retry(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Discard removes the quarantined event with the given id from the queue
+	Discard(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type deadLetterQueueClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeadLetterQueueClient(cc grpc.ClientConnInterface) DeadLetterQueueClient {
+	return &deadLetterQueueClient{cc}
+}
+
+func (c *deadLetterQueueClient) GetAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (DeadLetterQueue_GetAllClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeadLetterQueue_ServiceDesc.Streams[0], "/domain.DeadLetterQueue/GetAll", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deadLetterQueueGetAllClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeadLetterQueue_GetAllClient interface {
+	Recv() (*DeadLetter, error)
+	grpc.ClientStream
+}
+
+type deadLetterQueueGetAllClient struct {
+	grpc.ClientStream
+}
+
+func (x *deadLetterQueueGetAllClient) Recv() (*DeadLetter, error) {
+	m := new(DeadLetter)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deadLetterQueueClient) Retry(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/domain.DeadLetterQueue/Retry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deadLetterQueueClient) Discard(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/domain.DeadLetterQueue/Discard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeadLetterQueueServer is the server API for DeadLetterQueue service.
+// All implementations must embed UnimplementedDeadLetterQueueServer
+// for forward compatibility
+type DeadLetterQueueServer interface {
+	// GetAll returns all quarantined events
+	GetAll(*emptypb.Empty, DeadLetterQueue_GetAllServer) error
+	// Retry hands the quarantined event with the given id to the handler which
+	// failed again and removes it from the queue on success
+	Retry(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	// Discard removes the quarantined event with the given id from the queue
+	Discard(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	mustEmbedUnimplementedDeadLetterQueueServer()
+}
+
+// UnimplementedDeadLetterQueueServer must be embedded to have forward compatible implementations.
+type UnimplementedDeadLetterQueueServer struct {
+}
+
+func (UnimplementedDeadLetterQueueServer) GetAll(*emptypb.Empty, DeadLetterQueue_GetAllServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+}
+func (UnimplementedDeadLetterQueueServer) Retry(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retry not implemented")
+}
+func (UnimplementedDeadLetterQueueServer) Discard(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Discard not implemented")
+}
+func (UnimplementedDeadLetterQueueServer) mustEmbedUnimplementedDeadLetterQueueServer() {}
+
+// UnsafeDeadLetterQueueServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeadLetterQueueServer will
+// result in compilation errors.
+type UnsafeDeadLetterQueueServer interface {
+	mustEmbedUnimplementedDeadLetterQueueServer()
+}
+
+func RegisterDeadLetterQueueServer(s grpc.ServiceRegistrar, srv DeadLetterQueueServer) {
+	s.RegisterService(&DeadLetterQueue_ServiceDesc, srv)
+}
+
+func _DeadLetterQueue_GetAll_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeadLetterQueueServer).GetAll(m, &deadLetterQueueGetAllServer{stream})
+}
+
+type DeadLetterQueue_GetAllServer interface {
+	Send(*DeadLetter) error
+	grpc.ServerStream
+}
+
+type deadLetterQueueGetAllServer struct {
+	grpc.ServerStream
+}
+
+func (x *deadLetterQueueGetAllServer) Send(m *DeadLetter) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DeadLetterQueue_Retry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeadLetterQueueServer).Retry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/domain.DeadLetterQueue/Retry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeadLetterQueueServer).Retry(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeadLetterQueue_Discard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeadLetterQueueServer).Discard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/domain.DeadLetterQueue/Discard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeadLetterQueueServer).Discard(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeadLetterQueue_ServiceDesc is the grpc.ServiceDesc for DeadLetterQueue service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeadLetterQueue_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "domain.DeadLetterQueue",
+	HandlerType: (*DeadLetterQueueServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Retry",
+			Handler:    _DeadLetterQueue_Retry_Handler,
+		},
+		{
+			MethodName: "Discard",
+			Handler:    _DeadLetterQueue_Discard_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetAll",
+			Handler:       _DeadLetterQueue_GetAll_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/domain/queryhandler_service.proto",
+}
+
+// ProjectionAdminClient is the client API for ProjectionAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProjectionAdminClient interface {
+	// Rebuild rebuilds the projections of the given aggregate type or of all
+	// aggregate types if empty without downtime
+	Rebuild(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (ProjectionAdmin_RebuildClient, error)
+	// Check compares the versions of the projections of the given aggregate
+	// type or of all aggregate types if empty with the event store
+	Check(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (ProjectionAdmin_CheckClient, error)
+}
+
+type projectionAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProjectionAdminClient(cc grpc.ClientConnInterface) ProjectionAdminClient {
+	return &projectionAdminClient{cc}
+}
+
+func (c *projectionAdminClient) Rebuild(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (ProjectionAdmin_RebuildClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProjectionAdmin_ServiceDesc.Streams[0], "/domain.ProjectionAdmin/Rebuild", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &projectionAdminRebuildClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProjectionAdmin_RebuildClient interface {
+	Recv() (*ProjectionRebuildResult, error)
+	grpc.ClientStream
+}
+
+type projectionAdminRebuildClient struct {
+	grpc.ClientStream
+}
+
+func (x *projectionAdminRebuildClient) Recv() (*ProjectionRebuildResult, error) {
+	m := new(ProjectionRebuildResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *projectionAdminClient) Check(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (ProjectionAdmin_CheckClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProjectionAdmin_ServiceDesc.Streams[1], "/domain.ProjectionAdmin/Check", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &projectionAdminCheckClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProjectionAdmin_CheckClient interface {
+	Recv() (*ProjectionCheckResult, error)
+	grpc.ClientStream
+}
+
+type projectionAdminCheckClient struct {
+	grpc.ClientStream
+}
+
+func (x *projectionAdminCheckClient) Recv() (*ProjectionCheckResult, error) {
+	m := new(ProjectionCheckResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ProjectionAdminServer is the server API for ProjectionAdmin service.
+// All implementations must embed UnimplementedProjectionAdminServer
+// for forward compatibility
+type ProjectionAdminServer interface {
+	// Rebuild rebuilds the projections of the given aggregate type or of all
+	// aggregate types if empty without downtime
+	Rebuild(*wrapperspb.StringValue, ProjectionAdmin_RebuildServer) error
+	// Check compares the versions of the projections of the given aggregate
+	// type or of all aggregate types if empty with the event store
+	Check(*wrapperspb.StringValue, ProjectionAdmin_CheckServer) error
+	mustEmbedUnimplementedProjectionAdminServer()
+}
+
+// UnimplementedProjectionAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedProjectionAdminServer struct {
+}
+
+func (UnimplementedProjectionAdminServer) Rebuild(*wrapperspb.StringValue, ProjectionAdmin_RebuildServer) error {
+	return status.Errorf(codes.Unimplemented, "method Rebuild not implemented")
+}
+func (UnimplementedProjectionAdminServer) Check(*wrapperspb.StringValue, ProjectionAdmin_CheckServer) error {
+	return status.Errorf(codes.Unimplemented, "method Check not implemented")
+}
+func (UnimplementedProjectionAdminServer) mustEmbedUnimplementedProjectionAdminServer() {}
+
+// UnsafeProjectionAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProjectionAdminServer will
+// result in compilation errors.
+type UnsafeProjectionAdminServer interface {
+	mustEmbedUnimplementedProjectionAdminServer()
+}
+
+func RegisterProjectionAdminServer(s grpc.ServiceRegistrar, srv ProjectionAdminServer) {
+	s.RegisterService(&ProjectionAdmin_ServiceDesc, srv)
+}
+
+func _ProjectionAdmin_Rebuild_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(wrapperspb.StringValue)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProjectionAdminServer).Rebuild(m, &projectionAdminRebuildServer{stream})
+}
+
+type ProjectionAdmin_RebuildServer interface {
+	Send(*ProjectionRebuildResult) error
+	grpc.ServerStream
+}
+
+type projectionAdminRebuildServer struct {
+	grpc.ServerStream
+}
+
+func (x *projectionAdminRebuildServer) Send(m *ProjectionRebuildResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProjectionAdmin_Check_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(wrapperspb.StringValue)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProjectionAdminServer).Check(m, &projectionAdminCheckServer{stream})
+}
+
+type ProjectionAdmin_CheckServer interface {
+	Send(*ProjectionCheckResult) error
+	grpc.ServerStream
+}
+
+type projectionAdminCheckServer struct {
+	grpc.ServerStream
+}
+
+func (x *projectionAdminCheckServer) Send(m *ProjectionCheckResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ProjectionAdmin_ServiceDesc is the grpc.ServiceDesc for ProjectionAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProjectionAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "domain.ProjectionAdmin",
+	HandlerType: (*ProjectionAdminServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Rebuild",
+			Handler:       _ProjectionAdmin_Rebuild_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Check",
+			Handler:       _ProjectionAdmin_Check_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/domain/queryhandler_service.proto",
+}
@@ -158,3 +158,558 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CommandReplyValidationError{}
+
+// Validate checks the field values on ValidationReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ValidationReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidationReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidationReplyMultiError, or nil if none found.
+func (m *ValidationReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidationReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AggregateId
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidationReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidationReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidationReplyValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ValidationReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidationReplyMultiError is an error wrapping multiple validation errors
+// returned by ValidationReply.ValidateAll() if the designated constraints
+// aren't met.
+type ValidationReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidationReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidationReplyMultiError) AllErrors() []error { return m }
+
+// ValidationReplyValidationError is the validation error returned by
+// ValidationReply.Validate if the designated constraints aren't met.
+type ValidationReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidationReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidationReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidationReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidationReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidationReplyValidationError) ErrorName() string { return "ValidationReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ValidationReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidationReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidationReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidationReplyValidationError{}
+
+// Validate checks the field values on CommandBatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CommandBatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommandBatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CommandBatchMultiError, or
+// nil if none found.
+func (m *CommandBatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommandBatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetCommands()); l < 1 || l > 100 {
+		err := CommandBatchValidationError{
+			field:  "Commands",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCommands() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CommandBatchValidationError{
+						field:  fmt.Sprintf("Commands[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CommandBatchValidationError{
+						field:  fmt.Sprintf("Commands[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CommandBatchValidationError{
+					field:  fmt.Sprintf("Commands[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return CommandBatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommandBatchMultiError is an error wrapping multiple validation errors
+// returned by CommandBatch.ValidateAll() if the designated constraints aren't met.
+type CommandBatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommandBatchMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommandBatchMultiError) AllErrors() []error { return m }
+
+// CommandBatchValidationError is the validation error returned by
+// CommandBatch.Validate if the designated constraints aren't met.
+type CommandBatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommandBatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommandBatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommandBatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommandBatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommandBatchValidationError) ErrorName() string { return "CommandBatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommandBatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommandBatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommandBatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommandBatchValidationError{}
+
+// Validate checks the field values on CommandResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CommandResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommandResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CommandResultMultiError, or
+// nil if none found.
+func (m *CommandResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommandResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AggregateId
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommandResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommandResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommandResultValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommandResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommandResultMultiError is an error wrapping multiple validation errors
+// returned by CommandResult.ValidateAll() if the designated constraints
+// aren't met.
+type CommandResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommandResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommandResultMultiError) AllErrors() []error { return m }
+
+// CommandResultValidationError is the validation error returned by
+// CommandResult.Validate if the designated constraints aren't met.
+type CommandResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommandResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommandResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommandResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommandResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommandResultValidationError) ErrorName() string { return "CommandResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommandResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommandResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommandResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommandResultValidationError{}
+
+// Validate checks the field values on CommandBatchReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CommandBatchReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommandBatchReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommandBatchReplyMultiError, or nil if none found.
+func (m *CommandBatchReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommandBatchReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CommandBatchReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CommandBatchReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CommandBatchReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Committed
+
+	if len(errors) > 0 {
+		return CommandBatchReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommandBatchReplyMultiError is an error wrapping multiple validation errors
+// returned by CommandBatchReply.ValidateAll() if the designated constraints
+// aren't met.
+type CommandBatchReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommandBatchReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommandBatchReplyMultiError) AllErrors() []error { return m }
+
+// CommandBatchReplyValidationError is the validation error returned by
+// CommandBatchReply.Validate if the designated constraints aren't met.
+type CommandBatchReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommandBatchReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommandBatchReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommandBatchReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommandBatchReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommandBatchReplyValidationError) ErrorName() string {
+	return "CommandBatchReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommandBatchReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommandBatchReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommandBatchReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommandBatchReplyValidationError{}
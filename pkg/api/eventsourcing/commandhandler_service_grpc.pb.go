@@ -25,6 +25,12 @@ const _ = grpc.SupportPackageIsVersion7
 type CommandHandlerClient interface {
 	// Execute executes a command.
 	Execute(ctx context.Context, in *commands.Command, opts ...grpc.CallOption) (*CommandReply, error)
+	// Validate checks whether a command would be executed successfully without
+	// storing any changes and returns the events it would emit.
+	Validate(ctx context.Context, in *commands.Command, opts ...grpc.CallOption) (*ValidationReply, error)
+	// ExecuteBatch executes several commands at once. The changes of all
+	// commands are either stored all or none.
+	ExecuteBatch(ctx context.Context, in *CommandBatch, opts ...grpc.CallOption) (*CommandBatchReply, error)
 }
 
 type commandHandlerClient struct {
@@ -44,12 +50,36 @@ func (c *commandHandlerClient) Execute(ctx context.Context, in *commands.Command
 	return out, nil
 }
 
+func (c *commandHandlerClient) Validate(ctx context.Context, in *commands.Command, opts ...grpc.CallOption) (*ValidationReply, error) {
+	out := new(ValidationReply)
+	err := c.cc.Invoke(ctx, "/eventsourcing.CommandHandler/Validate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandHandlerClient) ExecuteBatch(ctx context.Context, in *CommandBatch, opts ...grpc.CallOption) (*CommandBatchReply, error) {
+	out := new(CommandBatchReply)
+	err := c.cc.Invoke(ctx, "/eventsourcing.CommandHandler/ExecuteBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommandHandlerServer is the server API for CommandHandler service.
 // All implementations must embed UnimplementedCommandHandlerServer
 // for forward compatibility
 type CommandHandlerServer interface {
 	// Execute executes a command.
 	Execute(context.Context, *commands.Command) (*CommandReply, error)
+	// Validate checks whether a command would be executed successfully without
+	// storing any changes and returns the events it would emit.
+	Validate(context.Context, *commands.Command) (*ValidationReply, error)
+	// ExecuteBatch executes several commands at once. The changes of all
+	// commands are either stored all or none.
+	ExecuteBatch(context.Context, *CommandBatch) (*CommandBatchReply, error)
 	mustEmbedUnimplementedCommandHandlerServer()
 }
 
@@ -60,6 +90,12 @@ type UnimplementedCommandHandlerServer struct {
 func (UnimplementedCommandHandlerServer) Execute(context.Context, *commands.Command) (*CommandReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
+func (UnimplementedCommandHandlerServer) Validate(context.Context, *commands.Command) (*ValidationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedCommandHandlerServer) ExecuteBatch(context.Context, *CommandBatch) (*CommandBatchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBatch not implemented")
+}
 func (UnimplementedCommandHandlerServer) mustEmbedUnimplementedCommandHandlerServer() {}
 
 // UnsafeCommandHandlerServer may be embedded to opt out of forward compatibility for this service.
@@ -91,6 +127,42 @@ func _CommandHandler_Execute_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommandHandler_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commands.Command)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandHandlerServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventsourcing.CommandHandler/Validate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandHandlerServer).Validate(ctx, req.(*commands.Command))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandHandler_ExecuteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandHandlerServer).ExecuteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventsourcing.CommandHandler/ExecuteBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandHandlerServer).ExecuteBatch(ctx, req.(*CommandBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommandHandler_ServiceDesc is the grpc.ServiceDesc for CommandHandler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -102,6 +174,14 @@ var CommandHandler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Execute",
 			Handler:    _CommandHandler_Execute_Handler,
 		},
+		{
+			MethodName: "Validate",
+			Handler:    _CommandHandler_Validate_Handler,
+		},
+		{
+			MethodName: "ExecuteBatch",
+			Handler:    _CommandHandler_ExecuteBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/eventsourcing/commandhandler_service.proto",
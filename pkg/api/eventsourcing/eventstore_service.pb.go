@@ -44,7 +44,7 @@ var file_api_eventsourcing_eventstore_service_proto_rawDesc = []byte{
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
 	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76,
 	0x65, 0x6e, 0x74, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xc8, 0x01, 0x0a, 0x0a, 0x45,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xdb, 0x02, 0x0a, 0x0a, 0x45,
 	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x53, 0x74, 0x6f,
 	0x72, 0x65, 0x12, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x69,
 	0x6e, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
@@ -57,28 +57,43 @@ var file_api_eventsourcing_eventstore_service_proto_rawDesc = []byte{
 	0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x69, 0x6e, 0x67,
 	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x14, 0x2e,
 	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6e, 0x6c, 0x65, 0x61, 0x70, 0x2d, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x73, 0x6b, 0x6f, 0x70, 0x65, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x69, 0x6e, 0x67,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6e, 0x6c, 0x65, 0x61, 0x70, 0x2d, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x73, 0x6b, 0x6f, 0x70, 0x65,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_api_eventsourcing_eventstore_service_proto_goTypes = []interface{}{
-	(*Event)(nil),         // 0: eventsourcing.Event
-	(*EventFilter)(nil),   // 1: eventsourcing.EventFilter
-	(*EventFilters)(nil),  // 2: eventsourcing.EventFilters
-	(*emptypb.Empty)(nil), // 3: google.protobuf.Empty
+	(*Event)(nil),           // 0: eventsourcing.Event
+	(*EventFilter)(nil),     // 1: eventsourcing.EventFilter
+	(*EventFilters)(nil),    // 2: eventsourcing.EventFilters
+	(*emptypb.Empty)(nil),   // 3: google.protobuf.Empty
+	(*IntegrityReport)(nil), // 4: eventsourcing.IntegrityReport
+	(*EventSchemas)(nil),    // 5: eventsourcing.EventSchemas
 }
 var file_api_eventsourcing_eventstore_service_proto_depIdxs = []int32{
 	0, // 0: eventsourcing.EventStore.Store:input_type -> eventsourcing.Event
 	1, // 1: eventsourcing.EventStore.Retrieve:input_type -> eventsourcing.EventFilter
 	2, // 2: eventsourcing.EventStore.RetrieveOr:input_type -> eventsourcing.EventFilters
-	3, // 3: eventsourcing.EventStore.Store:output_type -> google.protobuf.Empty
-	0, // 4: eventsourcing.EventStore.Retrieve:output_type -> eventsourcing.Event
-	0, // 5: eventsourcing.EventStore.RetrieveOr:output_type -> eventsourcing.Event
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	3, // 3: eventsourcing.EventStore.VerifyIntegrity:input_type -> google.protobuf.Empty
+	3, // 4: eventsourcing.EventStore.GetEventSchemas:input_type -> google.protobuf.Empty
+	3, // 5: eventsourcing.EventStore.Store:output_type -> google.protobuf.Empty
+	0, // 6: eventsourcing.EventStore.Retrieve:output_type -> eventsourcing.Event
+	0, // 7: eventsourcing.EventStore.RetrieveOr:output_type -> eventsourcing.Event
+	4, // 8: eventsourcing.EventStore.VerifyIntegrity:output_type -> eventsourcing.IntegrityReport
+	5, // 9: eventsourcing.EventStore.GetEventSchemas:output_type -> eventsourcing.EventSchemas
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -29,6 +29,12 @@ type EventStoreClient interface {
 	Retrieve(ctx context.Context, in *EventFilter, opts ...grpc.CallOption) (EventStore_RetrieveClient, error)
 	// RetrieveOr returns a stream of Events by concatenating the filters with the logical or
 	RetrieveOr(ctx context.Context, in *EventFilters, opts ...grpc.CallOption) (EventStore_RetrieveOrClient, error)
+	// VerifyIntegrity verifies the hash chain of all events and the signed
+	// checkpoints and reports the first broken link.
+	VerifyIntegrity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*IntegrityReport, error)
+	// GetEventSchemas returns the schemas of all known event types along with
+	// the descriptors required to decode their data.
+	GetEventSchemas(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EventSchemas, error)
 }
 
 type eventStoreClient struct {
@@ -137,6 +143,24 @@ func (x *eventStoreRetrieveOrClient) Recv() (*Event, error) {
 	return m, nil
 }
 
+func (c *eventStoreClient) VerifyIntegrity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*IntegrityReport, error) {
+	out := new(IntegrityReport)
+	err := c.cc.Invoke(ctx, "/eventsourcing.EventStore/VerifyIntegrity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventStoreClient) GetEventSchemas(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EventSchemas, error) {
+	out := new(EventSchemas)
+	err := c.cc.Invoke(ctx, "/eventsourcing.EventStore/GetEventSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventStoreServer is the server API for EventStore service.
 // All implementations must embed UnimplementedEventStoreServer
 // for forward compatibility
@@ -147,6 +171,12 @@ type EventStoreServer interface {
 	Retrieve(*EventFilter, EventStore_RetrieveServer) error
 	// RetrieveOr returns a stream of Events by concatenating the filters with the logical or
 	RetrieveOr(*EventFilters, EventStore_RetrieveOrServer) error
+	// VerifyIntegrity verifies the hash chain of all events and the signed
+	// checkpoints and reports the first broken link.
+	VerifyIntegrity(context.Context, *emptypb.Empty) (*IntegrityReport, error)
+	// GetEventSchemas returns the schemas of all known event types along with
+	// the descriptors required to decode their data.
+	GetEventSchemas(context.Context, *emptypb.Empty) (*EventSchemas, error)
 	mustEmbedUnimplementedEventStoreServer()
 }
 
@@ -163,6 +193,12 @@ func (UnimplementedEventStoreServer) Retrieve(*EventFilter, EventStore_RetrieveS
 func (UnimplementedEventStoreServer) RetrieveOr(*EventFilters, EventStore_RetrieveOrServer) error {
 	return status.Errorf(codes.Unimplemented, "method RetrieveOr not implemented")
 }
+func (UnimplementedEventStoreServer) VerifyIntegrity(context.Context, *emptypb.Empty) (*IntegrityReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyIntegrity not implemented")
+}
+func (UnimplementedEventStoreServer) GetEventSchemas(context.Context, *emptypb.Empty) (*EventSchemas, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventSchemas not implemented")
+}
 func (UnimplementedEventStoreServer) mustEmbedUnimplementedEventStoreServer() {}
 
 // UnsafeEventStoreServer may be embedded to opt out of forward compatibility for this service.
@@ -244,13 +280,58 @@ func (x *eventStoreRetrieveOrServer) Send(m *Event) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _EventStore_VerifyIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventStoreServer).VerifyIntegrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventsourcing.EventStore/VerifyIntegrity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventStoreServer).VerifyIntegrity(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventStore_GetEventSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventStoreServer).GetEventSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventsourcing.EventStore/GetEventSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventStoreServer).GetEventSchemas(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventStore_ServiceDesc is the grpc.ServiceDesc for EventStore service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var EventStore_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "eventsourcing.EventStore",
 	HandlerType: (*EventStoreServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyIntegrity",
+			Handler:    _EventStore_VerifyIntegrity_Handler,
+		},
+		{
+			MethodName: "GetEventSchemas",
+			Handler:    _EventStore_GetEventSchemas_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Store",
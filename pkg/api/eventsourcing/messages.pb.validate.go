@@ -446,6 +446,80 @@ func (m *EventFilter) validate(all bool) error {
 		}
 	}
 
+	if wrapper := m.GetEventType(); wrapper != nil {
+
+		if len(wrapper.GetValue()) > 60 {
+			err := EventFilterValidationError{
+				field:  "EventType",
+				reason: "value length must be at most 60 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_EventFilter_EventType_Pattern.MatchString(wrapper.GetValue()) {
+			err := EventFilterValidationError{
+				field:  "EventType",
+				reason: "value does not match regex pattern \"^[a-zA-Z][A-Za-z0-9_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if wrapper := m.GetIssuerId(); wrapper != nil {
+
+		if err := m._validateUuid(wrapper.GetValue()); err != nil {
+			err = EventFilterValidationError{
+				field:  "IssuerId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if wrapper := m.GetTenantId(); wrapper != nil {
+
+		if err := m._validateUuid(wrapper.GetValue()); err != nil {
+			err = EventFilterValidationError{
+				field:  "TenantId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if wrapper := m.GetUserId(); wrapper != nil {
+
+		if err := m._validateUuid(wrapper.GetValue()); err != nil {
+			err = EventFilterValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return EventFilterMultiError(errors)
 	}
@@ -533,6 +607,8 @@ var _ interface {
 
 var _EventFilter_AggregateType_Pattern = regexp.MustCompile("^[a-zA-Z][A-Za-z0-9_-]+$")
 
+var _EventFilter_EventType_Pattern = regexp.MustCompile("^[a-zA-Z][A-Za-z0-9_-]+$")
+
 // Validate checks the field values on EventFilters with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -665,3 +741,681 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = EventFiltersValidationError{}
+
+// Validate checks the field values on IntegrityReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IntegrityReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntegrityReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IntegrityReportMultiError, or nil if none found.
+func (m *IntegrityReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntegrityReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VerifiedEvents
+
+	// no validation rules for UnchainedEvents
+
+	// no validation rules for VerifiedCheckpoints
+
+	// no validation rules for UnverifiedCheckpoints
+
+	// no validation rules for BrokenLinks
+
+	if all {
+		switch v := interface{}(m.GetFirstBrokenLink()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IntegrityReportValidationError{
+					field:  "FirstBrokenLink",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IntegrityReportValidationError{
+					field:  "FirstBrokenLink",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFirstBrokenLink()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IntegrityReportValidationError{
+				field:  "FirstBrokenLink",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLatestCheckpoint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IntegrityReportValidationError{
+					field:  "LatestCheckpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IntegrityReportValidationError{
+					field:  "LatestCheckpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLatestCheckpoint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IntegrityReportValidationError{
+				field:  "LatestCheckpoint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return IntegrityReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntegrityReportMultiError is an error wrapping multiple validation errors
+// returned by IntegrityReport.ValidateAll() if the designated constraints
+// aren't met.
+type IntegrityReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntegrityReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntegrityReportMultiError) AllErrors() []error { return m }
+
+// IntegrityReportValidationError is the validation error returned by
+// IntegrityReport.Validate if the designated constraints aren't met.
+type IntegrityReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntegrityReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntegrityReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntegrityReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntegrityReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntegrityReportValidationError) ErrorName() string { return "IntegrityReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IntegrityReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntegrityReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntegrityReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntegrityReportValidationError{}
+
+// Validate checks the field values on BrokenLink with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BrokenLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BrokenLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BrokenLinkMultiError, or
+// nil if none found.
+func (m *BrokenLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BrokenLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for AggregateType
+
+	// no validation rules for AggregateId
+
+	// no validation rules for AggregateVersion
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BrokenLinkValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BrokenLinkValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BrokenLinkValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return BrokenLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// BrokenLinkMultiError is an error wrapping multiple validation errors
+// returned by BrokenLink.ValidateAll() if the designated constraints aren't met.
+type BrokenLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BrokenLinkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BrokenLinkMultiError) AllErrors() []error { return m }
+
+// BrokenLinkValidationError is the validation error returned by
+// BrokenLink.Validate if the designated constraints aren't met.
+type BrokenLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BrokenLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BrokenLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BrokenLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BrokenLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BrokenLinkValidationError) ErrorName() string { return "BrokenLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BrokenLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBrokenLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BrokenLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BrokenLinkValidationError{}
+
+// Validate checks the field values on Checkpoint with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Checkpoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Checkpoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckpointMultiError, or
+// nil if none found.
+func (m *Checkpoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Checkpoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckpointValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckpointValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckpointValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Aggregates
+
+	// no validation rules for Digest
+
+	// no validation rules for Signature
+
+	if len(errors) > 0 {
+		return CheckpointMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckpointMultiError is an error wrapping multiple validation errors
+// returned by Checkpoint.ValidateAll() if the designated constraints aren't met.
+type CheckpointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckpointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckpointMultiError) AllErrors() []error { return m }
+
+// CheckpointValidationError is the validation error returned by
+// Checkpoint.Validate if the designated constraints aren't met.
+type CheckpointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckpointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckpointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckpointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckpointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckpointValidationError) ErrorName() string { return "CheckpointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckpointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckpoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckpointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckpointValidationError{}
+
+// Validate checks the field values on EventSchema with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventSchema) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventSchema with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventSchemaMultiError, or
+// nil if none found.
+func (m *EventSchema) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventSchema) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for AggregateType
+
+	// no validation rules for DataType
+
+	if len(errors) > 0 {
+		return EventSchemaMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventSchemaMultiError is an error wrapping multiple validation errors
+// returned by EventSchema.ValidateAll() if the designated constraints aren't met.
+type EventSchemaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventSchemaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventSchemaMultiError) AllErrors() []error { return m }
+
+// EventSchemaValidationError is the validation error returned by
+// EventSchema.Validate if the designated constraints aren't met.
+type EventSchemaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventSchemaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventSchemaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventSchemaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventSchemaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventSchemaValidationError) ErrorName() string { return "EventSchemaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventSchemaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventSchema.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventSchemaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventSchemaValidationError{}
+
+// Validate checks the field values on EventSchemas with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventSchemas) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventSchemas with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventSchemasMultiError, or
+// nil if none found.
+func (m *EventSchemas) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventSchemas) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventSchemasValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventSchemasValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventSchemasValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EventSchemasMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventSchemasMultiError is an error wrapping multiple validation errors
+// returned by EventSchemas.ValidateAll() if the designated constraints aren't met.
+type EventSchemasMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventSchemasMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventSchemasMultiError) AllErrors() []error { return m }
+
+// EventSchemasValidationError is the validation error returned by
+// EventSchemas.Validate if the designated constraints aren't met.
+type EventSchemasValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventSchemasValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventSchemasValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventSchemasValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventSchemasValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventSchemasValidationError) ErrorName() string { return "EventSchemasValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventSchemasValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventSchemas.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventSchemasValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventSchemasValidationError{}
@@ -858,6 +858,33 @@ func (m *APITokenRequest) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) > 100 {
+			err := APITokenRequestValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_APITokenRequest_Scopes_Pattern.MatchString(item) {
+			err := APITokenRequestValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "value does not match regex pattern \"^(read|write):[a-z]+(/[A-Za-z0-9-]+)?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	switch m.User.(type) {
 
 	case *APITokenRequest_UserId:
@@ -975,6 +1002,8 @@ var _ interface {
 	ErrorName() string
 } = APITokenRequestValidationError{}
 
+var _APITokenRequest_Scopes_Pattern = regexp.MustCompile("^(read|write):[a-z]+(/[A-Za-z0-9-]+)?$")
+
 // Validate checks the field values on APITokenResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
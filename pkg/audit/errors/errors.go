@@ -33,3 +33,11 @@ var (
 	// ErrEventFormatterForEventTypeNotRegistered is when no event-formatter was registered for the event-type.
 	ErrEventFormatterForEventTypeNotRegistered = errors.New("event-formatter for event-type not registered")
 )
+
+// Export Errors
+var (
+	// ErrUnsupportedExportFormat is when the requested export format is not supported.
+	ErrUnsupportedExportFormat = errors.New("export format is not supported")
+	// ErrUnsupportedExportContent is when the requested export content is not supported.
+	ErrUnsupportedExportContent = errors.New("export content is not supported")
+)
@@ -0,0 +1,141 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	doApi "github.com/finleap-connect/monoskope/pkg/api/domain"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/audit"
+	"github.com/finleap-connect/monoskope/pkg/audit/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// AuditEventHeader is the CSV header of exported audit events
+	AuditEventHeader = []string{"timestamp", "event_type", "actor_id", "actor_name", "actor_email", "aggregate_type", "aggregate_id", "changes", "details"}
+	// UserOverviewHeader is the CSV header of exported users overviews
+	UserOverviewHeader = []string{"name", "email", "roles", "tenants", "clusters", "details"}
+)
+
+// Writer serializes audit records in an export format
+type Writer interface {
+	// WriteHeader starts the export of the given content and must be called before writing any record
+	WriteHeader(doApi.AuditLogExportRequest_ExportContent) error
+	// WriteAuditEvent writes a single audit event
+	WriteAuditEvent(*audit.AuditEvent) error
+	// WriteUserOverview writes a single users overview
+	WriteUserOverview(*audit.UserOverview) error
+}
+
+// NewWriter creates a Writer writing the given format to w
+func NewWriter(w io.Writer, format doApi.AuditLogExportRequest_ExportFormat) (Writer, error) {
+	switch format {
+	case doApi.AuditLogExportRequest_CSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case doApi.AuditLogExportRequest_JSONL:
+		return &jsonlWriter{w: w}, nil
+	}
+	return nil, errors.ErrUnsupportedExportFormat
+}
+
+// csvWriter writes records as CSV, starting with a header line
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) WriteHeader(content doApi.AuditLogExportRequest_ExportContent) error {
+	switch content {
+	case doApi.AuditLogExportRequest_EVENTS:
+		return c.write(AuditEventHeader)
+	case doApi.AuditLogExportRequest_USERS_OVERVIEW:
+		return c.write(UserOverviewHeader)
+	}
+	return errors.ErrUnsupportedExportContent
+}
+
+func (c *csvWriter) WriteAuditEvent(e *audit.AuditEvent) error {
+	return c.write([]string{
+		e.GetTimestamp().AsTime().Format(time.RFC3339Nano),
+		e.GetEventType(),
+		e.GetActor().GetId(),
+		e.GetActor().GetName(),
+		e.GetActor().GetEmail(),
+		e.GetTarget().GetAggregateType(),
+		e.GetTarget().GetAggregateId(),
+		FormatChanges(e.GetChanges()),
+		e.GetDetails(),
+	})
+}
+
+func (c *csvWriter) WriteUserOverview(o *audit.UserOverview) error {
+	return c.write([]string{
+		o.GetName(),
+		o.GetEmail(),
+		o.GetRoles(),
+		o.GetTenants(),
+		o.GetClusters(),
+		o.GetDetails(),
+	})
+}
+
+func (c *csvWriter) write(record []string) error {
+	if err := c.w.Write(record); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonlWriter writes records as JSON Lines
+type jsonlWriter struct {
+	w io.Writer
+}
+
+// WriteHeader writes nothing as JSON Lines have no header
+func (j *jsonlWriter) WriteHeader(doApi.AuditLogExportRequest_ExportContent) error {
+	return nil
+}
+
+func (j *jsonlWriter) WriteAuditEvent(e *audit.AuditEvent) error {
+	return j.write(e)
+}
+
+func (j *jsonlWriter) WriteUserOverview(o *audit.UserOverview) error {
+	return j.write(o)
+}
+
+func (j *jsonlWriter) write(m proto.Message) error {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = j.w.Write(append(data, '\n'))
+	return err
+}
+
+// FormatChanges formats field changes in a compact single line representation
+func FormatChanges(changes []*audit.FieldChange) string {
+	formatted := make([]string, 0, len(changes))
+	for _, c := range changes {
+		formatted = append(formatted, fmt.Sprintf("%s: %q -> %q", c.GetField(), c.GetOldValue(), c.GetNewValue()))
+	}
+	return strings.Join(formatted, "; ")
+}
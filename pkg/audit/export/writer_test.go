@@ -0,0 +1,111 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"time"
+
+	doApi "github.com/finleap-connect/monoskope/pkg/api/domain"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/audit"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("audit/export", func() {
+	auditEvent := &audit.AuditEvent{
+		Timestamp: timestamppb.New(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)),
+		EventType: "TenantUpdatedV2",
+		Actor:     &audit.Actor{Id: "1", Name: "admin", Email: "admin@monoskope.io"},
+		Target:    &audit.Target{AggregateType: "Tenant", AggregateId: "2"},
+		Changes:   []*audit.FieldChange{{Field: "Name", OldValue: "a", NewValue: "b"}},
+		Details:   "“admin@monoskope.io“ updated the Tenant, with \"quotes\", and\nnewlines",
+	}
+	userOverview := &audit.UserOverview{Name: "jane", Email: "jane@monoskope.io", Roles: "admin"}
+
+	It("can't create writers for unknown formats", func() {
+		_, err := NewWriter(&bytes.Buffer{}, doApi.AuditLogExportRequest_ExportFormat(42))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("writes audit events as csv", func() {
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, doApi.AuditLogExportRequest_CSV)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(w.WriteHeader(doApi.AuditLogExportRequest_EVENTS)).To(Succeed())
+		Expect(w.WriteAuditEvent(auditEvent)).To(Succeed())
+		Expect(w.WriteAuditEvent(auditEvent)).To(Succeed())
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(3))
+		Expect(records[0]).To(Equal(AuditEventHeader))
+		Expect(records[1]).To(Equal([]string{
+			"2022-01-02T03:04:05Z", "TenantUpdatedV2", "1", "admin", "admin@monoskope.io", "Tenant", "2", `Name: "a" -> "b"`, auditEvent.Details,
+		}))
+	})
+
+	It("writes users overviews as csv", func() {
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, doApi.AuditLogExportRequest_CSV)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(w.WriteHeader(doApi.AuditLogExportRequest_USERS_OVERVIEW)).To(Succeed())
+		Expect(w.WriteUserOverview(userOverview)).To(Succeed())
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(Equal([][]string{UserOverviewHeader, {"jane", "jane@monoskope.io", "admin", "", "", ""}}))
+	})
+
+	It("writes the csv header of empty exports", func() {
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, doApi.AuditLogExportRequest_CSV)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(w.WriteHeader(doApi.AuditLogExportRequest_EVENTS)).To(Succeed())
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(Equal([][]string{AuditEventHeader}))
+	})
+
+	It("can't write headers of unknown contents", func() {
+		w, err := NewWriter(&bytes.Buffer{}, doApi.AuditLogExportRequest_CSV)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(w.WriteHeader(doApi.AuditLogExportRequest_ExportContent(42))).ToNot(Succeed())
+	})
+
+	It("writes audit events as json lines", func() {
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, doApi.AuditLogExportRequest_JSONL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(w.WriteHeader(doApi.AuditLogExportRequest_EVENTS)).To(Succeed())
+		Expect(w.WriteAuditEvent(auditEvent)).To(Succeed())
+		Expect(w.WriteUserOverview(userOverview)).To(Succeed())
+
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		Expect(lines).To(HaveLen(2))
+
+		var decoded map[string]interface{}
+		Expect(json.Unmarshal([]byte(lines[0]), &decoded)).To(Succeed())
+		Expect(decoded["eventType"]).To(Equal("TenantUpdatedV2"))
+		Expect(decoded["details"]).To(Equal(auditEvent.Details))
+		Expect(json.Unmarshal([]byte(lines[1]), &decoded)).To(Succeed())
+		Expect(decoded["email"]).To(Equal("jane@monoskope.io"))
+	})
+})
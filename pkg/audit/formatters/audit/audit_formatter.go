@@ -20,15 +20,18 @@ import (
 
 	"github.com/finleap-connect/monoskope/internal/gateway/auth"
 	"github.com/finleap-connect/monoskope/pkg/api/domain/audit"
+	projectionsApi "github.com/finleap-connect/monoskope/pkg/api/domain/projections"
 	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/audit/formatters/event"
 	_ "github.com/finleap-connect/monoskope/pkg/domain/formatters/events"
 	"github.com/finleap-connect/monoskope/pkg/domain/formatters/overviews"
+	"github.com/finleap-connect/monoskope/pkg/domain/projections"
 	"github.com/finleap-connect/monoskope/pkg/domain/projectors"
 	"github.com/finleap-connect/monoskope/pkg/domain/snapshots"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/logger"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -36,6 +39,7 @@ import (
 // AuditFormatter is the interface definition for the formatter used by the auditLogServer
 type AuditFormatter interface {
 	NewHumanReadableEvent(context.Context, *esApi.Event) *audit.HumanReadableEvent
+	NewAuditEvent(context.Context, *esApi.Event) *audit.AuditEvent
 	NewUserOverview(context.Context, uuid.UUID, time.Time) *audit.UserOverview
 }
 
@@ -61,6 +65,13 @@ func (f *auditFormatter) NewHumanReadableEvent(ctx context.Context, event *esApi
 		IssuerId:  event.Metadata[auth.HeaderAuthId],
 		EventType: event.Type,
 	}
+
+	// Event formatters only handle the latest version of event types
+	event, err := es.UpcastProtoEvent(event)
+	if err != nil {
+		f.log.Error(err, "failed to upcast event", "eventType", humanReadableEvent.EventType)
+		return humanReadableEvent
+	}
 	eventFormatter, err := f.efRegistry.CreateEventFormatter(f.esClient, es.EventType(event.Type))
 	if err != nil {
 		return humanReadableEvent
@@ -76,6 +87,46 @@ func (f *auditFormatter) NewHumanReadableEvent(ctx context.Context, event *esApi
 	return humanReadableEvent
 }
 
+// NewAuditEvent creates a structured AuditEvent of a given event
+func (f *auditFormatter) NewAuditEvent(ctx context.Context, e *esApi.Event) *audit.AuditEvent {
+	auditEvent := &audit.AuditEvent{
+		Timestamp: e.Timestamp,
+		EventType: e.Type,
+		Actor: &audit.Actor{
+			Id:    e.Metadata[auth.HeaderAuthId],
+			Name:  e.Metadata[auth.HeaderAuthName],
+			Email: e.Metadata[auth.HeaderAuthEmail],
+		},
+		Target: &audit.Target{
+			AggregateType: e.AggregateType,
+			AggregateId:   e.AggregateId,
+		},
+	}
+
+	// Event formatters only handle the latest version of event types
+	e, err := es.UpcastProtoEvent(e)
+	if err != nil {
+		f.log.Error(err, "failed to upcast event", "eventType", auditEvent.EventType)
+		return auditEvent
+	}
+	eventFormatter, err := f.efRegistry.CreateEventFormatter(f.esClient, es.EventType(e.Type))
+	if err != nil {
+		return auditEvent
+	}
+
+	auditEvent.Details, err = eventFormatter.GetFormattedDetails(ctx, e)
+	if err != nil {
+		f.log.Error(err, "failed to format event details",
+			"eventAggregate", e.GetAggregateId(),
+			"eventTimestamp", e.GetTimestamp().AsTime().Format(time.RFC3339Nano))
+	}
+	if changesProvider, ok := eventFormatter.(event.FieldChangesProvider); ok {
+		auditEvent.Changes = changesProvider.FieldChanges()
+	}
+
+	return auditEvent
+}
+
 // NewUserOverview creates a UserOverview of the given user by its id according to the given timestamp
 func (f *auditFormatter) NewUserOverview(ctx context.Context, userId uuid.UUID, timestamp time.Time) *audit.UserOverview {
 	userOverview := &audit.UserOverview{}
@@ -83,7 +134,7 @@ func (f *auditFormatter) NewUserOverview(ctx context.Context, userId uuid.UUID,
 	userSnapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewUserProjector())
 	userRoleBindingSnapshotter := snapshots.NewUserRoleBindingSnapshotter(f.esClient)
 
-	user, err := userSnapshotter.CreateSnapshot(ctx, &esApi.EventFilter{
+	userSnapshot, err := userSnapshotter.CreateSnapshot(ctx, &esApi.EventFilter{
 		MaxTimestamp: timestamppb.New(timestamp),
 		AggregateId:  wrapperspb.String(userId.String()),
 	})
@@ -91,6 +142,11 @@ func (f *auditFormatter) NewUserOverview(ctx context.Context, userId uuid.UUID,
 		f.log.Error(err, "failed to create user snapshot", "userId", userId, "timeStamp", timestamp)
 		return userOverview
 	}
+	// snapshots may be shared by a cache, the roles are added to a copy
+	user := &projections.User{
+		DomainProjection: userSnapshot.DomainProjection,
+		User:             proto.Clone(userSnapshot.User).(*projectionsApi.User),
+	}
 	for _, role := range userRoleBindingSnapshotter.CreateAllSnapshots(ctx, userId, timestamp) {
 		user.Roles = append(user.Roles, role.Proto())
 	}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/finleap-connect/monoskope/pkg/api/domain/audit"
 	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
 )
 
@@ -28,13 +29,27 @@ type EventFormatter interface {
 	GetFormattedDetails(context.Context, *esApi.Event) (string, error)
 }
 
+// FieldChangesProvider is implemented by event formatters which keep track of
+// the field changes of the last formatted event
+type FieldChangesProvider interface {
+	// FieldChanges returns the field changes of the formatted event
+	FieldChanges() []*audit.FieldChange
+}
+
 // EventFormatterBase is the base implementation for all event formatters
 type EventFormatterBase struct {
+	changes []*audit.FieldChange
+}
+
+// FieldChanges returns the changes recorded by AppendUpdate
+func (f *EventFormatterBase) FieldChanges() []*audit.FieldChange {
+	return f.changes
 }
 
 // AppendUpdate appends updates to a string builder in human-readable format
 func (f *EventFormatterBase) AppendUpdate(field string, update string, old string, strBuilder *strings.Builder) {
 	if update != "" {
+		f.changes = append(f.changes, &audit.FieldChange{Field: field, OldValue: old, NewValue: update})
 		strBuilder.WriteString(fmt.Sprintf("\n- “%s“ to “%s“", field, update))
 		if old != "" {
 			strBuilder.WriteString(fmt.Sprintf(" from “%s“", old))
@@ -0,0 +1,37 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("event_formatter", func() {
+	It("records the field changes of appended updates", func() {
+		var details strings.Builder
+		base := &EventFormatterBase{}
+		base.AppendUpdate("Name", "new", "old", &details)
+		base.AppendUpdate("Prefix", "", "old", &details)
+
+		Expect(details.String()).To(ContainSubstring("new"))
+		Expect(base.FieldChanges()).To(HaveLen(1))
+		Expect(base.FieldChanges()[0].Field).To(Equal("Name"))
+		Expect(base.FieldChanges()[0].OldValue).To(Equal("old"))
+		Expect(base.FieldChanges()[0].NewValue).To(Equal("new"))
+	})
+})
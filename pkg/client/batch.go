@@ -0,0 +1,54 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// BatchCommand is a command executed as part of a batch by ExecuteBatch.
+type BatchCommand struct {
+	// AggregateId is the id of the aggregate the command is for. Commands
+	// creating aggregates may provide a new id so that later commands of the
+	// batch can refer to the created aggregate.
+	AggregateId uuid.UUID
+	// CommandType is the type of the command.
+	CommandType es.CommandType
+	// Data is the data of the command.
+	Data proto.Message
+}
+
+// BatchError is returned by ExecuteBatch if a command of the batch failed.
+// None of the commands of the batch has been executed then.
+type BatchError struct {
+	// Index is the position of the failed command in the batch.
+	Index int
+	// Err is the error the command failed with.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("command %d of batch failed: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error the command failed with.
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
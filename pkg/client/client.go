@@ -0,0 +1,122 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client provides a typed Go SDK for the APIs of Monoskope.
+//
+// It executes commands without assembling them by hand, streams the results of
+// queries as iterators and translates errors to the errors of
+// github.com/finleap-connect/monoskope/pkg/domain/errors so they can be
+// compared with errors.Is. A fake implementation for tests of consumers is
+// provided by the fake subpackage.
+package client
+
+import (
+	"context"
+
+	cmdData "github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/projections"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// Client is the API of Monoskope.
+type Client interface {
+	// Execute executes a command of any type with the given data and returns
+	// the id of the aggregate. Use uuid.Nil as id to create aggregates.
+	Execute(ctx context.Context, aggregateId uuid.UUID, commandType es.CommandType, data proto.Message) (uuid.UUID, error)
+	// Validate checks whether the command would be executed successfully
+	// without executing it and returns the events it would emit. The error
+	// is the one executing the command would fail with.
+	Validate(ctx context.Context, aggregateId uuid.UUID, commandType es.CommandType, data proto.Message) ([]es.Event, error)
+	// ExecuteBatch executes all commands at once and returns the ids of their
+	// aggregates. Either all commands are executed or none, in which case the
+	// error is a *BatchError if a command failed. With dryRun the commands are
+	// only validated.
+	ExecuteBatch(ctx context.Context, cmds []BatchCommand, dryRun bool) ([]uuid.UUID, error)
+
+	// CreateUser creates a user and returns its id.
+	CreateUser(ctx context.Context, email, name string) (uuid.UUID, error)
+	// UpdateUser renames the user with the given id.
+	UpdateUser(ctx context.Context, userId uuid.UUID, name string) error
+	// DeleteUser deletes the user with the given id.
+	DeleteUser(ctx context.Context, userId uuid.UUID) error
+	// GrantRole binds the role to the user within the given scope and
+	// returns the id of the role binding. The resource is the id of the
+	// tenant or cluster for roles of these scopes and empty otherwise.
+	GrantRole(ctx context.Context, userId uuid.UUID, role, scope, resource string) (uuid.UUID, error)
+	// RevokeRole deletes the role binding with the given id.
+	RevokeRole(ctx context.Context, roleBindingId uuid.UUID) error
+	// GetUser returns the user with the given id.
+	GetUser(ctx context.Context, userId uuid.UUID) (*projections.User, error)
+	// GetUserByEmail returns the user with the given email address.
+	GetUserByEmail(ctx context.Context, email string) (*projections.User, error)
+	// Users iterates all users.
+	Users(ctx context.Context, includeDeleted bool) Iterator[*projections.User]
+	// RoleBindings iterates the role bindings of the user with the given id.
+	RoleBindings(ctx context.Context, userId uuid.UUID) Iterator[*projections.UserRoleBinding]
+
+	// CreateTenant creates a tenant and returns its id.
+	CreateTenant(ctx context.Context, data *cmdData.CreateTenantCommandData) (uuid.UUID, error)
+	// UpdateTenant updates the tenant with the given id.
+	UpdateTenant(ctx context.Context, tenantId uuid.UUID, data *cmdData.UpdateTenantCommandData) error
+	// DeleteTenant deletes the tenant with the given id.
+	DeleteTenant(ctx context.Context, tenantId uuid.UUID) error
+	// GetTenant returns the tenant with the given id.
+	GetTenant(ctx context.Context, tenantId uuid.UUID) (*projections.Tenant, error)
+	// GetTenantByName returns the tenant with the given name.
+	GetTenantByName(ctx context.Context, name string) (*projections.Tenant, error)
+	// Tenants iterates all tenants.
+	Tenants(ctx context.Context, includeDeleted bool) Iterator[*projections.Tenant]
+	// TenantUsers iterates the users of the tenant with the given id.
+	TenantUsers(ctx context.Context, tenantId uuid.UUID) Iterator[*projections.TenantUser]
+
+	// CreateCluster creates a cluster and returns its id.
+	CreateCluster(ctx context.Context, data *cmdData.CreateCluster) (uuid.UUID, error)
+	// UpdateCluster updates the cluster with the given id.
+	UpdateCluster(ctx context.Context, clusterId uuid.UUID, data *cmdData.UpdateCluster) error
+	// DeleteCluster deletes the cluster with the given id.
+	DeleteCluster(ctx context.Context, clusterId uuid.UUID) error
+	// GetCluster returns the cluster with the given id.
+	GetCluster(ctx context.Context, clusterId uuid.UUID) (*projections.Cluster, error)
+	// GetClusterByName returns the cluster with the given name.
+	GetClusterByName(ctx context.Context, name string) (*projections.Cluster, error)
+	// Clusters iterates all clusters matching the label selector, all if empty.
+	Clusters(ctx context.Context, includeDeleted bool, labelSelector string) Iterator[*projections.Cluster]
+
+	// BindTenantToCluster gives the tenant access to the cluster and returns
+	// the id of the binding.
+	BindTenantToCluster(ctx context.Context, tenantId, clusterId uuid.UUID) (uuid.UUID, error)
+	// BindTenantToClusters gives the tenant access to all clusters matching
+	// the label selector and returns the id of the binding.
+	BindTenantToClusters(ctx context.Context, tenantId uuid.UUID, clusterSelector string) (uuid.UUID, error)
+	// UnbindTenantFromCluster deletes the binding with the given id.
+	UnbindTenantFromCluster(ctx context.Context, bindingId uuid.UUID) error
+	// TenantClusterBindings iterates the bindings of the tenant with the given id.
+	TenantClusterBindings(ctx context.Context, tenantId uuid.UUID) Iterator[*projections.TenantClusterBinding]
+
+	// CreateRole defines a role and returns its id.
+	CreateRole(ctx context.Context, data *cmdData.CreateRoleCommandData) (uuid.UUID, error)
+	// UpdateRole updates the role with the given id.
+	UpdateRole(ctx context.Context, roleId uuid.UUID, data *cmdData.UpdateRoleCommandData) error
+	// DeleteRole deletes the role with the given id.
+	DeleteRole(ctx context.Context, roleId uuid.UUID) error
+	// GetRole returns the role with the given name.
+	GetRole(ctx context.Context, name string) (*projections.Role, error)
+	// Roles iterates all roles defined in addition to the built-in roles.
+	Roles(ctx context.Context, includeDeleted bool) Iterator[*projections.Role]
+
+	// Close closes the connection to Monoskope.
+	Close() error
+}
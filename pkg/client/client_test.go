@@ -0,0 +1,264 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	goerrors "errors"
+	"net"
+
+	domainApi "github.com/finleap-connect/monoskope/pkg/api/domain"
+	cmdData "github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/projections"
+	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/api/eventsourcing/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	commandTypes "github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type testCommandHandler struct {
+	esApi.UnimplementedCommandHandlerServer
+	commands      []*commands.Command
+	authorization []string
+	err           error
+}
+
+func (s *testCommandHandler) Execute(ctx context.Context, command *commands.Command) (*esApi.CommandReply, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		s.authorization = md.Get("authorization")
+	}
+	if s.err != nil {
+		return nil, errors.TranslateToGrpcError(s.err)
+	}
+	s.commands = append(s.commands, command)
+	aggregateId := command.Id
+	if aggregateId == uuid.Nil.String() {
+		aggregateId = uuid.New().String()
+	}
+	return &esApi.CommandReply{AggregateId: aggregateId}, nil
+}
+
+func (s *testCommandHandler) Validate(ctx context.Context, command *commands.Command) (*esApi.ValidationReply, error) {
+	if s.err != nil {
+		return nil, errors.TranslateToGrpcError(s.err)
+	}
+	event := &esApi.Event{
+		Type:          string(events.TenantCreated),
+		Timestamp:     timestamppb.Now(),
+		AggregateId:   command.Id,
+		AggregateType: string(aggregates.Tenant),
+	}
+	return &esApi.ValidationReply{AggregateId: command.Id, Version: 1, Events: []*esApi.Event{event}}, nil
+}
+
+func (s *testCommandHandler) ExecuteBatch(ctx context.Context, batch *esApi.CommandBatch) (*esApi.CommandBatchReply, error) {
+	reply := &esApi.CommandBatchReply{Committed: s.err == nil && !batch.DryRun}
+	for i, command := range batch.Commands {
+		result := &esApi.CommandResult{AggregateId: command.Id}
+		if s.err != nil && i == len(batch.Commands)-1 {
+			result = &esApi.CommandResult{Error: status.Convert(errors.TranslateToGrpcError(s.err)).Proto()}
+		}
+		reply.Results = append(reply.Results, result)
+	}
+	if reply.Committed {
+		s.commands = append(s.commands, batch.Commands...)
+	}
+	return reply, nil
+}
+
+type testTenantServer struct {
+	domainApi.UnimplementedTenantServer
+	tenants []*projections.Tenant
+}
+
+func (s *testTenantServer) GetAll(request *domainApi.GetAllRequest, stream domainApi.Tenant_GetAllServer) error {
+	for _, tenant := range s.tenants {
+		if err := stream.Send(tenant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *testTenantServer) GetByName(ctx context.Context, name *wrapperspb.StringValue) (*projections.Tenant, error) {
+	for _, tenant := range s.tenants {
+		if tenant.Name == name.Value {
+			return tenant, nil
+		}
+	}
+	return nil, errors.TranslateToGrpcError(errors.ErrTenantNotFound)
+}
+
+var _ = Describe("pkg/client", func() {
+	ctx := context.Background()
+
+	var (
+		server         *grpc.Server
+		commandHandler *testCommandHandler
+		tenantServer   *testTenantServer
+		c              Client
+	)
+
+	BeforeEach(func() {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+
+		commandHandler = &testCommandHandler{}
+		tenantServer = &testTenantServer{tenants: []*projections.Tenant{
+			{Id: uuid.New().String(), Name: "tenant-a"},
+			{Id: uuid.New().String(), Name: "tenant-b"},
+		}}
+		server = grpc.NewServer()
+		esApi.RegisterCommandHandlerServer(server, commandHandler)
+		domainApi.RegisterTenantServer(server, tenantServer)
+		go func() {
+			defer GinkgoRecover()
+			Expect(server.Serve(lis)).To(Succeed())
+		}()
+
+		c, err = New(ctx, lis.Addr().String(), WithInsecure(), WithToken("some-token"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(c.Close()).To(Succeed())
+		server.Stop()
+	})
+
+	It("executes typed commands", func() {
+		tenantId, err := c.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "tenant-c", Prefix: "tc"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tenantId).ToNot(Equal(uuid.Nil))
+
+		clusterId := uuid.New()
+		_, err = c.BindTenantToCluster(ctx, tenantId, clusterId)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(commandHandler.commands).To(HaveLen(2))
+		Expect(commandHandler.commands[0].Type).To(Equal(string(commandTypes.CreateTenant)))
+		Expect(commandHandler.commands[0].Id).To(Equal(uuid.Nil.String()))
+
+		data := &cmdData.CreateTenantClusterBindingCommandData{}
+		Expect(commandHandler.commands[1].Type).To(Equal(string(commandTypes.CreateTenantClusterBinding)))
+		Expect(commandHandler.commands[1].Data.UnmarshalTo(data)).To(Succeed())
+		Expect(data.TenantId).To(Equal(tenantId.String()))
+		Expect(data.ClusterId).To(Equal(clusterId.String()))
+	})
+	It("validates commands", func() {
+		tenantId := uuid.New()
+		events, err := c.Validate(ctx, tenantId, commandTypes.UpdateTenant, &cmdData.UpdateTenantCommandData{Name: wrapperspb.String("tenant-c")})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].AggregateID()).To(Equal(tenantId))
+		Expect(commandHandler.commands).To(BeEmpty())
+
+		commandHandler.err = errors.ErrTenantNotFound
+		_, err = c.Validate(ctx, tenantId, commandTypes.UpdateTenant, &cmdData.UpdateTenantCommandData{Name: wrapperspb.String("tenant-c")})
+		Expect(err).To(Equal(errors.ErrTenantNotFound))
+	})
+	It("executes batches of commands", func() {
+		tenantId := uuid.New()
+		ids, err := c.ExecuteBatch(ctx, []BatchCommand{
+			{AggregateId: tenantId, CommandType: commandTypes.CreateTenant, Data: &cmdData.CreateTenantCommandData{Name: "tenant-c", Prefix: "tc"}},
+			{AggregateId: uuid.New(), CommandType: commandTypes.CreateTenantClusterBinding, Data: &cmdData.CreateTenantClusterBindingCommandData{TenantId: tenantId.String(), ClusterId: uuid.New().String()}},
+		}, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ids).To(HaveLen(2))
+		Expect(ids[0]).To(Equal(tenantId))
+		Expect(commandHandler.commands).To(HaveLen(2))
+	})
+	It("translates errors of batches", func() {
+		commandHandler.err = errors.ErrTenantAlreadyExists
+		_, err := c.ExecuteBatch(ctx, []BatchCommand{
+			{AggregateId: uuid.New(), CommandType: commandTypes.CreateTenant, Data: &cmdData.CreateTenantCommandData{Name: "tenant-a", Prefix: "ta"}},
+		}, false)
+		var batchErr *BatchError
+		Expect(goerrors.As(err, &batchErr)).To(BeTrue())
+		Expect(batchErr.Index).To(Equal(0))
+		Expect(goerrors.Is(err, errors.ErrTenantAlreadyExists)).To(BeTrue())
+		Expect(commandHandler.commands).To(BeEmpty())
+	})
+	It("authenticates with the token", func() {
+		_, err := c.CreateUser(ctx, "jane.doe@monoskope.io", "jane.doe")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(commandHandler.authorization).To(ConsistOf("Bearer some-token"))
+	})
+	It("translates errors of commands", func() {
+		commandHandler.err = errors.ErrTenantAlreadyExists
+		_, err := c.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "tenant-a", Prefix: "ta"})
+		Expect(err).To(Equal(errors.ErrTenantAlreadyExists))
+	})
+	It("translates errors of queries", func() {
+		_, err := c.GetTenantByName(ctx, "unknown")
+		Expect(err).To(Equal(errors.ErrTenantNotFound))
+	})
+	It("iterates query results", func() {
+		tenants, err := Collect(c.Tenants(ctx, false))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tenants).To(HaveLen(2))
+		Expect(tenants[0].Name).To(Equal("tenant-a"))
+		Expect(tenants[1].Name).To(Equal("tenant-b"))
+	})
+	It("closes iterators early", func() {
+		it := c.Tenants(ctx, false)
+		tenant, err := it.Next()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tenant.Name).To(Equal("tenant-a"))
+		it.Close()
+
+		_, err = it.Next()
+		Expect(err).To(HaveOccurred())
+	})
+	It("reuses tokens until they expire", func() {
+		calls := 0
+		ts := tokenSourceFunc(func() (*oauth2.Token, error) {
+			calls++
+			return &oauth2.Token{AccessToken: "refreshed-token"}, nil
+		})
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		go func() {
+			defer GinkgoRecover()
+			Expect(server.Serve(lis)).To(Succeed())
+		}()
+
+		other, err := New(ctx, lis.Addr().String(), WithInsecure(), WithTokenSource(ts))
+		Expect(err).ToNot(HaveOccurred())
+		defer other.Close()
+
+		Expect(other.DeleteUser(ctx, uuid.New())).To(Succeed())
+		Expect(other.DeleteUser(ctx, uuid.New())).To(Succeed())
+		Expect(calls).To(Equal(1))
+		Expect(commandHandler.authorization).To(ConsistOf("Bearer refreshed-token"))
+	})
+})
+
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) {
+	return f()
+}
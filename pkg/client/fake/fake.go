@@ -0,0 +1,680 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fake provides an in-memory implementation of client.Client to be
+// used in tests of code built on top of the SDK.
+package fake
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	cmdData "github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/projections"
+	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing/commands"
+	"github.com/finleap-connect/monoskope/pkg/client"
+	"github.com/finleap-connect/monoskope/pkg/domain/commands"
+	commandTypes "github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	"github.com/finleap-connect/monoskope/pkg/domain/repositories"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Client is an in-memory fake of client.Client. The typed methods keep a
+// consistent state and fail with the same errors Monoskope returns for the
+// common cases, e.g. errors.ErrTenantAlreadyExists. Authorization is not
+// checked.
+type Client struct {
+	mu           sync.Mutex
+	commands     []*esApi.Command
+	users        map[uuid.UUID]*projections.User
+	roleBindings map[uuid.UUID]*projections.UserRoleBinding
+	tenants      map[uuid.UUID]*projections.Tenant
+	clusters     map[uuid.UUID]*projections.Cluster
+	bindings     map[uuid.UUID]*projections.TenantClusterBinding
+	roles        map[uuid.UUID]*projections.Role
+}
+
+var _ client.Client = &Client{}
+
+// NewClient creates an empty fake.
+func NewClient() *Client {
+	return &Client{
+		users:        make(map[uuid.UUID]*projections.User),
+		roleBindings: make(map[uuid.UUID]*projections.UserRoleBinding),
+		tenants:      make(map[uuid.UUID]*projections.Tenant),
+		clusters:     make(map[uuid.UUID]*projections.Cluster),
+		bindings:     make(map[uuid.UUID]*projections.TenantClusterBinding),
+		roles:        make(map[uuid.UUID]*projections.Role),
+	}
+}
+
+// Commands returns all commands executed so far in the order they were executed.
+func (c *Client) Commands() []*esApi.Command {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*esApi.Command{}, c.commands...)
+}
+
+// Execute only records the command, it does not change the state of the fake.
+// The id of the aggregate is returned, a new one if it is nil.
+func (c *Client) Execute(ctx context.Context, aggregateId uuid.UUID, commandType es.CommandType, data proto.Message) (uuid.UUID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.record(aggregateId, commandType, data); err != nil {
+		return uuid.Nil, err
+	}
+	if aggregateId == uuid.Nil {
+		aggregateId = uuid.New()
+	}
+	return aggregateId, nil
+}
+
+// Validate does not record the command and returns no events, the typed
+// methods of the fake are used to check errors of commands.
+func (c *Client) Validate(ctx context.Context, aggregateId uuid.UUID, commandType es.CommandType, data proto.Message) ([]es.Event, error) {
+	return nil, nil
+}
+
+// ExecuteBatch only records the commands, it does not change the state of the
+// fake. The ids of the aggregates are returned, new ones for nil ids.
+func (c *Client) ExecuteBatch(ctx context.Context, cmds []client.BatchCommand, dryRun bool) ([]uuid.UUID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ids := make([]uuid.UUID, 0, len(cmds))
+	for _, cmd := range cmds {
+		if err := c.record(cmd.AggregateId, cmd.CommandType, cmd.Data); err != nil {
+			return nil, err
+		}
+		id := cmd.AggregateId
+		if id == uuid.Nil {
+			id = uuid.New()
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Close is a no-op.
+func (c *Client) Close() error {
+	return nil
+}
+
+// record appends the command the real client would have sent.
+func (c *Client) record(aggregateId uuid.UUID, commandType es.CommandType, data proto.Message) error {
+	command := commands.NewCommand(aggregateId, commandType)
+	if data != nil {
+		anyData, err := commands.CreateCommandData(data)
+		if err != nil {
+			return err
+		}
+		command.Data = anyData
+	}
+	c.commands = append(c.commands, command)
+	return nil
+}
+
+type projection interface {
+	proto.Message
+	GetMetadata() *projections.LifecycleMetadata
+}
+
+func newMetadata() *projections.LifecycleMetadata {
+	now := timestamppb.Now()
+	return &projections.LifecycleMetadata{Created: now, LastModified: now}
+}
+
+func isDeleted(m projection) bool {
+	return m.GetMetadata().GetDeleted() != nil
+}
+
+func markModified(metadata *projections.LifecycleMetadata) {
+	metadata.LastModified = timestamppb.Now()
+}
+
+func markDeleted(metadata *projections.LifecycleMetadata) {
+	metadata.Deleted = timestamppb.Now()
+	metadata.LastModified = metadata.Deleted
+}
+
+// get returns the projection with the given id if it exists and is not deleted.
+func get[T projection](items map[uuid.UUID]T, id uuid.UUID, errNotFound error) (T, error) {
+	item, ok := items[id]
+	if !ok {
+		var empty T
+		return empty, errNotFound
+	}
+	if isDeleted(item) {
+		var empty T
+		return empty, errors.ErrDeleted
+	}
+	return item, nil
+}
+
+// find returns the first projection accepted by the filter which is not deleted.
+func find[T projection](items map[uuid.UUID]T, filter func(T) bool) (T, bool) {
+	for _, item := range items {
+		if !isDeleted(item) && filter(item) {
+			return item, true
+		}
+	}
+	var empty T
+	return empty, false
+}
+
+// iterate returns an iterator over copies of the projections accepted by the
+// filter, ordered by creation.
+func iterate[T projection](items map[uuid.UUID]T, includeDeleted bool, filter func(T) bool) client.Iterator[T] {
+	var result []T
+	for _, item := range items {
+		if (includeDeleted || !isDeleted(item)) && (filter == nil || filter(item)) {
+			result = append(result, proto.Clone(item).(T))
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].GetMetadata().GetCreated().AsTime().Before(result[j].GetMetadata().GetCreated().AsTime())
+	})
+	return client.NewSliceIterator(result)
+}
+
+func (c *Client) CreateUser(ctx context.Context, email, name string) (uuid.UUID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := find(c.users, func(u *projections.User) bool { return u.Email == email }); ok {
+		return uuid.Nil, errors.ErrUserAlreadyExists
+	}
+	id := uuid.New()
+	c.users[id] = &projections.User{Id: id.String(), Email: email, Name: name, Metadata: newMetadata()}
+	return id, c.record(uuid.Nil, commandTypes.CreateUser, &cmdData.CreateUserCommandData{Email: email, Name: name})
+}
+
+func (c *Client) UpdateUser(ctx context.Context, userId uuid.UUID, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	user, err := get(c.users, userId, errors.ErrUserNotFound)
+	if err != nil {
+		return err
+	}
+	user.Name = name
+	markModified(user.Metadata)
+	return c.record(userId, commandTypes.UpdateUser, &cmdData.UpdateUserCommandData{Name: wrapperspb.String(name)})
+}
+
+func (c *Client) DeleteUser(ctx context.Context, userId uuid.UUID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	user, err := get(c.users, userId, errors.ErrUserNotFound)
+	if err != nil {
+		return err
+	}
+	markDeleted(user.Metadata)
+	for _, binding := range c.roleBindings {
+		if binding.UserId == user.Id && !isDeleted(binding) {
+			markDeleted(binding.Metadata)
+		}
+	}
+	return c.record(userId, commandTypes.DeleteUser, nil)
+}
+
+func (c *Client) GrantRole(ctx context.Context, userId uuid.UUID, role, scope, resource string) (uuid.UUID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := get(c.users, userId, errors.ErrUserNotFound); err != nil {
+		return uuid.Nil, err
+	}
+	if _, ok := find(c.roleBindings, func(b *projections.UserRoleBinding) bool {
+		return b.UserId == userId.String() && b.Role == role && b.Scope == scope && b.Resource == resource
+	}); ok {
+		return uuid.Nil, errors.ErrUserRoleBindingAlreadyExists
+	}
+
+	data := &cmdData.CreateUserRoleBindingCommandData{UserId: userId.String(), Role: role, Scope: scope}
+	if resource != "" {
+		data.Resource = wrapperspb.String(resource)
+	}
+	id := uuid.New()
+	c.roleBindings[id] = &projections.UserRoleBinding{
+		Id:       id.String(),
+		UserId:   userId.String(),
+		Role:     role,
+		Scope:    scope,
+		Resource: resource,
+		Metadata: newMetadata(),
+	}
+	return id, c.record(uuid.Nil, commandTypes.CreateUserRoleBinding, data)
+}
+
+func (c *Client) RevokeRole(ctx context.Context, roleBindingId uuid.UUID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	binding, err := get(c.roleBindings, roleBindingId, errors.ErrUserRoleBindingNotFound)
+	if err != nil {
+		return err
+	}
+	markDeleted(binding.Metadata)
+	return c.record(roleBindingId, commandTypes.DeleteUserRoleBinding, nil)
+}
+
+// withRoles returns a copy of the user including its role bindings.
+func (c *Client) withRoles(user *projections.User) *projections.User {
+	user = proto.Clone(user).(*projections.User)
+	for _, binding := range c.roleBindings {
+		if binding.UserId == user.Id && !isDeleted(binding) {
+			user.Roles = append(user.Roles, proto.Clone(binding).(*projections.UserRoleBinding))
+		}
+	}
+	return user
+}
+
+func (c *Client) GetUser(ctx context.Context, userId uuid.UUID) (*projections.User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	user, ok := c.users[userId]
+	if !ok {
+		return nil, errors.ErrUserNotFound
+	}
+	return c.withRoles(user), nil
+}
+
+func (c *Client) GetUserByEmail(ctx context.Context, email string) (*projections.User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	user, ok := find(c.users, func(u *projections.User) bool { return u.Email == email })
+	if !ok {
+		return nil, errors.ErrUserNotFound
+	}
+	return c.withRoles(user), nil
+}
+
+func (c *Client) Users(ctx context.Context, includeDeleted bool) client.Iterator[*projections.User] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return iterate(c.users, includeDeleted, nil)
+}
+
+func (c *Client) RoleBindings(ctx context.Context, userId uuid.UUID) client.Iterator[*projections.UserRoleBinding] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return iterate(c.roleBindings, false, func(b *projections.UserRoleBinding) bool { return b.UserId == userId.String() })
+}
+
+func (c *Client) CreateTenant(ctx context.Context, data *cmdData.CreateTenantCommandData) (uuid.UUID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := find(c.tenants, func(t *projections.Tenant) bool { return t.Name == data.Name }); ok {
+		return uuid.Nil, errors.ErrTenantAlreadyExists
+	}
+	if data.ParentId != "" {
+		parentId, err := uuid.Parse(data.ParentId)
+		if err != nil {
+			return uuid.Nil, errors.ErrInvalidArgument(err.Error())
+		}
+		if _, err := get(c.tenants, parentId, errors.ErrTenantNotFound); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	id := uuid.New()
+	c.tenants[id] = &projections.Tenant{
+		Id:                 id.String(),
+		Name:               data.Name,
+		Prefix:             data.Prefix,
+		ParentId:           data.ParentId,
+		CostCentre:         data.CostCentre,
+		Contact:            data.Contact,
+		MaxClusterBindings: data.MaxClusterBindings,
+		Metadata:           newMetadata(),
+	}
+	return id, c.record(uuid.Nil, commandTypes.CreateTenant, data)
+}
+
+func (c *Client) UpdateTenant(ctx context.Context, tenantId uuid.UUID, data *cmdData.UpdateTenantCommandData) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tenant, err := get(c.tenants, tenantId, errors.ErrTenantNotFound)
+	if err != nil {
+		return err
+	}
+	if data.Name != nil {
+		tenant.Name = data.Name.Value
+	}
+	if data.ParentId != nil {
+		tenant.ParentId = data.ParentId.Value
+	}
+	if data.CostCentre != nil {
+		tenant.CostCentre = data.CostCentre.Value
+	}
+	if data.Contact != nil {
+		tenant.Contact = data.Contact.Value
+	}
+	if data.MaxClusterBindings != nil {
+		tenant.MaxClusterBindings = data.MaxClusterBindings.Value
+	}
+	markModified(tenant.Metadata)
+	return c.record(tenantId, commandTypes.UpdateTenant, data)
+}
+
+func (c *Client) DeleteTenant(ctx context.Context, tenantId uuid.UUID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tenant, err := get(c.tenants, tenantId, errors.ErrTenantNotFound)
+	if err != nil {
+		return err
+	}
+	if _, ok := find(c.tenants, func(t *projections.Tenant) bool { return t.ParentId == tenant.Id }); ok {
+		return errors.ErrTenantHasChildren
+	}
+	markDeleted(tenant.Metadata)
+	return c.record(tenantId, commandTypes.DeleteTenant, nil)
+}
+
+func (c *Client) GetTenant(ctx context.Context, tenantId uuid.UUID) (*projections.Tenant, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tenant, ok := c.tenants[tenantId]
+	if !ok {
+		return nil, errors.ErrTenantNotFound
+	}
+	return proto.Clone(tenant).(*projections.Tenant), nil
+}
+
+func (c *Client) GetTenantByName(ctx context.Context, name string) (*projections.Tenant, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tenant, ok := find(c.tenants, func(t *projections.Tenant) bool { return t.Name == name })
+	if !ok {
+		return nil, errors.ErrTenantNotFound
+	}
+	return proto.Clone(tenant).(*projections.Tenant), nil
+}
+
+func (c *Client) Tenants(ctx context.Context, includeDeleted bool) client.Iterator[*projections.Tenant] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return iterate(c.tenants, includeDeleted, nil)
+}
+
+func (c *Client) TenantUsers(ctx context.Context, tenantId uuid.UUID) client.Iterator[*projections.TenantUser] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tenantUsers := make(map[uuid.UUID]*projections.TenantUser)
+	for _, binding := range c.roleBindings {
+		if isDeleted(binding) || binding.Scope != string(scopes.Tenant) || binding.Resource != tenantId.String() {
+			continue
+		}
+		userId := uuid.MustParse(binding.UserId)
+		user, ok := c.users[userId]
+		if !ok || isDeleted(user) {
+			continue
+		}
+		tenantUser, ok := tenantUsers[userId]
+		if !ok {
+			tenantUser = &projections.TenantUser{
+				Id:       user.Id,
+				Name:     user.Name,
+				Email:    user.Email,
+				TenantId: tenantId.String(),
+				Metadata: proto.Clone(user.Metadata).(*projections.LifecycleMetadata),
+			}
+			tenantUsers[userId] = tenantUser
+		}
+		tenantUser.TenantRoles = append(tenantUser.TenantRoles, binding.Role)
+	}
+	return iterate(tenantUsers, false, nil)
+}
+
+func (c *Client) CreateCluster(ctx context.Context, data *cmdData.CreateCluster) (uuid.UUID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := find(c.clusters, func(cl *projections.Cluster) bool { return cl.Name == data.Name }); ok {
+		return uuid.Nil, errors.ErrClusterAlreadyExists
+	}
+
+	id := uuid.New()
+	c.clusters[id] = &projections.Cluster{
+		Id:               id.String(),
+		Name:             data.Name,
+		ApiServerAddress: data.ApiServerAddress,
+		CaCertBundle:     data.CaCertBundle,
+		Description:      data.Description,
+		Labels:           data.GetLabels().GetValues(),
+		Annotations:      data.GetAnnotations().GetValues(),
+		Metadata:         newMetadata(),
+	}
+	return id, c.record(uuid.Nil, commandTypes.CreateCluster, data)
+}
+
+func (c *Client) UpdateCluster(ctx context.Context, clusterId uuid.UUID, data *cmdData.UpdateCluster) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cluster, err := get(c.clusters, clusterId, errors.ErrClusterNotFound)
+	if err != nil {
+		return err
+	}
+	if data.Name != nil {
+		cluster.Name = data.Name.Value
+	}
+	if data.ApiServerAddress != nil {
+		cluster.ApiServerAddress = data.ApiServerAddress.Value
+	}
+	if len(data.CaCertBundle) > 0 {
+		cluster.CaCertBundle = data.CaCertBundle
+	}
+	if data.Description != nil {
+		cluster.Description = data.Description.Value
+	}
+	if data.Labels != nil {
+		cluster.Labels = data.Labels.Values
+	}
+	if data.Annotations != nil {
+		cluster.Annotations = data.Annotations.Values
+	}
+	markModified(cluster.Metadata)
+	return c.record(clusterId, commandTypes.UpdateCluster, data)
+}
+
+func (c *Client) DeleteCluster(ctx context.Context, clusterId uuid.UUID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cluster, err := get(c.clusters, clusterId, errors.ErrClusterNotFound)
+	if err != nil {
+		return err
+	}
+	markDeleted(cluster.Metadata)
+	return c.record(clusterId, commandTypes.DeleteCluster, nil)
+}
+
+func (c *Client) GetCluster(ctx context.Context, clusterId uuid.UUID) (*projections.Cluster, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cluster, ok := c.clusters[clusterId]
+	if !ok {
+		return nil, errors.ErrClusterNotFound
+	}
+	return proto.Clone(cluster).(*projections.Cluster), nil
+}
+
+func (c *Client) GetClusterByName(ctx context.Context, name string) (*projections.Cluster, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cluster, ok := find(c.clusters, func(cl *projections.Cluster) bool { return cl.Name == name })
+	if !ok {
+		return nil, errors.ErrClusterNotFound
+	}
+	return proto.Clone(cluster).(*projections.Cluster), nil
+}
+
+func (c *Client) Clusters(ctx context.Context, includeDeleted bool, labelSelector string) client.Iterator[*projections.Cluster] {
+	selector, err := repositories.ParseLabelSelector(labelSelector)
+	if err != nil {
+		return client.NewErrorIterator[*projections.Cluster](err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return iterate(c.clusters, includeDeleted, func(cl *projections.Cluster) bool {
+		return selector.Matches(labels.Set(cl.Labels))
+	})
+}
+
+func (c *Client) BindTenantToCluster(ctx context.Context, tenantId, clusterId uuid.UUID) (uuid.UUID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := get(c.clusters, clusterId, errors.ErrClusterNotFound); err != nil {
+		return uuid.Nil, err
+	}
+	return c.bind(&cmdData.CreateTenantClusterBindingCommandData{
+		TenantId:  tenantId.String(),
+		ClusterId: clusterId.String(),
+	})
+}
+
+func (c *Client) BindTenantToClusters(ctx context.Context, tenantId uuid.UUID, clusterSelector string) (uuid.UUID, error) {
+	if _, err := repositories.ParseLabelSelector(clusterSelector); err != nil {
+		return uuid.Nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bind(&cmdData.CreateTenantClusterBindingCommandData{
+		TenantId:        tenantId.String(),
+		ClusterSelector: clusterSelector,
+	})
+}
+
+// bind creates a tenant-cluster-binding respecting the tenant's quota.
+func (c *Client) bind(data *cmdData.CreateTenantClusterBindingCommandData) (uuid.UUID, error) {
+	tenant, err := get(c.tenants, uuid.MustParse(data.TenantId), errors.ErrTenantNotFound)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	count := 0
+	for _, binding := range c.bindings {
+		if binding.TenantId != data.TenantId || isDeleted(binding) {
+			continue
+		}
+		if binding.ClusterId == data.ClusterId && binding.ClusterSelector == data.ClusterSelector {
+			return uuid.Nil, errors.ErrTenantClusterBindingAlreadyExists
+		}
+		count++
+	}
+	if tenant.MaxClusterBindings > 0 && count >= int(tenant.MaxClusterBindings) {
+		return uuid.Nil, errors.ErrTenantClusterBindingQuotaExceeded
+	}
+
+	id := uuid.New()
+	c.bindings[id] = &projections.TenantClusterBinding{
+		Id:              id.String(),
+		TenantId:        data.TenantId,
+		ClusterId:       data.ClusterId,
+		ClusterSelector: data.ClusterSelector,
+		Metadata:        newMetadata(),
+	}
+	return id, c.record(uuid.Nil, commandTypes.CreateTenantClusterBinding, data)
+}
+
+func (c *Client) UnbindTenantFromCluster(ctx context.Context, bindingId uuid.UUID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	binding, err := get(c.bindings, bindingId, errors.ErrTenantClusterBindingNotFound)
+	if err != nil {
+		return err
+	}
+	markDeleted(binding.Metadata)
+	return c.record(bindingId, commandTypes.DeleteTenantClusterBinding, nil)
+}
+
+func (c *Client) TenantClusterBindings(ctx context.Context, tenantId uuid.UUID) client.Iterator[*projections.TenantClusterBinding] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return iterate(c.bindings, false, func(b *projections.TenantClusterBinding) bool { return b.TenantId == tenantId.String() })
+}
+
+func (c *Client) CreateRole(ctx context.Context, data *cmdData.CreateRoleCommandData) (uuid.UUID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := find(c.roles, func(r *projections.Role) bool { return r.Name == data.Name }); ok {
+		return uuid.Nil, errors.ErrRoleAlreadyExists
+	}
+
+	id := uuid.New()
+	c.roles[id] = &projections.Role{
+		Id:             id.String(),
+		Name:           data.Name,
+		Description:    data.Description,
+		Scopes:         data.GetScopes().GetValues(),
+		K8SClusterRole: data.K8SClusterRole,
+		Metadata:       newMetadata(),
+	}
+	return id, c.record(uuid.Nil, commandTypes.CreateRole, data)
+}
+
+func (c *Client) UpdateRole(ctx context.Context, roleId uuid.UUID, data *cmdData.UpdateRoleCommandData) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	role, err := get(c.roles, roleId, errors.ErrRoleNotFound)
+	if err != nil {
+		return err
+	}
+	if data.Description != nil {
+		role.Description = data.Description.Value
+	}
+	if data.Scopes != nil {
+		role.Scopes = data.Scopes.Values
+	}
+	if data.K8SClusterRole != nil {
+		role.K8SClusterRole = data.K8SClusterRole.Value
+	}
+	markModified(role.Metadata)
+	return c.record(roleId, commandTypes.UpdateRole, data)
+}
+
+func (c *Client) DeleteRole(ctx context.Context, roleId uuid.UUID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	role, err := get(c.roles, roleId, errors.ErrRoleNotFound)
+	if err != nil {
+		return err
+	}
+	if _, ok := find(c.roleBindings, func(b *projections.UserRoleBinding) bool { return b.Role == role.Name }); ok {
+		return errors.ErrRoleInUse
+	}
+	markDeleted(role.Metadata)
+	return c.record(roleId, commandTypes.DeleteRole, nil)
+}
+
+func (c *Client) GetRole(ctx context.Context, name string) (*projections.Role, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	role, ok := find(c.roles, func(r *projections.Role) bool { return r.Name == name })
+	if !ok {
+		return nil, errors.ErrRoleNotFound
+	}
+	return proto.Clone(role).(*projections.Role), nil
+}
+
+func (c *Client) Roles(ctx context.Context, includeDeleted bool) client.Iterator[*projections.Role] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return iterate(c.roles, includeDeleted, nil)
+}
@@ -0,0 +1,160 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+
+	cmdData "github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
+	"github.com/finleap-connect/monoskope/pkg/client"
+	commandTypes "github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("pkg/client/fake", func() {
+	ctx := context.Background()
+
+	var c *Client
+
+	BeforeEach(func() {
+		c = NewClient()
+	})
+
+	It("creates and queries tenants", func() {
+		tenantId, err := c.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "tenant-a", Prefix: "ta"})
+		Expect(err).ToNot(HaveOccurred())
+
+		tenant, err := c.GetTenantByName(ctx, "tenant-a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tenant.Id).To(Equal(tenantId.String()))
+
+		_, err = c.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "tenant-a", Prefix: "ta"})
+		Expect(err).To(Equal(errors.ErrTenantAlreadyExists))
+
+		Expect(c.DeleteTenant(ctx, tenantId)).To(Succeed())
+		Expect(client.Collect(c.Tenants(ctx, false))).To(BeEmpty())
+		Expect(client.Collect(c.Tenants(ctx, true))).To(HaveLen(1))
+	})
+	It("records the executed commands", func() {
+		tenantId, err := c.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "tenant-a", Prefix: "ta"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.DeleteTenant(ctx, tenantId)).To(Succeed())
+
+		commands := c.Commands()
+		Expect(commands).To(HaveLen(2))
+		Expect(commands[0].Type).To(Equal(string(commandTypes.CreateTenant)))
+		Expect(commands[0].Id).To(Equal(uuid.Nil.String()))
+		Expect(commands[1].Type).To(Equal(string(commandTypes.DeleteTenant)))
+		Expect(commands[1].Id).To(Equal(tenantId.String()))
+	})
+	It("records the commands of batches", func() {
+		tenantId := uuid.New()
+		ids, err := c.ExecuteBatch(ctx, []client.BatchCommand{
+			{AggregateId: tenantId, CommandType: commandTypes.CreateTenant, Data: &cmdData.CreateTenantCommandData{Name: "tenant-a", Prefix: "ta"}},
+			{CommandType: commandTypes.CreateUser, Data: &cmdData.CreateUserCommandData{Email: "jane.doe@monoskope.io", Name: "jane.doe"}},
+		}, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ids).To(HaveLen(2))
+		Expect(ids[0]).To(Equal(tenantId))
+		Expect(ids[1]).ToNot(Equal(uuid.Nil))
+		Expect(c.Commands()).To(HaveLen(2))
+	})
+	It("binds tenants to clusters", func() {
+		tenantId, err := c.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "tenant-a", Prefix: "ta", MaxClusterBindings: 1})
+		Expect(err).ToNot(HaveOccurred())
+		clusterId, err := c.CreateCluster(ctx, &cmdData.CreateCluster{
+			Name:   "cluster-a",
+			Labels: &cmdData.ClusterLabels{Values: map[string]string{"env": "dev"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = c.BindTenantToCluster(ctx, tenantId, uuid.New())
+		Expect(err).To(Equal(errors.ErrClusterNotFound))
+
+		bindingId, err := c.BindTenantToCluster(ctx, tenantId, clusterId)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = c.BindTenantToClusters(ctx, tenantId, "env=dev")
+		Expect(err).To(Equal(errors.ErrTenantClusterBindingQuotaExceeded))
+
+		bindings, err := client.Collect(c.TenantClusterBindings(ctx, tenantId))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bindings).To(HaveLen(1))
+		Expect(bindings[0].Id).To(Equal(bindingId.String()))
+
+		Expect(c.UnbindTenantFromCluster(ctx, bindingId)).To(Succeed())
+		Expect(client.Collect(c.TenantClusterBindings(ctx, tenantId))).To(BeEmpty())
+	})
+	It("filters clusters by labels", func() {
+		_, err := c.CreateCluster(ctx, &cmdData.CreateCluster{
+			Name:   "cluster-a",
+			Labels: &cmdData.ClusterLabels{Values: map[string]string{"env": "dev"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = c.CreateCluster(ctx, &cmdData.CreateCluster{
+			Name:   "cluster-b",
+			Labels: &cmdData.ClusterLabels{Values: map[string]string{"env": "prod"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		clusters, err := client.Collect(c.Clusters(ctx, false, "env=prod"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clusters).To(HaveLen(1))
+		Expect(clusters[0].Name).To(Equal("cluster-b"))
+
+		_, err = client.Collect(c.Clusters(ctx, false, "env in"))
+		Expect(err).To(HaveOccurred())
+	})
+	It("grants roles to users", func() {
+		tenantId, err := c.CreateTenant(ctx, &cmdData.CreateTenantCommandData{Name: "tenant-a", Prefix: "ta"})
+		Expect(err).ToNot(HaveOccurred())
+		userId, err := c.CreateUser(ctx, "jane.doe@monoskope.io", "jane.doe")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = c.GrantRole(ctx, userId, string(roles.User), string(scopes.Tenant), tenantId.String())
+		Expect(err).ToNot(HaveOccurred())
+		_, err = c.GrantRole(ctx, userId, string(roles.User), string(scopes.Tenant), tenantId.String())
+		Expect(err).To(Equal(errors.ErrUserRoleBindingAlreadyExists))
+
+		user, err := c.GetUserByEmail(ctx, "jane.doe@monoskope.io")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(user.Roles).To(HaveLen(1))
+
+		tenantUsers, err := client.Collect(c.TenantUsers(ctx, tenantId))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tenantUsers).To(HaveLen(1))
+		Expect(tenantUsers[0].Email).To(Equal("jane.doe@monoskope.io"))
+		Expect(tenantUsers[0].TenantRoles).To(ConsistOf(string(roles.User)))
+	})
+	It("does not delete roles in use", func() {
+		roleId, err := c.CreateRole(ctx, &cmdData.CreateRoleCommandData{Name: "viewer"})
+		Expect(err).ToNot(HaveOccurred())
+		userId, err := c.CreateUser(ctx, "jane.doe@monoskope.io", "jane.doe")
+		Expect(err).ToNot(HaveOccurred())
+		bindingId, err := c.GrantRole(ctx, userId, "viewer", string(scopes.System), "")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(c.DeleteRole(ctx, roleId)).To(Equal(errors.ErrRoleInUse))
+		Expect(c.RevokeRole(ctx, bindingId)).To(Succeed())
+		Expect(c.DeleteRole(ctx, roleId)).To(Succeed())
+		_, err = c.GetRole(ctx, "viewer")
+		Expect(err).To(Equal(errors.ErrRoleNotFound))
+	})
+})
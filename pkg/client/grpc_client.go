@@ -0,0 +1,361 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	domainApi "github.com/finleap-connect/monoskope/pkg/api/domain"
+	cmdData "github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/projections"
+	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	cmdApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/commands"
+	commandTypes "github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	grpcUtil "github.com/finleap-connect/monoskope/pkg/grpc"
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type options struct {
+	tokenSource oauth2.TokenSource
+	insecure    bool
+}
+
+// Option configures the connection to Monoskope.
+type Option func(*options)
+
+// WithToken authenticates with the given token, e.g. an API token.
+func WithToken(token string) Option {
+	return WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}))
+}
+
+// WithTokenSource authenticates with tokens of the given source. A new token
+// is requested only once the previous one expired.
+func WithTokenSource(tokenSource oauth2.TokenSource) Option {
+	return func(o *options) {
+		o.tokenSource = oauth2.ReuseTokenSource(nil, tokenSource)
+	}
+}
+
+// WithInsecure connects without transport security, e.g. to the dev server.
+func WithInsecure() Option {
+	return func(o *options) {
+		o.insecure = true
+	}
+}
+
+// grpcClient implements the Client using the gRPC APIs of Monoskope.
+type grpcClient struct {
+	conn           *grpc.ClientConn
+	commandHandler esApi.CommandHandlerClient
+	users          domainApi.UserClient
+	tenants        domainApi.TenantClient
+	clusters       domainApi.ClusterClient
+	clusterAccess  domainApi.ClusterAccessClient
+	roles          domainApi.RoleClient
+}
+
+// New connects to the gRPC API of Monoskope at the given address.
+func New(ctx context.Context, addr string, opts ...Option) (Client, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	factory := grpcUtil.NewGrpcConnectionFactory(addr).WithOpenTelemetry()
+	if o.insecure {
+		factory = factory.WithInsecure()
+	} else {
+		factory = factory.WithOSCaTransportCredentials()
+	}
+	if o.tokenSource != nil {
+		factory = factory.WithPerRPCCredentials(grpcUtil.NewOauthAccessFromTokenSource(o.tokenSource, !o.insecure))
+	}
+
+	conn, err := factory.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewFromConn(conn).(*grpcClient)
+	c.conn = conn
+	return c, nil
+}
+
+// NewFromConn uses the given connection to the gRPC API of Monoskope. The
+// connection is not closed by the client.
+func NewFromConn(conn grpc.ClientConnInterface) Client {
+	return &grpcClient{
+		commandHandler: esApi.NewCommandHandlerClient(conn),
+		users:          domainApi.NewUserClient(conn),
+		tenants:        domainApi.NewTenantClient(conn),
+		clusters:       domainApi.NewClusterClient(conn),
+		clusterAccess:  domainApi.NewClusterAccessClient(conn),
+		roles:          domainApi.NewRoleClient(conn),
+	}
+}
+
+// Close closes the connection if it has been opened by New.
+func (c *grpcClient) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// newCommand creates the command with the given data.
+func newCommand(aggregateId uuid.UUID, commandType es.CommandType, data proto.Message) (*cmdApi.Command, error) {
+	command := commands.NewCommand(aggregateId, commandType)
+	if data != nil {
+		anyData, err := commands.CreateCommandData(data)
+		if err != nil {
+			return nil, err
+		}
+		command.Data = anyData
+	}
+	return command, nil
+}
+
+func (c *grpcClient) Execute(ctx context.Context, aggregateId uuid.UUID, commandType es.CommandType, data proto.Message) (uuid.UUID, error) {
+	command, err := newCommand(aggregateId, commandType, data)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	reply, err := c.commandHandler.Execute(ctx, command)
+	if err != nil {
+		return uuid.Nil, errors.TranslateFromGrpcError(err)
+	}
+	return uuid.Parse(reply.GetAggregateId())
+}
+
+func (c *grpcClient) Validate(ctx context.Context, aggregateId uuid.UUID, commandType es.CommandType, data proto.Message) ([]es.Event, error) {
+	command, err := newCommand(aggregateId, commandType, data)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := c.commandHandler.Validate(ctx, command)
+	if err != nil {
+		return nil, errors.TranslateFromGrpcError(err)
+	}
+
+	events := make([]es.Event, 0, len(reply.GetEvents()))
+	for _, protoEvent := range reply.GetEvents() {
+		event, err := es.NewEventFromProto(protoEvent)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (c *grpcClient) ExecuteBatch(ctx context.Context, cmds []BatchCommand, dryRun bool) ([]uuid.UUID, error) {
+	batch := &esApi.CommandBatch{DryRun: dryRun}
+	for _, cmd := range cmds {
+		command, err := newCommand(cmd.AggregateId, cmd.CommandType, cmd.Data)
+		if err != nil {
+			return nil, err
+		}
+		batch.Commands = append(batch.Commands, command)
+	}
+
+	reply, err := c.commandHandler.ExecuteBatch(ctx, batch)
+	if err != nil {
+		return nil, errors.TranslateFromGrpcError(err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(reply.GetResults()))
+	for i, result := range reply.GetResults() {
+		if result.GetError() != nil {
+			return nil, &BatchError{Index: i, Err: errors.TranslateFromGrpcError(status.ErrorProto(result.GetError()))}
+		}
+		id, err := uuid.Parse(result.GetAggregateId())
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// execute executes a command for an existing aggregate.
+func (c *grpcClient) execute(ctx context.Context, aggregateId uuid.UUID, commandType es.CommandType, data proto.Message) error {
+	_, err := c.Execute(ctx, aggregateId, commandType, data)
+	return err
+}
+
+func (c *grpcClient) CreateUser(ctx context.Context, email, name string) (uuid.UUID, error) {
+	return c.Execute(ctx, uuid.Nil, commandTypes.CreateUser, &cmdData.CreateUserCommandData{Email: email, Name: name})
+}
+
+func (c *grpcClient) UpdateUser(ctx context.Context, userId uuid.UUID, name string) error {
+	return c.execute(ctx, userId, commandTypes.UpdateUser, &cmdData.UpdateUserCommandData{Name: wrapperspb.String(name)})
+}
+
+func (c *grpcClient) DeleteUser(ctx context.Context, userId uuid.UUID) error {
+	return c.execute(ctx, userId, commandTypes.DeleteUser, nil)
+}
+
+func (c *grpcClient) GrantRole(ctx context.Context, userId uuid.UUID, role, scope, resource string) (uuid.UUID, error) {
+	data := &cmdData.CreateUserRoleBindingCommandData{UserId: userId.String(), Role: role, Scope: scope}
+	if resource != "" {
+		data.Resource = wrapperspb.String(resource)
+	}
+	return c.Execute(ctx, uuid.Nil, commandTypes.CreateUserRoleBinding, data)
+}
+
+func (c *grpcClient) RevokeRole(ctx context.Context, roleBindingId uuid.UUID) error {
+	return c.execute(ctx, roleBindingId, commandTypes.DeleteUserRoleBinding, nil)
+}
+
+func (c *grpcClient) GetUser(ctx context.Context, userId uuid.UUID) (*projections.User, error) {
+	user, err := c.users.GetById(ctx, wrapperspb.String(userId.String()))
+	return user, errors.TranslateFromGrpcError(err)
+}
+
+func (c *grpcClient) GetUserByEmail(ctx context.Context, email string) (*projections.User, error) {
+	user, err := c.users.GetByEmail(ctx, wrapperspb.String(email))
+	return user, errors.TranslateFromGrpcError(err)
+}
+
+func (c *grpcClient) Users(ctx context.Context, includeDeleted bool) Iterator[*projections.User] {
+	return newStreamIterator(ctx, func(ctx context.Context) (receiver[*projections.User], error) {
+		return c.users.GetAll(ctx, &domainApi.GetAllRequest{IncludeDeleted: includeDeleted})
+	})
+}
+
+func (c *grpcClient) RoleBindings(ctx context.Context, userId uuid.UUID) Iterator[*projections.UserRoleBinding] {
+	return newStreamIterator(ctx, func(ctx context.Context) (receiver[*projections.UserRoleBinding], error) {
+		return c.users.GetRoleBindingsById(ctx, wrapperspb.String(userId.String()))
+	})
+}
+
+func (c *grpcClient) CreateTenant(ctx context.Context, data *cmdData.CreateTenantCommandData) (uuid.UUID, error) {
+	return c.Execute(ctx, uuid.Nil, commandTypes.CreateTenant, data)
+}
+
+func (c *grpcClient) UpdateTenant(ctx context.Context, tenantId uuid.UUID, data *cmdData.UpdateTenantCommandData) error {
+	return c.execute(ctx, tenantId, commandTypes.UpdateTenant, data)
+}
+
+func (c *grpcClient) DeleteTenant(ctx context.Context, tenantId uuid.UUID) error {
+	return c.execute(ctx, tenantId, commandTypes.DeleteTenant, nil)
+}
+
+func (c *grpcClient) GetTenant(ctx context.Context, tenantId uuid.UUID) (*projections.Tenant, error) {
+	tenant, err := c.tenants.GetById(ctx, wrapperspb.String(tenantId.String()))
+	return tenant, errors.TranslateFromGrpcError(err)
+}
+
+func (c *grpcClient) GetTenantByName(ctx context.Context, name string) (*projections.Tenant, error) {
+	tenant, err := c.tenants.GetByName(ctx, wrapperspb.String(name))
+	return tenant, errors.TranslateFromGrpcError(err)
+}
+
+func (c *grpcClient) Tenants(ctx context.Context, includeDeleted bool) Iterator[*projections.Tenant] {
+	return newStreamIterator(ctx, func(ctx context.Context) (receiver[*projections.Tenant], error) {
+		return c.tenants.GetAll(ctx, &domainApi.GetAllRequest{IncludeDeleted: includeDeleted})
+	})
+}
+
+func (c *grpcClient) TenantUsers(ctx context.Context, tenantId uuid.UUID) Iterator[*projections.TenantUser] {
+	return newStreamIterator(ctx, func(ctx context.Context) (receiver[*projections.TenantUser], error) {
+		return c.tenants.GetUsers(ctx, wrapperspb.String(tenantId.String()))
+	})
+}
+
+func (c *grpcClient) CreateCluster(ctx context.Context, data *cmdData.CreateCluster) (uuid.UUID, error) {
+	return c.Execute(ctx, uuid.Nil, commandTypes.CreateCluster, data)
+}
+
+func (c *grpcClient) UpdateCluster(ctx context.Context, clusterId uuid.UUID, data *cmdData.UpdateCluster) error {
+	return c.execute(ctx, clusterId, commandTypes.UpdateCluster, data)
+}
+
+func (c *grpcClient) DeleteCluster(ctx context.Context, clusterId uuid.UUID) error {
+	return c.execute(ctx, clusterId, commandTypes.DeleteCluster, nil)
+}
+
+func (c *grpcClient) GetCluster(ctx context.Context, clusterId uuid.UUID) (*projections.Cluster, error) {
+	cluster, err := c.clusters.GetById(ctx, wrapperspb.String(clusterId.String()))
+	return cluster, errors.TranslateFromGrpcError(err)
+}
+
+func (c *grpcClient) GetClusterByName(ctx context.Context, name string) (*projections.Cluster, error) {
+	cluster, err := c.clusters.GetByName(ctx, wrapperspb.String(name))
+	return cluster, errors.TranslateFromGrpcError(err)
+}
+
+func (c *grpcClient) Clusters(ctx context.Context, includeDeleted bool, labelSelector string) Iterator[*projections.Cluster] {
+	return newStreamIterator(ctx, func(ctx context.Context) (receiver[*projections.Cluster], error) {
+		return c.clusters.GetAll(ctx, &domainApi.GetAllClustersRequest{IncludeDeleted: includeDeleted, LabelSelector: labelSelector})
+	})
+}
+
+func (c *grpcClient) BindTenantToCluster(ctx context.Context, tenantId, clusterId uuid.UUID) (uuid.UUID, error) {
+	return c.Execute(ctx, uuid.Nil, commandTypes.CreateTenantClusterBinding, &cmdData.CreateTenantClusterBindingCommandData{
+		TenantId:  tenantId.String(),
+		ClusterId: clusterId.String(),
+	})
+}
+
+func (c *grpcClient) BindTenantToClusters(ctx context.Context, tenantId uuid.UUID, clusterSelector string) (uuid.UUID, error) {
+	return c.Execute(ctx, uuid.Nil, commandTypes.CreateTenantClusterBinding, &cmdData.CreateTenantClusterBindingCommandData{
+		TenantId:        tenantId.String(),
+		ClusterSelector: clusterSelector,
+	})
+}
+
+func (c *grpcClient) UnbindTenantFromCluster(ctx context.Context, bindingId uuid.UUID) error {
+	return c.execute(ctx, bindingId, commandTypes.DeleteTenantClusterBinding, nil)
+}
+
+func (c *grpcClient) TenantClusterBindings(ctx context.Context, tenantId uuid.UUID) Iterator[*projections.TenantClusterBinding] {
+	return newStreamIterator(ctx, func(ctx context.Context) (receiver[*projections.TenantClusterBinding], error) {
+		return c.clusterAccess.GetTenantClusterMappingsByTenantId(ctx, wrapperspb.String(tenantId.String()))
+	})
+}
+
+func (c *grpcClient) CreateRole(ctx context.Context, data *cmdData.CreateRoleCommandData) (uuid.UUID, error) {
+	return c.Execute(ctx, uuid.Nil, commandTypes.CreateRole, data)
+}
+
+func (c *grpcClient) UpdateRole(ctx context.Context, roleId uuid.UUID, data *cmdData.UpdateRoleCommandData) error {
+	return c.execute(ctx, roleId, commandTypes.UpdateRole, data)
+}
+
+func (c *grpcClient) DeleteRole(ctx context.Context, roleId uuid.UUID) error {
+	return c.execute(ctx, roleId, commandTypes.DeleteRole, nil)
+}
+
+func (c *grpcClient) GetRole(ctx context.Context, name string) (*projections.Role, error) {
+	role, err := c.roles.GetByName(ctx, wrapperspb.String(name))
+	return role, errors.TranslateFromGrpcError(err)
+}
+
+func (c *grpcClient) Roles(ctx context.Context, includeDeleted bool) Iterator[*projections.Role] {
+	return newStreamIterator(ctx, func(ctx context.Context) (receiver[*projections.Role], error) {
+		return c.roles.GetAll(ctx, &domainApi.GetAllRequest{IncludeDeleted: includeDeleted})
+	})
+}
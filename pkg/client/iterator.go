@@ -0,0 +1,142 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"io"
+
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+)
+
+// Iterator iterates the results of a query.
+type Iterator[T any] interface {
+	// Next returns the next result or io.EOF if there are no more results.
+	Next() (T, error)
+	// Close stops the iteration early and releases its resources.
+	Close()
+}
+
+// Collect returns all remaining results of the iterator and closes it.
+func Collect[T any](it Iterator[T]) ([]T, error) {
+	defer it.Close()
+
+	var items []T
+	for {
+		item, err := it.Next()
+		if err == io.EOF {
+			return items, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+}
+
+// receiver is implemented by the clients of server streaming gRPC methods.
+type receiver[T any] interface {
+	Recv() (T, error)
+}
+
+// streamIterator iterates the messages of a server stream.
+type streamIterator[T any] struct {
+	stream receiver[T]
+	cancel context.CancelFunc
+	err    error
+}
+
+// newStreamIterator opens the stream with a context which is cancelled once the iteration ended.
+func newStreamIterator[T any](ctx context.Context, open func(context.Context) (receiver[T], error)) Iterator[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := open(ctx)
+	if err != nil {
+		cancel()
+		return &streamIterator[T]{cancel: cancel, err: errors.TranslateFromGrpcError(err)}
+	}
+	return &streamIterator[T]{stream: stream, cancel: cancel}
+}
+
+// Next returns the next message of the stream.
+func (it *streamIterator[T]) Next() (T, error) {
+	var item T
+	if it.err != nil {
+		return item, it.err
+	}
+
+	item, err := it.stream.Recv()
+	if err != nil {
+		if err != io.EOF {
+			err = errors.TranslateFromGrpcError(err)
+		}
+		it.err = err
+		it.cancel()
+	}
+	return item, err
+}
+
+// Close cancels the stream.
+func (it *streamIterator[T]) Close() {
+	if it.err == nil {
+		it.err = io.EOF
+	}
+	it.cancel()
+}
+
+// sliceIterator iterates the items of a slice.
+type sliceIterator[T any] struct {
+	items []T
+}
+
+// NewSliceIterator returns an iterator over the given items, e.g. to return
+// results from fakes.
+func NewSliceIterator[T any](items []T) Iterator[T] {
+	return &sliceIterator[T]{items: items}
+}
+
+// Next returns the next item of the slice.
+func (it *sliceIterator[T]) Next() (T, error) {
+	var item T
+	if len(it.items) == 0 {
+		return item, io.EOF
+	}
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Close drops the remaining items.
+func (it *sliceIterator[T]) Close() {
+	it.items = nil
+}
+
+// errorIterator fails every call to Next with the same error.
+type errorIterator[T any] struct {
+	err error
+}
+
+// NewErrorIterator returns an iterator which fails with the given error, e.g.
+// to return errors from fakes.
+func NewErrorIterator[T any](err error) Iterator[T] {
+	return &errorIterator[T]{err: err}
+}
+
+// Next returns the error of the iterator.
+func (it *errorIterator[T]) Next() (T, error) {
+	var item T
+	return item, it.err
+}
+
+// Close is a no-op.
+func (it *errorIterator[T]) Close() {}
@@ -0,0 +1,96 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	gatewayApi "github.com/finleap-connect/monoskope/pkg/api/gateway"
+	"github.com/finleap-connect/monoskope/pkg/auth"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+)
+
+// LoginConfig configures how users log in via the gateway.
+type LoginConfig struct {
+	// Config of the local server receiving the authorization code from the
+	// identity provider.
+	auth.Config
+	// OpenURL is called with the URL the user has to open in a browser to
+	// log in, e.g. to open a browser or to print the URL.
+	OpenURL func(url string) error
+}
+
+// loginTokenSource logs in via the OIDC flow of the gateway.
+type loginTokenSource struct {
+	ctx     context.Context
+	gateway gatewayApi.GatewayClient
+	config  *LoginConfig
+}
+
+// NewLoginTokenSource returns a token source which logs in via the OIDC flow
+// of the gateway like monoctl does. Used with WithTokenSource the user is
+// asked to log in again only once the previous token expired.
+//
+// The connection must not authenticate with this token source itself, the
+// Gateway API does not require authentication.
+func NewLoginTokenSource(ctx context.Context, conn grpc.ClientConnInterface, config *LoginConfig) oauth2.TokenSource {
+	return &loginTokenSource{
+		ctx:     ctx,
+		gateway: gatewayApi.NewGatewayClient(conn),
+		config:  config,
+	}
+}
+
+// Token logs in and returns the token issued by the gateway.
+func (s *loginTokenSource) Token() (*oauth2.Token, error) {
+	server, err := auth.NewServer(&s.config.Config)
+	if err != nil {
+		return nil, err
+	}
+	defer server.Close()
+
+	upstream, err := s.gateway.RequestUpstreamAuthentication(s.ctx, &gatewayApi.UpstreamAuthenticationRequest{
+		CallbackUrl: server.RedirectURI,
+	})
+	if err != nil {
+		return nil, errors.TranslateFromGrpcError(err)
+	}
+
+	// The local server redirects to the identity provider
+	if err := s.config.OpenURL(server.RedirectURI); err != nil {
+		return nil, fmt.Errorf("failed to open login URL: %w", err)
+	}
+
+	code, err := server.ReceiveCodeViaLocalServer(s.ctx, upstream.GetUpstreamIdpRedirect(), upstream.GetState())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.gateway.RequestAuthentication(s.ctx, &gatewayApi.AuthenticationRequest{
+		Code:  code,
+		State: upstream.GetState(),
+	})
+	if err != nil {
+		return nil, errors.TranslateFromGrpcError(err)
+	}
+
+	return &oauth2.Token{
+		AccessToken: response.GetAccessToken(),
+		Expiry:      response.GetExpiry().AsTime(),
+	}, nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
 	domainErrors "github.com/finleap-connect/monoskope/pkg/domain/errors"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // ClusterAggregate is an aggregate for K8s Clusters.
@@ -55,20 +56,30 @@ func (a *ClusterAggregate) HandleCommand(ctx context.Context, cmd es.Command) (*
 
 	switch cmd := cmd.(type) {
 	case *commands.CreateClusterCommand:
-		ed := es.ToEventDataFromProto(&eventdata.ClusterCreatedV3{
+		ed := es.ToEventDataFromProto(&eventdata.ClusterCreatedV4{
 			Name:                cmd.GetName(),
 			ApiServerAddress:    cmd.GetApiServerAddress(),
 			CaCertificateBundle: cmd.GetCaCertBundle(),
+			Description:         cmd.GetDescription(),
+			Labels:              cmd.GetLabels().GetValues(),
+			Annotations:         cmd.GetAnnotations().GetValues(),
 		})
-		_ = a.AppendEvent(ctx, events.ClusterCreatedV3, ed)
+		_ = a.AppendEvent(ctx, events.ClusterCreatedV4, ed)
 	case *commands.UpdateClusterCommand:
-		ed := new(eventdata.ClusterUpdatedV2)
+		ed := new(eventdata.ClusterUpdatedV3)
 		ed.Name = cmd.Name
 		ed.ApiServerAddress = cmd.ApiServerAddress
 		if cmd.CaCertBundle != nil && !bytes.Equal(a.caCertBundle, cmd.CaCertBundle) {
 			ed.CaCertificateBundle = cmd.CaCertBundle
 		}
-		_ = a.AppendEvent(ctx, events.ClusterUpdatedV2, es.ToEventDataFromProto(ed))
+		ed.Description = cmd.Description
+		if cmd.Labels != nil {
+			ed.Labels = &eventdata.StringMap{Values: cmd.Labels.GetValues()}
+		}
+		if cmd.Annotations != nil {
+			ed.Annotations = &eventdata.StringMap{Values: cmd.Annotations.GetValues()}
+		}
+		_ = a.AppendEvent(ctx, events.ClusterUpdatedV3, es.ToEventDataFromProto(ed))
 	case *commands.DeleteClusterCommand:
 		_ = a.AppendEvent(ctx, events.ClusterDeleted, nil)
 	default:
@@ -94,12 +105,35 @@ func (a *ClusterAggregate) validate(ctx context.Context, cmd es.Command) error {
 		if containsCluster(aggregates, cmd.GetName()) {
 			return domainErrors.ErrClusterAlreadyExists
 		}
-		return nil
+		return validateClusterMetadata(cmd.GetLabels().GetValues(), cmd.GetAnnotations().GetValues())
+	case *commands.UpdateClusterCommand:
+		if err := a.Validate(ctx, cmd); err != nil {
+			return err
+		}
+		return validateClusterMetadata(cmd.GetLabels().GetValues(), cmd.GetAnnotations().GetValues())
 	default:
 		return a.Validate(ctx, cmd)
 	}
 }
 
+// validateClusterMetadata validates labels and annotations following the rules of K8s
+func validateClusterMetadata(labels, annotations map[string]string) error {
+	for key, value := range labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return domainErrors.ErrInvalidArgument(fmt.Sprintf("label key '%s' is invalid: %s", key, strings.Join(errs, ", ")))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return domainErrors.ErrInvalidArgument(fmt.Sprintf("label value '%s' is invalid: %s", value, strings.Join(errs, ", ")))
+		}
+	}
+	for key := range annotations {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return domainErrors.ErrInvalidArgument(fmt.Sprintf("annotation key '%s' is invalid: %s", key, strings.Join(errs, ", ")))
+		}
+	}
+	return nil
+}
+
 func containsCluster(values []es.Aggregate, name string) bool {
 	for _, value := range values {
 		d, ok := value.(*ClusterAggregate)
@@ -113,50 +147,20 @@ func containsCluster(values []es.Aggregate, name string) bool {
 }
 
 // ApplyEvent implements the ApplyEvent method of the Aggregate interface.
+// Events of previous versions are upcasted when loaded, see package upcasters.
 func (a *ClusterAggregate) ApplyEvent(event es.Event) error {
 	switch event.EventType() {
-	case events.ClusterCreated:
-		clusterCreatedV1 := new(eventdata.ClusterCreated)
-		err := event.Data().ToProto(clusterCreatedV1)
+	case events.ClusterCreatedV4:
+		clusterCreatedV4 := new(eventdata.ClusterCreatedV4)
+		err := event.Data().ToProto(clusterCreatedV4)
 		if err != nil {
 			return err
 		}
-		a.name = clusterCreatedV1.GetLabel()
-		a.apiServerAddr = clusterCreatedV1.GetApiServerAddress()
-		a.caCertBundle = clusterCreatedV1.GetCaCertificateBundle()
-	case events.ClusterCreatedV2:
-		clusterCreatedV2 := new(eventdata.ClusterCreatedV2)
-		err := event.Data().ToProto(clusterCreatedV2)
-		if err != nil {
-			return err
-		}
-		a.name = clusterCreatedV2.GetName()
-		a.apiServerAddr = clusterCreatedV2.GetApiServerAddress()
-		a.caCertBundle = clusterCreatedV2.GetCaCertificateBundle()
-	case events.ClusterCreatedV3:
-		clusterCreatedV3 := new(eventdata.ClusterCreatedV3)
-		err := event.Data().ToProto(clusterCreatedV3)
-		if err != nil {
-			return err
-		}
-		a.name = clusterCreatedV3.GetName()
-		a.apiServerAddr = clusterCreatedV3.GetApiServerAddress()
-		a.caCertBundle = clusterCreatedV3.GetCaCertificateBundle()
-	case events.ClusterUpdated:
-		data := new(eventdata.ClusterUpdated)
-		err := event.Data().ToProto(data)
-		if err != nil {
-			return err
-		}
-
-		if len(data.GetApiServerAddress()) > 0 && a.apiServerAddr != data.GetApiServerAddress() {
-			a.apiServerAddr = data.GetApiServerAddress()
-		}
-		if len(data.GetCaCertificateBundle()) > 0 && !bytes.Equal(a.caCertBundle, data.GetCaCertificateBundle()) {
-			a.caCertBundle = data.GetCaCertificateBundle()
-		}
-	case events.ClusterUpdatedV2:
-		data := new(eventdata.ClusterUpdatedV2)
+		a.name = clusterCreatedV4.GetName()
+		a.apiServerAddr = clusterCreatedV4.GetApiServerAddress()
+		a.caCertBundle = clusterCreatedV4.GetCaCertificateBundle()
+	case events.ClusterUpdatedV3:
+		data := new(eventdata.ClusterUpdatedV3)
 		err := event.Data().ToProto(data)
 		if err != nil {
 			return err
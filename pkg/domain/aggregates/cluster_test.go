@@ -17,8 +17,11 @@ package aggregates
 import (
 	"time"
 
+	"github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
 	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	cmd "github.com/finleap-connect/monoskope/pkg/domain/commands"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	"github.com/finleap-connect/monoskope/pkg/domain/upcasters"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
@@ -27,6 +30,15 @@ import (
 )
 
 var _ = Describe("Unit Test for Cluster Aggregate", func() {
+	// events of previous versions are upcasted when loaded
+	upcast := func(event es.Event) es.Event {
+		registry := es.NewUpcasterRegistry()
+		Expect(upcasters.RegisterClusterUpcasters(registry)).To(Succeed())
+		upcasted, err := registry.Upcast(event)
+		Expect(err).ToNot(HaveOccurred())
+		return upcasted
+	}
+
 	It("should set the data from a command to the resultant event", func() {
 		ctx := createSysAdminCtx()
 		agg := NewClusterAggregate(NewTestAggregateManager())
@@ -38,16 +50,63 @@ var _ = Describe("Unit Test for Cluster Aggregate", func() {
 
 		event := agg.UncommittedEvents()[0]
 
-		Expect(event.EventType()).To(Equal(events.ClusterCreatedV3))
+		Expect(event.EventType()).To(Equal(events.ClusterCreatedV4))
 
-		data := new(eventdata.ClusterCreatedV3)
+		data := new(eventdata.ClusterCreatedV4)
 		err = event.Data().ToProto(data)
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(data.Name).To(Equal(expectedClusterName))
 		Expect(data.ApiServerAddress).To(Equal(expectedClusterApiServerAddress))
 		Expect(data.CaCertificateBundle).To(Equal(expectedClusterCACertBundle))
+		Expect(data.Description).To(Equal(expectedClusterDescription))
+		Expect(data.Labels).To(Equal(expectedClusterLabels))
+
+	})
+
+	It("should reject invalid labels", func() {
+		ctx := createSysAdminCtx()
+		agg := NewClusterAggregate(NewTestAggregateManager())
+
+		esCommand, ok := cmd.NewCreateClusterCommand(uuid.New()).(*cmd.CreateClusterCommand)
+		Expect(ok).To(BeTrue())
+		esCommand.CreateCluster.Name = expectedClusterName
+		esCommand.CreateCluster.Labels = &commanddata.ClusterLabels{Values: map[string]string{"env": "not a valid value"}}
+
+		_, err := agg.HandleCommand(ctx, esCommand)
+		Expect(err).To(HaveOccurred())
+
+		esCommand.CreateCluster.Labels = &commanddata.ClusterLabels{Values: map[string]string{"-invalid-key": "dev"}}
+		_, err = agg.HandleCommand(ctx, esCommand)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should set the labels from an update command to the resultant event", func() {
+		ctx := createSysAdminCtx()
+		agg := NewClusterAggregate(NewTestAggregateManager())
+
+		_, err := createCluster(ctx, agg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(agg.ApplyEvent(agg.UncommittedEvents()[0])).To(Succeed())
+		agg.IncrementVersion()
+
+		esCommand, ok := cmd.NewUpdateClusterCommand(agg.ID()).(*cmd.UpdateClusterCommand)
+		Expect(ok).To(BeTrue())
+		esCommand.UpdateCluster.Labels = &commanddata.ClusterLabels{}
 
+		_, err = agg.HandleCommand(ctx, esCommand)
+		Expect(err).NotTo(HaveOccurred())
+
+		event := agg.UncommittedEvents()[0]
+		Expect(event.EventType()).To(Equal(events.ClusterUpdatedV3))
+
+		data := new(eventdata.ClusterUpdatedV3)
+		err = event.Data().ToProto(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data.Labels).ToNot(BeNil())
+		Expect(data.Labels.Values).To(BeEmpty())
+		Expect(data.Annotations).To(BeNil())
+		Expect(data.Description).To(BeNil())
 	})
 
 	It("should apply the data from an event to the aggregate", func() {
@@ -63,7 +122,7 @@ var _ = Describe("Unit Test for Cluster Aggregate", func() {
 		esEvent := es.NewEvent(ctx, events.ClusterCreatedV3, ed, time.Now().UTC(),
 			agg.Type(), agg.ID(), agg.Version())
 
-		err := agg.ApplyEvent(esEvent)
+		err := agg.ApplyEvent(upcast(esEvent))
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(agg.(*ClusterAggregate).name).To(Equal(expectedClusterName))
@@ -83,7 +142,7 @@ var _ = Describe("Unit Test for Cluster Aggregate", func() {
 			esEvent := es.NewEvent(ctx, events.ClusterUpdatedV2, ed, time.Now().UTC(),
 				agg.Type(), agg.ID(), agg.Version()+1)
 
-			err := agg.ApplyEvent(esEvent)
+			err := agg.ApplyEvent(upcast(esEvent))
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(agg.(*ClusterAggregate).name).To(Equal(expectedNewName))
@@ -99,7 +158,7 @@ var _ = Describe("Unit Test for Cluster Aggregate", func() {
 			esEvent := es.NewEvent(ctx, events.ClusterUpdated, ed, time.Now().UTC(),
 				agg.Type(), agg.ID(), agg.Version()+1)
 
-			err := agg.ApplyEvent(esEvent)
+			err := agg.ApplyEvent(upcast(esEvent))
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(agg.(*ClusterAggregate).apiServerAddr).To(Equal(expectedValue))
@@ -115,7 +174,7 @@ var _ = Describe("Unit Test for Cluster Aggregate", func() {
 			esEvent := es.NewEvent(ctx, events.ClusterUpdated, ed, time.Now().UTC(),
 				agg.Type(), agg.ID(), agg.Version()+1)
 
-			err := agg.ApplyEvent(esEvent)
+			err := agg.ApplyEvent(upcast(esEvent))
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(agg.(*ClusterAggregate).caCertBundle).To(Equal(expectedValue))
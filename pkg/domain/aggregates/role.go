@@ -0,0 +1,207 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/domain/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
+	domainErrors "github.com/finleap-connect/monoskope/pkg/domain/errors"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+)
+
+// RoleAggregate is an aggregate for Roles defined in addition to the built-in roles.
+type RoleAggregate struct {
+	*DomainAggregateBase
+	aggregateManager es.AggregateStore
+	name             string
+	scopes           []string
+	k8sClusterRole   string
+}
+
+// NewRoleAggregate creates a new RoleAggregate
+func NewRoleAggregate(aggregateManager es.AggregateStore) es.Aggregate {
+	return &RoleAggregate{
+		DomainAggregateBase: &DomainAggregateBase{
+			BaseAggregate: es.NewBaseAggregate(aggregates.Role),
+		},
+		aggregateManager: aggregateManager,
+	}
+}
+
+// HandleCommand implements the HandleCommand method of the Aggregate interface.
+func (a *RoleAggregate) HandleCommand(ctx context.Context, cmd es.Command) (*es.CommandReply, error) {
+	if err := a.validate(ctx, cmd); err != nil {
+		return nil, err
+	}
+	return a.execute(ctx, cmd)
+}
+
+func (a *RoleAggregate) validate(ctx context.Context, cmd es.Command) error {
+	switch cmd := cmd.(type) {
+	case *commands.CreateRoleCommand:
+		if a.Exists() {
+			return domainErrors.ErrRoleAlreadyExists
+		}
+		if err := roles.ValidateRole(cmd.GetName()); err == nil || cmd.GetName() == string(roles.K8sOperator) {
+			return domainErrors.ErrRoleAlreadyExists
+		}
+		if err := validateRoleScopes(cmd.GetScopes().GetValues()); err != nil {
+			return err
+		}
+
+		// Get all aggregates of same type
+		aggregates, err := a.aggregateManager.All(ctx, a.Type())
+		if err != nil {
+			return err
+		}
+		if FindRole(aggregates, cmd.GetName()) != nil {
+			return domainErrors.ErrRoleAlreadyExists
+		}
+		return nil
+	case *commands.UpdateRoleCommand:
+		if err := a.Validate(ctx, cmd); err != nil {
+			return err
+		}
+		if cmd.Scopes == nil {
+			return nil
+		}
+		return validateRoleScopes(cmd.GetScopes().GetValues())
+	case *commands.DeleteRoleCommand:
+		if err := a.Validate(ctx, cmd); err != nil {
+			return err
+		}
+
+		// Roles which are still bound to users can not be deleted
+		roleBindings, err := a.aggregateManager.All(ctx, aggregates.UserRoleBinding)
+		if err != nil {
+			return err
+		}
+		for _, value := range roleBindings {
+			if d, ok := value.(*UserRoleBindingAggregate); ok && !d.Deleted() && string(d.role) == a.name {
+				return domainErrors.ErrRoleInUse
+			}
+		}
+		return nil
+	default:
+		return a.Validate(ctx, cmd)
+	}
+}
+
+func validateRoleScopes(values []string) error {
+	if len(values) == 0 {
+		return domainErrors.ErrInvalidArgument("at least one scope is required")
+	}
+	for _, scope := range values {
+		if err := scopes.ValidateScope(scope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindRole returns the not deleted role with the given name or nil if there is none
+func FindRole(values []es.Aggregate, name string) *RoleAggregate {
+	for _, value := range values {
+		d, ok := value.(*RoleAggregate)
+		if ok && !d.Deleted() && d.name == name {
+			return d
+		}
+	}
+	return nil
+}
+
+// Name returns the name of the role.
+func (a *RoleAggregate) Name() string {
+	return a.name
+}
+
+// AllowsScope returns if the role can be bound to the given scope.
+func (a *RoleAggregate) AllowsScope(scope string) bool {
+	for _, s := range a.scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// K8sClusterRole returns the name of the Kubernetes ClusterRole granted within clusters.
+func (a *RoleAggregate) K8sClusterRole() string {
+	return a.k8sClusterRole
+}
+
+func (a *RoleAggregate) execute(ctx context.Context, cmd es.Command) (*es.CommandReply, error) {
+	switch cmd := cmd.(type) {
+	case *commands.CreateRoleCommand:
+		ed := es.ToEventDataFromProto(&eventdata.RoleCreated{
+			Name:           cmd.GetName(),
+			Description:    cmd.GetDescription(),
+			Scopes:         cmd.GetScopes().GetValues(),
+			K8SClusterRole: cmd.GetK8SClusterRole(),
+		})
+		_ = a.AppendEvent(ctx, events.RoleCreated, ed)
+	case *commands.UpdateRoleCommand:
+		data := &eventdata.RoleUpdated{
+			Description:    cmd.GetDescription(),
+			K8SClusterRole: cmd.GetK8SClusterRole(),
+		}
+		if cmd.Scopes != nil {
+			data.Scopes = &eventdata.StringList{Values: cmd.GetScopes().GetValues()}
+		}
+		_ = a.AppendEvent(ctx, events.RoleUpdated, es.ToEventDataFromProto(data))
+	case *commands.DeleteRoleCommand:
+		_ = a.AppendEvent(ctx, events.RoleDeleted, nil)
+	default:
+		return nil, fmt.Errorf("couldn't handle command of type '%s'", cmd.CommandType())
+	}
+	return a.DefaultReply(), nil
+}
+
+// ApplyEvent implements the ApplyEvent method of the Aggregate interface.
+func (a *RoleAggregate) ApplyEvent(event es.Event) error {
+	switch event.EventType() {
+	case events.RoleCreated:
+		data := &eventdata.RoleCreated{}
+		if err := event.Data().ToProto(data); err != nil {
+			return err
+		}
+		a.name = data.GetName()
+		a.scopes = data.GetScopes()
+		a.k8sClusterRole = data.GetK8SClusterRole()
+	case events.RoleUpdated:
+		data := &eventdata.RoleUpdated{}
+		if err := event.Data().ToProto(data); err != nil {
+			return err
+		}
+		if data.Scopes != nil {
+			a.scopes = data.Scopes.Values
+		}
+		if data.K8SClusterRole != nil {
+			a.k8sClusterRole = data.K8SClusterRole.Value
+		}
+	case events.RoleDeleted:
+		a.SetDeleted(true)
+	default:
+		return fmt.Errorf("couldn't handle event of type '%s'", event.EventType())
+	}
+	return nil
+}
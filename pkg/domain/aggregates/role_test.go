@@ -0,0 +1,136 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregates
+
+import (
+	"context"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	cmd "github.com/finleap-connect/monoskope/pkg/domain/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
+	domainErrors "github.com/finleap-connect/monoskope/pkg/domain/errors"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Unit Test for Role Aggregate", func() {
+	const expectedRoleName = "auditor"
+
+	createRole := func(ctx context.Context, agg es.Aggregate, name string, roleScopes ...string) (*es.CommandReply, error) {
+		esCommand, ok := cmd.NewCreateRoleCommand(agg.ID()).(*cmd.CreateRoleCommand)
+		Expect(ok).To(BeTrue())
+		esCommand.Name = name
+		esCommand.Scopes = &commanddata.RoleScopes{Values: roleScopes}
+		esCommand.K8SClusterRole = "view"
+		return agg.HandleCommand(ctx, esCommand)
+	}
+
+	It("should set the data from a command to the resultant event", func() {
+		ctx := createSysAdminCtx()
+		agg := NewRoleAggregate(NewTestAggregateManager())
+
+		_, err := createRole(ctx, agg, expectedRoleName, string(scopes.Tenant))
+		Expect(err).NotTo(HaveOccurred())
+
+		event := agg.UncommittedEvents()[0]
+		Expect(event.EventType()).To(Equal(events.RoleCreated))
+
+		data := new(eventdata.RoleCreated)
+		Expect(event.Data().ToProto(data)).To(Succeed())
+		Expect(data.Name).To(Equal(expectedRoleName))
+		Expect(data.Scopes).To(ConsistOf(string(scopes.Tenant)))
+		Expect(data.K8SClusterRole).To(Equal("view"))
+
+		Expect(agg.ApplyEvent(event)).To(Succeed())
+		Expect(agg.(*RoleAggregate).Name()).To(Equal(expectedRoleName))
+		Expect(agg.(*RoleAggregate).AllowsScope(string(scopes.Tenant))).To(BeTrue())
+		Expect(agg.(*RoleAggregate).AllowsScope(string(scopes.System))).To(BeFalse())
+	})
+
+	It("should reject built-in and duplicate role names", func() {
+		ctx := createSysAdminCtx()
+		aggManager := NewTestAggregateManager()
+
+		_, err := createRole(ctx, NewRoleAggregate(aggManager), string(roles.Admin), string(scopes.Tenant))
+		Expect(err).To(Equal(domainErrors.ErrRoleAlreadyExists))
+
+		agg := NewRoleAggregate(aggManager)
+		_, err = createRole(ctx, agg, expectedRoleName, string(scopes.Tenant))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(agg.ApplyEvent(agg.UncommittedEvents()[0])).To(Succeed())
+		agg.IncrementVersion()
+		aggManager.(*aggregateTestStore).Add(agg)
+
+		_, err = createRole(ctx, NewRoleAggregate(aggManager), expectedRoleName, string(scopes.Tenant))
+		Expect(err).To(Equal(domainErrors.ErrRoleAlreadyExists))
+	})
+
+	It("should reject unknown scopes", func() {
+		ctx := createSysAdminCtx()
+		_, err := createRole(ctx, NewRoleAggregate(NewTestAggregateManager()), expectedRoleName, "galaxy")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not delete roles which are still bound", func() {
+		ctx := createSysAdminCtx()
+		aggManager := NewTestAggregateManager()
+
+		roleAgg := NewRoleAggregate(aggManager)
+		_, err := createRole(ctx, roleAgg, expectedRoleName, string(scopes.Tenant))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roleAgg.ApplyEvent(roleAgg.UncommittedEvents()[0])).To(Succeed())
+		roleAgg.IncrementVersion()
+		aggManager.(*aggregateTestStore).Add(roleAgg)
+
+		userAgg := NewUserAggregate(aggManager)
+		ret, err := createUser(ctx, userAgg)
+		Expect(err).NotTo(HaveOccurred())
+		userAgg.IncrementVersion()
+		aggManager.(*aggregateTestStore).Add(userAgg)
+
+		bindingAgg := NewUserRoleBindingAggregate(aggManager)
+		esCommand, ok := cmd.NewCreateUserRoleBindingCommand(uuid.New()).(*cmd.CreateUserRoleBindingCommand)
+		Expect(ok).To(BeTrue())
+		esCommand.UserId = ret.Id.String()
+		esCommand.Role = expectedRoleName
+		esCommand.Scope = string(scopes.Tenant)
+		esCommand.Resource = wrapperspb.String(uuid.NewString())
+		_, err = bindingAgg.HandleCommand(ctx, esCommand)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bindingAgg.ApplyEvent(bindingAgg.UncommittedEvents()[0])).To(Succeed())
+		bindingAgg.IncrementVersion()
+		aggManager.(*aggregateTestStore).Add(bindingAgg)
+
+		// the role can not be bound to a scope it does not allow
+		esCommand, ok = cmd.NewCreateUserRoleBindingCommand(uuid.New()).(*cmd.CreateUserRoleBindingCommand)
+		Expect(ok).To(BeTrue())
+		esCommand.UserId = ret.Id.String()
+		esCommand.Role = expectedRoleName
+		esCommand.Scope = string(scopes.System)
+		_, err = NewUserRoleBindingAggregate(aggManager).HandleCommand(ctx, esCommand)
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		_, err = roleAgg.HandleCommand(ctx, cmd.NewDeleteRoleCommand(roleAgg.ID()))
+		Expect(err).To(Equal(domainErrors.ErrRoleInUse))
+	})
+})
@@ -18,6 +18,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
 	cmd "github.com/finleap-connect/monoskope/pkg/domain/commands"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
@@ -44,6 +45,8 @@ var (
 	expectedClusterName             = "one-cluster"
 	expectedClusterApiServerAddress = "one.example.com"
 	expectedClusterCACertBundle     = []byte("This should be a certificate")
+	expectedClusterDescription      = "The one cluster"
+	expectedClusterLabels           = map[string]string{"env": "dev", "monoskope.io/region": "eu"}
 )
 
 func TestAggregates(t *testing.T) {
@@ -71,6 +74,8 @@ func createCluster(ctx context.Context, agg es.Aggregate) (*es.CommandReply, err
 	esCommand.CreateCluster.Name = expectedClusterName
 	esCommand.CreateCluster.ApiServerAddress = expectedClusterApiServerAddress
 	esCommand.CreateCluster.CaCertBundle = expectedClusterCACertBundle
+	esCommand.CreateCluster.Description = expectedClusterDescription
+	esCommand.CreateCluster.Labels = &commanddata.ClusterLabels{Values: expectedClusterLabels}
 
 	return agg.HandleCommand(ctx, esCommand)
 }
@@ -78,6 +83,7 @@ func createCluster(ctx context.Context, agg es.Aggregate) (*es.CommandReply, err
 type aggregateTestStore struct {
 	bindings map[uuid.UUID]es.Aggregate
 	users    map[uuid.UUID]es.Aggregate
+	roles    map[uuid.UUID]es.Aggregate
 }
 
 // NewTestAggregateManager creates a new dummy AggregateHandler which allows observing interactions and injecting test data.
@@ -85,6 +91,7 @@ func NewTestAggregateManager() es.AggregateStore {
 	return &aggregateTestStore{
 		bindings: make(map[uuid.UUID]es.Aggregate),
 		users:    make(map[uuid.UUID]es.Aggregate),
+		roles:    make(map[uuid.UUID]es.Aggregate),
 	}
 }
 
@@ -94,6 +101,8 @@ func (tas *aggregateTestStore) Add(agg es.Aggregate) {
 		tas.users[agg.ID()] = agg
 	case aggregates.UserRoleBinding:
 		tas.bindings[agg.ID()] = agg
+	case aggregates.Role:
+		tas.roles[agg.ID()] = agg
 	}
 }
 
@@ -112,6 +121,8 @@ func (tas *aggregateTestStore) All(ctx context.Context, atype es.AggregateType)
 		retmap = tas.bindings
 	case aggregates.User:
 		retmap = tas.users
+	case aggregates.Role:
+		retmap = tas.roles
 	}
 
 	values := make([]es.Aggregate, 0, len(retmap))
@@ -142,6 +153,9 @@ func (tas *aggregateTestStore) Get(ctx context.Context, atype es.AggregateType,
 	case aggregates.User:
 		retmap = tas.users
 		notFoundVal = errors.ErrUserNotFound
+	case aggregates.Role:
+		retmap = tas.roles
+		notFoundVal = errors.ErrRoleNotFound
 	default:
 		return nil, errors.ErrUnknownAggregateType
 	}
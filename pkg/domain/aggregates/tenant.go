@@ -25,14 +25,17 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
 	domainErrors "github.com/finleap-connect/monoskope/pkg/domain/errors"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
 )
 
 // TenantAggregate is an aggregate for Tenants.
 type TenantAggregate struct {
 	*DomainAggregateBase
-	aggregateManager es.AggregateStore
-	name             string
-	prefix           string
+	aggregateManager   es.AggregateStore
+	name               string
+	prefix             string
+	parentId           uuid.UUID
+	maxClusterBindings uint32
 }
 
 // NewTenantAggregate creates a new TenantAggregate
@@ -70,12 +73,74 @@ func (a *TenantAggregate) validate(ctx context.Context, cmd es.Command) error {
 		if containsTenant(aggregates, cmd.GetName()) {
 			return domainErrors.ErrTenantAlreadyExists
 		}
+		return a.validateParent(ctx, cmd.GetParentId())
+	case *commands.UpdateTenantCommand:
+		if err := a.Validate(ctx, cmd); err != nil {
+			return err
+		}
+		if cmd.ParentId == nil {
+			return nil
+		}
+		return a.validateParent(ctx, cmd.GetParentId().GetValue())
+	case *commands.DeleteTenantCommand:
+		if err := a.Validate(ctx, cmd); err != nil {
+			return err
+		}
+
+		// Tenants which are parent of other tenants can not be deleted
+		aggregates, err := a.aggregateManager.All(ctx, a.Type())
+		if err != nil {
+			return err
+		}
+		for _, value := range aggregates {
+			if d, ok := value.(*TenantAggregate); ok && !d.Deleted() && d.parentId == a.ID() {
+				return domainErrors.ErrTenantHasChildren
+			}
+		}
 		return nil
 	default:
 		return a.Validate(ctx, cmd)
 	}
 }
 
+// validateParent validates that the parent tenant exists and that it is not a descendant of this tenant
+func (a *TenantAggregate) validateParent(ctx context.Context, parentId string) error {
+	if parentId == "" {
+		return nil
+	}
+	id, err := uuid.Parse(parentId)
+	if err != nil {
+		return domainErrors.ErrInvalidArgument("parent id is invalid")
+	}
+
+	visited := make(map[uuid.UUID]bool)
+	for id != uuid.Nil {
+		if id == a.ID() || visited[id] {
+			return domainErrors.ErrInvalidArgument("parent tenant would create a cycle")
+		}
+		visited[id] = true
+
+		parent, err := a.aggregateManager.Get(ctx, aggregates.Tenant, id)
+		if err != nil {
+			return err
+		}
+		if !parent.Exists() || parent.Deleted() {
+			return domainErrors.ErrTenantNotFound
+		}
+		parentTenant, ok := parent.(*TenantAggregate)
+		if !ok {
+			return domainErrors.ErrTenantNotFound
+		}
+		id = parentTenant.parentId
+	}
+	return nil
+}
+
+// MaxClusterBindings returns the maximum number of cluster bindings of the tenant, 0 means unlimited.
+func (a *TenantAggregate) MaxClusterBindings() uint32 {
+	return a.maxClusterBindings
+}
+
 func containsTenant(values []es.Aggregate, name string) bool {
 	for _, value := range values {
 		d, ok := value.(*TenantAggregate)
@@ -92,15 +157,24 @@ func containsTenant(values []es.Aggregate, name string) bool {
 func (a *TenantAggregate) execute(ctx context.Context, cmd es.Command) (*es.CommandReply, error) {
 	switch cmd := cmd.(type) {
 	case *commands.CreateTenantCommand:
-		ed := es.ToEventDataFromProto(&eventdata.TenantCreated{
-			Name:   cmd.GetName(),
-			Prefix: cmd.GetPrefix()})
-		_ = a.AppendEvent(ctx, events.TenantCreated, ed)
+		ed := es.ToEventDataFromProto(&eventdata.TenantCreatedV2{
+			Name:               cmd.GetName(),
+			Prefix:             cmd.GetPrefix(),
+			ParentId:           cmd.GetParentId(),
+			CostCentre:         cmd.GetCostCentre(),
+			Contact:            cmd.GetContact(),
+			MaxClusterBindings: cmd.GetMaxClusterBindings(),
+		})
+		_ = a.AppendEvent(ctx, events.TenantCreatedV2, ed)
 	case *commands.UpdateTenantCommand:
-		ed := es.ToEventDataFromProto(&eventdata.TenantUpdated{
-			Name: cmd.GetName(),
+		ed := es.ToEventDataFromProto(&eventdata.TenantUpdatedV2{
+			Name:               cmd.GetName(),
+			ParentId:           cmd.GetParentId(),
+			CostCentre:         cmd.GetCostCentre(),
+			Contact:            cmd.GetContact(),
+			MaxClusterBindings: cmd.GetMaxClusterBindings(),
 		})
-		_ = a.AppendEvent(ctx, events.TenantUpdated, ed)
+		_ = a.AppendEvent(ctx, events.TenantUpdatedV2, ed)
 	case *commands.DeleteTenantCommand:
 		_ = a.AppendEvent(ctx, events.TenantDeleted, nil)
 	default:
@@ -125,6 +199,33 @@ func (a *TenantAggregate) ApplyEvent(event es.Event) error {
 			return err
 		}
 		a.name = data.GetName().GetValue()
+	case events.TenantCreatedV2:
+		data := &eventdata.TenantCreatedV2{}
+		if err := event.Data().ToProto(data); err != nil {
+			return err
+		}
+		a.name = data.GetName()
+		a.prefix = data.GetPrefix()
+		a.maxClusterBindings = data.GetMaxClusterBindings()
+		if err := a.setParentId(data.GetParentId()); err != nil {
+			return err
+		}
+	case events.TenantUpdatedV2:
+		data := &eventdata.TenantUpdatedV2{}
+		if err := event.Data().ToProto(data); err != nil {
+			return err
+		}
+		if data.Name != nil {
+			a.name = data.Name.Value
+		}
+		if data.MaxClusterBindings != nil {
+			a.maxClusterBindings = data.MaxClusterBindings.Value
+		}
+		if data.ParentId != nil {
+			if err := a.setParentId(data.ParentId.Value); err != nil {
+				return err
+			}
+		}
 	case events.TenantDeleted:
 		a.SetDeleted(true)
 	default:
@@ -132,3 +233,16 @@ func (a *TenantAggregate) ApplyEvent(event es.Event) error {
 	}
 	return nil
 }
+
+func (a *TenantAggregate) setParentId(parentId string) error {
+	if parentId == "" {
+		a.parentId = uuid.Nil
+		return nil
+	}
+	id, err := uuid.Parse(parentId)
+	if err != nil {
+		return err
+	}
+	a.parentId = id
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	domainErrors "github.com/finleap-connect/monoskope/pkg/domain/errors"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // TenantClusterBindingAggregate is an aggregate for TenantClusterBindings.
@@ -33,6 +34,7 @@ type TenantClusterBindingAggregate struct {
 	aggregateManager es.AggregateStore
 	tenantID         uuid.UUID // ID of the referenced tenant
 	clusterID        uuid.UUID // ID of the referenced cluster
+	clusterSelector  string    // Label selector of the referenced clusters
 }
 
 // NewTenantClusterBindingAggregate creates a new TenantClusterBindingAggregate
@@ -54,8 +56,9 @@ func (a *TenantClusterBindingAggregate) HandleCommand(ctx context.Context, cmd e
 	switch cmd := cmd.(type) {
 	case *commands.CreateTenantClusterBindingCommand:
 		ed := es.ToEventDataFromProto(&eventdata.TenantClusterBindingCreated{
-			TenantId:  cmd.GetTenantId(),
-			ClusterId: cmd.GetClusterId(),
+			TenantId:        cmd.GetTenantId(),
+			ClusterId:       cmd.GetClusterId(),
+			ClusterSelector: cmd.GetClusterSelector(),
 		})
 		_ = a.AppendEvent(ctx, events.TenantClusterBindingCreated, ed)
 	case *commands.DeleteTenantClusterBindingCommand:
@@ -78,9 +81,8 @@ func (a *TenantClusterBindingAggregate) validate(ctx context.Context, cmd es.Com
 		if err != nil {
 			return domainErrors.ErrInvalidArgument("tenant id is invalid")
 		}
-		clusterId, err := uuid.Parse(cmd.GetClusterId())
-		if err != nil {
-			return domainErrors.ErrInvalidArgument("cluster id is invalid")
+		if (cmd.GetClusterId() == "") == (cmd.GetClusterSelector() == "") {
+			return domainErrors.ErrInvalidArgument("either cluster id or cluster selector is required")
 		}
 
 		tenantAggregate, err := a.aggregateManager.Get(ctx, aggregates.Tenant, tenantId)
@@ -91,12 +93,24 @@ func (a *TenantClusterBindingAggregate) validate(ctx context.Context, cmd es.Com
 			return domainErrors.ErrTenantNotFound
 		}
 
-		clusterAggregate, err := a.aggregateManager.Get(ctx, aggregates.Cluster, clusterId)
-		if err != nil {
-			return err
-		}
-		if !clusterAggregate.Exists() || clusterAggregate.Deleted() {
-			return domainErrors.ErrClusterNotFound
+		var clusterId uuid.UUID
+		if cmd.GetClusterSelector() != "" {
+			if _, err := labels.Parse(cmd.GetClusterSelector()); err != nil {
+				return domainErrors.ErrInvalidArgument(fmt.Sprintf("cluster selector is invalid: %s", err))
+			}
+		} else {
+			clusterId, err = uuid.Parse(cmd.GetClusterId())
+			if err != nil {
+				return domainErrors.ErrInvalidArgument("cluster id is invalid")
+			}
+
+			clusterAggregate, err := a.aggregateManager.Get(ctx, aggregates.Cluster, clusterId)
+			if err != nil {
+				return err
+			}
+			if !clusterAggregate.Exists() || clusterAggregate.Deleted() {
+				return domainErrors.ErrClusterNotFound
+			}
 		}
 
 		// Get all aggregates of same type
@@ -104,20 +118,27 @@ func (a *TenantClusterBindingAggregate) validate(ctx context.Context, cmd es.Com
 		if err != nil {
 			return err
 		}
-		if containsTenantClusterBinding(aggs, tenantId, clusterId) {
+		if containsTenantClusterBinding(aggs, tenantId, clusterId, cmd.GetClusterSelector()) {
 			return domainErrors.ErrTenantClusterBindingAlreadyExists
 		}
+
+		// Enforce the maximum number of cluster bindings of the tenant if set
+		if tenant, ok := tenantAggregate.(*TenantAggregate); ok && tenant.MaxClusterBindings() > 0 {
+			if countTenantClusterBindings(aggs, tenantId) >= int(tenant.MaxClusterBindings()) {
+				return domainErrors.ErrTenantClusterBindingQuotaExceeded
+			}
+		}
 		return nil
 	default:
 		return a.Validate(ctx, cmd)
 	}
 }
 
-func containsTenantClusterBinding(values []es.Aggregate, tenantId, clusterId uuid.UUID) bool {
+func containsTenantClusterBinding(values []es.Aggregate, tenantId, clusterId uuid.UUID, clusterSelector string) bool {
 	for _, value := range values {
 		d, ok := value.(*TenantClusterBindingAggregate)
 		if ok {
-			if !d.Deleted() && d.tenantID == tenantId && d.clusterID == clusterId {
+			if !d.Deleted() && d.tenantID == tenantId && d.clusterID == clusterId && d.clusterSelector == clusterSelector {
 				return true
 			}
 		}
@@ -139,13 +160,17 @@ func (a *TenantClusterBindingAggregate) ApplyEvent(event es.Event) error {
 		if err != nil {
 			return err
 		}
-		clusterId, err := uuid.Parse(ed.GetClusterId())
-		if err != nil {
-			return err
-		}
-
 		a.tenantID = tenantId
-		a.clusterID = clusterId
+		a.clusterSelector = ed.GetClusterSelector()
+
+		// Bindings by cluster selector do not reference a single cluster
+		if a.clusterSelector == "" {
+			clusterId, err := uuid.Parse(ed.GetClusterId())
+			if err != nil {
+				return err
+			}
+			a.clusterID = clusterId
+		}
 	case events.TenantClusterBindingDeleted:
 		a.SetDeleted(true)
 	default:
@@ -153,3 +178,14 @@ func (a *TenantClusterBindingAggregate) ApplyEvent(event es.Event) error {
 	}
 	return nil
 }
+
+func countTenantClusterBindings(values []es.Aggregate, tenantId uuid.UUID) int {
+	count := 0
+	for _, value := range values {
+		d, ok := value.(*TenantClusterBindingAggregate)
+		if ok && !d.Deleted() && d.tenantID == tenantId {
+			count++
+		}
+	}
+	return count
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/commands"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	domainErrors "github.com/finleap-connect/monoskope/pkg/domain/errors"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -97,4 +98,79 @@ var _ = Describe("UnitTest: TenantClusterBindingAggregate", func() {
 		Expect(binding.Deleted()).To(BeTrue())
 		Expect(binding.Version()).To(BeNumerically("==", 2))
 	})
+	It("should handle the CreateTenantClusterBindingCommand with a cluster selector correctly", func() {
+		aggManager := esMock.NewMockAggregateStore(mockCtrl)
+
+		// Setup aggregates
+		tenant := NewTenantAggregate(aggManager)
+		binding := NewTenantClusterBindingAggregate(aggManager)
+		tenant.IncrementVersion()
+
+		// Build create command for binding
+		createCommand := commands.NewCreateTenantClusterBindingCommand(uuid.Nil).(*commands.CreateTenantClusterBindingCommand)
+		createCommand.TenantId = tenant.ID().String()
+		createCommand.ClusterSelector = "env=dev"
+
+		// Define expected calls to mock, the cluster is not resolved
+		aggManager.EXPECT().Get(ctx, aggregates.Tenant, tenant.ID()).Return(tenant, nil)
+		aggManager.EXPECT().All(ctx, aggregates.TenantClusterBinding).Return(make([]es.Aggregate, 0), nil)
+
+		reply, err := binding.HandleCommand(ctx, createCommand)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reply).ToNot(BeNil())
+
+		event := binding.UncommittedEvents()[0]
+		data := &eventdata.TenantClusterBindingCreated{}
+		err = event.Data().ToProto(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data.TenantId).To(Equal(tenant.ID().String()))
+		Expect(data.ClusterId).To(BeEmpty())
+		Expect(data.ClusterSelector).To(Equal("env=dev"))
+
+		err = binding.ApplyEvent(event)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(binding.(*TenantClusterBindingAggregate).clusterSelector).To(Equal("env=dev"))
+	})
+
+	It("should reject a CreateTenantClusterBindingCommand with an invalid target", func() {
+		aggManager := esMock.NewMockAggregateStore(mockCtrl)
+		binding := NewTenantClusterBindingAggregate(aggManager)
+
+		createCommand := commands.NewCreateTenantClusterBindingCommand(uuid.Nil).(*commands.CreateTenantClusterBindingCommand)
+		createCommand.TenantId = uuid.New().String()
+
+		// neither cluster id nor selector
+		_, err := binding.HandleCommand(ctx, createCommand)
+		Expect(err).To(HaveOccurred())
+
+		// both cluster id and selector
+		createCommand.ClusterId = uuid.New().String()
+		createCommand.ClusterSelector = "env=dev"
+		_, err = binding.HandleCommand(ctx, createCommand)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject a CreateTenantClusterBindingCommand if the tenant quota is exceeded", func() {
+		aggManager := esMock.NewMockAggregateStore(mockCtrl)
+
+		// Setup a tenant allowing a single cluster binding which already has one
+		tenant := NewTenantAggregate(aggManager)
+		tenant.IncrementVersion()
+		tenant.(*TenantAggregate).maxClusterBindings = 1
+		existingBinding := NewTenantClusterBindingAggregate(aggManager)
+		existingBinding.IncrementVersion()
+		existingBinding.(*TenantClusterBindingAggregate).tenantID = tenant.ID()
+		existingBinding.(*TenantClusterBindingAggregate).clusterSelector = "env=prod"
+		binding := NewTenantClusterBindingAggregate(aggManager)
+
+		createCommand := commands.NewCreateTenantClusterBindingCommand(uuid.Nil).(*commands.CreateTenantClusterBindingCommand)
+		createCommand.TenantId = tenant.ID().String()
+		createCommand.ClusterSelector = "env=dev"
+
+		aggManager.EXPECT().Get(ctx, aggregates.Tenant, tenant.ID()).Return(tenant, nil)
+		aggManager.EXPECT().All(ctx, aggregates.TenantClusterBinding).Return([]es.Aggregate{existingBinding}, nil)
+
+		_, err := binding.HandleCommand(ctx, createCommand)
+		Expect(err).To(Equal(domainErrors.ErrTenantClusterBindingQuotaExceeded))
+	})
 })
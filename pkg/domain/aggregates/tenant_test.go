@@ -17,12 +17,20 @@ package aggregates
 import (
 	"time"
 
+	esMock "github.com/finleap-connect/monoskope/internal/test/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/domain/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	domainErrors "github.com/finleap-connect/monoskope/pkg/domain/errors"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
@@ -43,10 +51,10 @@ var _ = Describe("Unit Test for the Tenant Aggregate", func() {
 
 		event := agg.UncommittedEvents()[0]
 
-		Expect(event.EventType()).To(Equal(events.TenantCreated))
+		Expect(event.EventType()).To(Equal(events.TenantCreatedV2))
 		Expect(event.AggregateID()).ToNot(Equal(inID))
 
-		data := &eventdata.TenantCreated{}
+		data := &eventdata.TenantCreatedV2{}
 		err = event.Data().ToProto(data)
 		Expect(err).NotTo(HaveOccurred())
 
@@ -72,4 +80,124 @@ var _ = Describe("Unit Test for the Tenant Aggregate", func() {
 		Expect(agg.(*TenantAggregate).prefix).To(Equal(expectedPrefix))
 
 	})
+
+	It("should apply the data from a V2 event to the aggregate", func() {
+		ctx := createSysAdminCtx()
+		agg := NewTenantAggregate(NewTestAggregateManager())
+		parentId := uuid.New()
+
+		ed := es.ToEventDataFromProto(&eventdata.TenantCreatedV2{
+			Name:               expectedTenantName,
+			Prefix:             expectedPrefix,
+			ParentId:           parentId.String(),
+			MaxClusterBindings: 2,
+		})
+		esEvent := es.NewEvent(ctx, events.TenantCreatedV2, ed, time.Now().UTC(),
+			agg.Type(), agg.ID(), agg.Version())
+		Expect(agg.ApplyEvent(esEvent)).To(Succeed())
+
+		tenant := agg.(*TenantAggregate)
+		Expect(tenant.name).To(Equal(expectedTenantName))
+		Expect(tenant.prefix).To(Equal(expectedPrefix))
+		Expect(tenant.parentId).To(Equal(parentId))
+		Expect(tenant.MaxClusterBindings()).To(Equal(uint32(2)))
+
+		ed = es.ToEventDataFromProto(&eventdata.TenantUpdatedV2{
+			ParentId:           wrapperspb.String(""),
+			MaxClusterBindings: wrapperspb.UInt32(0),
+		})
+		esEvent = es.NewEvent(ctx, events.TenantUpdatedV2, ed, time.Now().UTC(),
+			agg.Type(), agg.ID(), agg.Version())
+		Expect(agg.ApplyEvent(esEvent)).To(Succeed())
+
+		Expect(tenant.name).To(Equal(expectedTenantName))
+		Expect(tenant.parentId).To(Equal(uuid.Nil))
+		Expect(tenant.MaxClusterBindings()).To(Equal(uint32(0)))
+	})
+
+	Context("tenant hierarchy", func() {
+		var mockCtrl *gomock.Controller
+		ctx := createSysAdminCtx()
+
+		BeforeEach(func() {
+			mockCtrl = gomock.NewController(GinkgoT())
+		})
+
+		AfterEach(func() {
+			mockCtrl.Finish()
+		})
+
+		It("should create a tenant with an existing parent", func() {
+			aggManager := esMock.NewMockAggregateStore(mockCtrl)
+			parent := NewTenantAggregate(aggManager)
+			parent.IncrementVersion()
+			agg := NewTenantAggregate(aggManager)
+
+			createCommand := commands.NewCreateTenantCommand(agg.ID()).(*commands.CreateTenantCommand)
+			createCommand.Name = expectedTenantName
+			createCommand.Prefix = expectedPrefix
+			createCommand.ParentId = parent.ID().String()
+
+			aggManager.EXPECT().All(ctx, aggregates.Tenant).Return([]es.Aggregate{parent}, nil)
+			aggManager.EXPECT().Get(ctx, aggregates.Tenant, parent.ID()).Return(parent, nil)
+
+			_, err := agg.HandleCommand(ctx, createCommand)
+			Expect(err).NotTo(HaveOccurred())
+
+			data := &eventdata.TenantCreatedV2{}
+			Expect(agg.UncommittedEvents()[0].Data().ToProto(data)).To(Succeed())
+			Expect(data.ParentId).To(Equal(parent.ID().String()))
+		})
+
+		It("should fail to create a tenant with a non existing parent", func() {
+			aggManager := esMock.NewMockAggregateStore(mockCtrl)
+			parent := NewTenantAggregate(aggManager)
+			agg := NewTenantAggregate(aggManager)
+
+			createCommand := commands.NewCreateTenantCommand(agg.ID()).(*commands.CreateTenantCommand)
+			createCommand.Name = expectedTenantName
+			createCommand.Prefix = expectedPrefix
+			createCommand.ParentId = parent.ID().String()
+
+			aggManager.EXPECT().All(ctx, aggregates.Tenant).Return([]es.Aggregate{}, nil)
+			aggManager.EXPECT().Get(ctx, aggregates.Tenant, parent.ID()).Return(parent, nil)
+
+			_, err := agg.HandleCommand(ctx, createCommand)
+			Expect(err).To(Equal(domainErrors.ErrTenantNotFound))
+		})
+
+		It("should fail to update the parent if it would create a cycle", func() {
+			aggManager := esMock.NewMockAggregateStore(mockCtrl)
+			agg := NewTenantAggregate(aggManager)
+			agg.IncrementVersion()
+			child := NewTenantAggregate(aggManager)
+			child.IncrementVersion()
+			child.(*TenantAggregate).parentId = agg.ID()
+
+			updateCommand := commands.NewUpdateTenantCommand(agg.ID()).(*commands.UpdateTenantCommand)
+			updateCommand.ParentId = wrapperspb.String(child.ID().String())
+
+			aggManager.EXPECT().Get(ctx, aggregates.Tenant, child.ID()).Return(child, nil)
+
+			_, err := agg.HandleCommand(ctx, updateCommand)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(agg.UncommittedEvents()).To(BeEmpty())
+		})
+
+		It("should fail to delete a tenant with children", func() {
+			aggManager := esMock.NewMockAggregateStore(mockCtrl)
+			agg := NewTenantAggregate(aggManager)
+			agg.IncrementVersion()
+			child := NewTenantAggregate(aggManager)
+			child.IncrementVersion()
+			child.(*TenantAggregate).parentId = agg.ID()
+
+			deleteCommand := commands.NewDeleteTenantCommand(agg.ID())
+
+			aggManager.EXPECT().All(ctx, aggregates.Tenant).Return([]es.Aggregate{agg, child}, nil)
+
+			_, err := agg.HandleCommand(ctx, deleteCommand)
+			Expect(err).To(Equal(domainErrors.ErrTenantHasChildren))
+		})
+	})
 })
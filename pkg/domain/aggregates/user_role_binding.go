@@ -71,12 +71,23 @@ func (a *UserRoleBindingAggregate) validate(ctx context.Context, cmd es.Command)
 		if userId, err = uuid.Parse(cmd.GetUserId()); err != nil {
 			return domainErrors.ErrInvalidArgument("user id is invalid")
 		}
-		if err := roles.ValidateRole(cmd.GetRole()); err != nil {
-			return err
-		}
 		if err := scopes.ValidateScope(cmd.GetScope()); err != nil {
 			return err
 		}
+		if err := roles.ValidateRole(cmd.GetRole()); err != nil {
+			// Roles which are not built-in must be defined and allowed for the scope
+			roleAggregates, allErr := a.aggregateManager.All(ctx, aggregates.Role)
+			if allErr != nil {
+				return allErr
+			}
+			role := FindRole(roleAggregates, cmd.GetRole())
+			if role == nil {
+				return err
+			}
+			if !role.AllowsScope(cmd.GetScope()) {
+				return domainErrors.ErrInvalidArgument(fmt.Sprintf("Role '%s' can not be bound to scope '%s'.", cmd.GetRole(), cmd.GetScope()))
+			}
+		}
 		if cmd.Resource != nil {
 			resourceValue := cmd.GetResource().GetValue()
 			if resource, err = uuid.Parse(resourceValue); err != nil {
@@ -84,6 +95,20 @@ func (a *UserRoleBindingAggregate) validate(ctx context.Context, cmd es.Command)
 			}
 		}
 
+		// Cluster scoped role bindings require an existing cluster as resource
+		if cmd.GetScope() == string(scopes.Cluster) {
+			if resource == uuid.Nil {
+				return domainErrors.ErrInvalidArgument("resource is required for cluster scope")
+			}
+			clusterAggregate, err := a.aggregateManager.Get(ctx, aggregates.Cluster, resource)
+			if err != nil {
+				return err
+			}
+			if !clusterAggregate.Exists() || clusterAggregate.Deleted() {
+				return domainErrors.ErrClusterNotFound
+			}
+		}
+
 		userAggregate, err := a.aggregateManager.Get(ctx, aggregates.User, userId)
 		if err != nil {
 			return err
@@ -17,12 +17,22 @@ package aggregates
 import (
 	"time"
 
+	esMock "github.com/finleap-connect/monoskope/internal/test/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/domain/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
+	domainErrors "github.com/finleap-connect/monoskope/pkg/domain/errors"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var _ = Describe("Unit Test for UserRoleBinding Aggregate", func() {
@@ -92,4 +102,68 @@ var _ = Describe("Unit Test for UserRoleBinding Aggregate", func() {
 
 	})
 
+	Context("cluster scope", func() {
+		var mockCtrl *gomock.Controller
+
+		BeforeEach(func() {
+			mockCtrl = gomock.NewController(GinkgoT())
+		})
+
+		AfterEach(func() {
+			mockCtrl.Finish()
+		})
+
+		newCreateClusterRoleBindingCommand := func(userId uuid.UUID, clusterId string) *commands.CreateUserRoleBindingCommand {
+			esCommand := commands.NewCreateUserRoleBindingCommand(uuid.New()).(*commands.CreateUserRoleBindingCommand)
+			esCommand.UserId = userId.String()
+			esCommand.Role = string(roles.OnCall)
+			esCommand.Scope = string(scopes.Cluster)
+			if clusterId != "" {
+				esCommand.Resource = wrapperspb.String(clusterId)
+			}
+			return esCommand
+		}
+
+		It("should create a role binding for an existing cluster", func() {
+			ctx := createSysAdminCtx()
+			aggManager := esMock.NewMockAggregateStore(mockCtrl)
+			user := NewUserAggregate(aggManager)
+			user.IncrementVersion()
+			cluster := NewClusterAggregate(aggManager)
+			cluster.IncrementVersion()
+			agg := NewUserRoleBindingAggregate(aggManager)
+
+			aggManager.EXPECT().Get(ctx, aggregates.Cluster, cluster.ID()).Return(cluster, nil)
+			aggManager.EXPECT().Get(ctx, aggregates.User, user.ID()).Return(user, nil)
+			aggManager.EXPECT().All(ctx, aggregates.UserRoleBinding).Return([]es.Aggregate{}, nil)
+
+			_, err := agg.HandleCommand(ctx, newCreateClusterRoleBindingCommand(user.ID(), cluster.ID().String()))
+			Expect(err).NotTo(HaveOccurred())
+
+			data := &eventdata.UserRoleAdded{}
+			Expect(agg.UncommittedEvents()[0].Data().ToProto(data)).To(Succeed())
+			Expect(data.Scope).To(Equal(string(scopes.Cluster)))
+			Expect(data.Resource).To(Equal(cluster.ID().String()))
+		})
+
+		It("should fail to create a role binding for a non existing cluster", func() {
+			ctx := createSysAdminCtx()
+			aggManager := esMock.NewMockAggregateStore(mockCtrl)
+			cluster := NewClusterAggregate(aggManager)
+			agg := NewUserRoleBindingAggregate(aggManager)
+
+			aggManager.EXPECT().Get(ctx, aggregates.Cluster, cluster.ID()).Return(cluster, nil)
+
+			_, err := agg.HandleCommand(ctx, newCreateClusterRoleBindingCommand(uuid.New(), cluster.ID().String()))
+			Expect(err).To(Equal(domainErrors.ErrClusterNotFound))
+		})
+
+		It("should fail to create a role binding without cluster", func() {
+			ctx := createSysAdminCtx()
+			agg := NewUserRoleBindingAggregate(esMock.NewMockAggregateStore(mockCtrl))
+
+			_, err := agg.HandleCommand(ctx, newCreateClusterRoleBindingCommand(uuid.New(), ""))
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
 })
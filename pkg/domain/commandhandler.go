@@ -53,6 +53,9 @@ func registerAggregates(esClient esApi.EventStoreClient) es.AggregateStore {
 	// TenantClusterBinding
 	es.DefaultAggregateRegistry.RegisterAggregate(func() es.Aggregate { return aggregates.NewTenantClusterBindingAggregate(aggregateManager) })
 
+	// Role
+	es.DefaultAggregateRegistry.RegisterAggregate(func() es.Aggregate { return aggregates.NewRoleAggregate(aggregateManager) })
+
 	return aggregateManager
 }
 
@@ -186,8 +189,13 @@ func setupUsers(ctx context.Context, handler es.CommandHandler) error {
 	return nil
 }
 
-// SetupCommandHandlerDomain sets up the necessary handlers/repositories for the command side of es/cqrs.
-func SetupCommandHandlerDomain(ctx context.Context, esClient esApi.EventStoreClient) error {
+// SetupCommandHandlerDomain sets up the necessary handlers/repositories for the command side of es/cqrs and returns the aggregate store used.
+func SetupCommandHandlerDomain(ctx context.Context, esClient esApi.EventStoreClient) (es.AggregateStore, error) {
+	// Register upcasters
+	if err := registerUpcasters(); err != nil {
+		return nil, err
+	}
+
 	// Register aggregates
 	aggregateManager := registerAggregates(esClient)
 
@@ -206,12 +214,12 @@ func SetupCommandHandlerDomain(ctx context.Context, esClient esApi.EventStoreCli
 	// Create default and super users
 	metadataManager, err := metadata.NewDomainMetadataManager(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := setupUsers(metadataManager.GetContext(), handler); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return aggregateManager, nil
 }
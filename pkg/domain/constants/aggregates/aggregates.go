@@ -29,4 +29,6 @@ const (
 	Certificate es.AggregateType = "Certificate"
 	// Type for the TenantClusterBindingAggregate
 	TenantClusterBinding es.AggregateType = "TenantClusterBinding"
+	// Type for the RoleAggregate
+	Role es.AggregateType = "Role"
 )
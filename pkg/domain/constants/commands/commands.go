@@ -47,6 +47,13 @@ const (
 	CreateTenantClusterBinding es.CommandType = "CreateTenantClusterBinding"
 	// Command to remove access of a tenant to a certain cluster
 	DeleteTenantClusterBinding es.CommandType = "DeleteTenantClusterBinding"
+
+	// Command to define a Role
+	CreateRole es.CommandType = "CreateRole"
+	// Command to update a Role
+	UpdateRole es.CommandType = "UpdateRole"
+	// Command to delete a Role
+	DeleteRole es.CommandType = "DeleteRole"
 )
 
 var (
@@ -78,11 +85,18 @@ var (
 		DeleteTenantClusterBinding,
 	}
 
+	RoleCommands = []es.CommandType{
+		CreateRole,
+		UpdateRole,
+		DeleteRole,
+	}
+
 	CommandTypes = map[string][]es.CommandType{
 		"User":                 UserCommands,
 		"UserRoleBinding":      UserRoleBindingCommands,
 		"Tenant":               TenantCommands,
 		"Cluster":              ClusterCommands,
 		"TenantClusterBinding": TenantClusterBindingCommands,
+		"Role":                 RoleCommands,
 	}
 )
@@ -29,9 +29,11 @@ const (
 	UserRoleBindingDeleted es.EventType = "UserRoleBindingDeleted"
 
 	// TenantCreated event emitted when a User has been created
-	TenantCreated es.EventType = "TenantCreated"
+	TenantCreated   es.EventType = "TenantCreated"
+	TenantCreatedV2 es.EventType = "TenantCreatedV2"
 	// TenantUpdated event emitted when a Tenant has been updated
-	TenantUpdated es.EventType = "TenantUpdated"
+	TenantUpdated   es.EventType = "TenantUpdated"
+	TenantUpdatedV2 es.EventType = "TenantUpdatedV2"
 	// TenantDeleted event emitted when a Tenant has been deleted
 	TenantDeleted es.EventType = "TenantDeleted"
 
@@ -39,9 +41,11 @@ const (
 	ClusterCreated   es.EventType = "ClusterCreated"
 	ClusterCreatedV2 es.EventType = "ClusterCreatedV2"
 	ClusterCreatedV3 es.EventType = "ClusterCreatedV3"
+	ClusterCreatedV4 es.EventType = "ClusterCreatedV4"
 	// ClusterUpdated event emitted when a Cluster has been created
 	ClusterUpdated   es.EventType = "ClusterUpdated"
 	ClusterUpdatedV2 es.EventType = "ClusterUpdatedV2"
+	ClusterUpdatedV3 es.EventType = "ClusterUpdatedV3"
 	// ClusterDeleted event emitted when a Cluster has been deleted
 	ClusterDeleted es.EventType = "ClusterDeleted"
 	// IGNORED: ClusterBootstrapTokenCreated event emitted when a bootstrap token has been created
@@ -60,6 +64,13 @@ const (
 	TenantClusterBindingCreated es.EventType = "TenantClusterBindingCreated"
 	// TenantClusterBindingDeleted event emitted when a tenant's access to a cluster has been revoked
 	TenantClusterBindingDeleted es.EventType = "TenantClusterBindingDeleted"
+
+	// RoleCreated event emitted when a Role has been defined
+	RoleCreated es.EventType = "RoleCreated"
+	// RoleUpdated event emitted when a Role has been updated
+	RoleUpdated es.EventType = "RoleUpdated"
+	// RoleDeleted event emitted when a Role has been deleted
+	RoleDeleted es.EventType = "RoleDeleted"
 )
 
 var (
@@ -73,7 +84,9 @@ var (
 
 	TenantEvents = []es.EventType{
 		TenantCreated,
+		TenantCreatedV2,
 		TenantUpdated,
+		TenantUpdatedV2,
 		TenantDeleted,
 		TenantClusterBindingCreated,
 		TenantClusterBindingDeleted,
@@ -83,11 +96,19 @@ var (
 		ClusterCreated,
 		ClusterCreatedV2,
 		ClusterCreatedV3,
+		ClusterCreatedV4,
 		ClusterUpdated,
 		ClusterUpdatedV2,
+		ClusterUpdatedV3,
 		ClusterDeleted,
 	}
 
+	RoleEvents = []es.EventType{
+		RoleCreated,
+		RoleUpdated,
+		RoleDeleted,
+	}
+
 	CertificateEvents = []es.EventType{
 		CertificateRequested,
 		CertificateRequestIssued,
@@ -32,16 +32,27 @@ const (
 	UserDeletedDetailsFormat            DetailsFormat = "“%s“ deleted user “%s“"
 	UserRoleBindingDeletedDetailsFormat DetailsFormat = "“%s“ removed the role “%s“ for scope “%s“ from user “%s“"
 
+	UserClusterRoleAddedDetailsFormat          DetailsFormat = "“%s“ assigned the role “%s“ for cluster “%s“ to user “%s“"
+	UserClusterRoleBindingDeletedDetailsFormat DetailsFormat = "“%s“ removed the role “%s“ for cluster “%s“ from user “%s“"
+
 	TenantCreatedDetailsFormat               DetailsFormat = "“%s“ created tenant “%s“ with prefix “%s“"
 	TenantUpdatedDetailsFormat               DetailsFormat = "“%s“ updated the tenant"
 	TenantClusterBindingCreatedDetailsFormat DetailsFormat = "“%s“ granted tenant “%s“ access to cluster “%s”"
 	TenantDeletedDetailsFormat               DetailsFormat = "“%s“ deleted tenant “%s“"
 	TenantClusterBindingDeletedDetailsFormat DetailsFormat = "“%s“ revoked access to cluster “%s“ for tenant “%s“"
 
-	ClusterCreatedDetailsFormat   DetailsFormat = "“%s“ created cluster “%s“"
-	ClusterCreatedV2DetailsFormat DetailsFormat = ClusterCreatedDetailsFormat
-	ClusterUpdatedDetailsFormat   DetailsFormat = "“%s“ updated the cluster"
-	ClusterDeletedDetailsFormat   DetailsFormat = "“%s“ deleted cluster “%s“"
+	TenantCreatedWithParentDetailsFormat DetailsFormat = "“%s“ created tenant “%s“ with prefix “%s“ as child of tenant “%s“"
+
+	TenantClusterSelectorBindingCreatedDetailsFormat DetailsFormat = "“%s“ granted tenant “%s“ access to clusters matching “%s“"
+	TenantClusterSelectorBindingDeletedDetailsFormat DetailsFormat = "“%s“ revoked access to clusters matching “%s“ for tenant “%s“"
+
+	ClusterCreatedDetailsFormat DetailsFormat = "“%s“ created cluster “%s“"
+	ClusterUpdatedDetailsFormat DetailsFormat = "“%s“ updated the cluster"
+	ClusterDeletedDetailsFormat DetailsFormat = "“%s“ deleted cluster “%s“"
+
+	RoleCreatedDetailsFormat DetailsFormat = "“%s“ created role “%s“ for scopes “%s“"
+	RoleUpdatedDetailsFormat DetailsFormat = "“%s“ updated the role"
+	RoleDeletedDetailsFormat DetailsFormat = "“%s“ deleted role “%s“"
 
 	UserCreatedOverviewDetailsFormat            DetailsFormat = "“%s“ was created by “%s“ at “%s“"
 	UserDeletedOverviewDetailsFormat            DetailsFormat = " and was deleted by “%s“ at “%s“"
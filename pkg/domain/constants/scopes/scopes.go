@@ -31,12 +31,16 @@ const (
 
 	// Tenant scope
 	Tenant es.Scope = "tenant"
+
+	// Cluster scope
+	Cluster es.Scope = "cluster"
 )
 
 // A list of all existing scopes.
 var AvailableScopes = []es.Scope{
 	System,
 	Tenant,
+	Cluster,
 }
 
 func ValidateScope(scope string) error {
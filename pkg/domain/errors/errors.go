@@ -48,6 +48,8 @@ var (
 	ErrTenantNotFound = errors.New("tenant not found")
 	// ErrTenantAlreadyExists is returned when a tenant does already exist.
 	ErrTenantAlreadyExists = errors.New("tenant already exists")
+	// ErrTenantHasChildren is returned when a tenant which is parent of other tenants should be deleted.
+	ErrTenantHasChildren = errors.New("tenant is parent of other tenants")
 
 	// ErrClusterRegistrationNotFound is returned when a cluster registration is not known to the system.
 	ErrClusterRegistrationNotFound = errors.New("cluster registration not found")
@@ -66,6 +68,16 @@ var (
 	ErrTenantClusterBindingAlreadyExists = errors.New("tenant already has access to that cluster")
 	// ErrTenantClusterBindingNotFound is returned when a tenant-cluster-binding could not be found.
 	ErrTenantClusterBindingNotFound = errors.New("no cluster access found for the given cluster and tenant")
+	// ErrRoleNotFound is returned when a role could not be found.
+	ErrRoleNotFound = errors.New("role not found")
+	// ErrRoleAlreadyExists is returned when a role does already exist.
+	ErrRoleAlreadyExists = errors.New("role already exists")
+	// ErrRoleInUse is returned when a role which is still bound to users should be deleted.
+	ErrRoleInUse = errors.New("role is still bound to users")
+	// ErrTenantClusterBindingQuotaExceeded is returned when a tenant has reached its maximum number of cluster bindings.
+	ErrTenantClusterBindingQuotaExceeded = errors.New("tenant has reached its maximum number of cluster bindings")
+	// ErrProjectionsNotFound is returned when there are no projections of the given aggregate type.
+	ErrProjectionsNotFound = errors.New("no projections of aggregate type")
 )
 
 var (
@@ -76,7 +88,10 @@ var (
 			ErrTenantNotFound,
 			ErrClusterRegistrationNotFound,
 			ErrClusterNotFound,
+			ErrRoleNotFound,
 			es_errors.ErrProjectionNotFound,
+			es_errors.ErrDeadLetterNotFound,
+			ErrProjectionsNotFound,
 		},
 		codes.AlreadyExists: {
 			ErrUserAlreadyExists,
@@ -86,9 +101,13 @@ var (
 			ErrClusterAlreadyExists,
 			ErrCertificateAlreadyExists,
 			ErrTenantClusterBindingAlreadyExists,
+			ErrRoleAlreadyExists,
 		},
-		codes.PermissionDenied: {ErrUnauthorized},
-		codes.Unauthenticated:  {ErrUnauthenticated},
+		codes.FailedPrecondition: {ErrTenantHasChildren, ErrRoleInUse, es_errors.ErrDeadLetterHandlerNotFound, es_errors.ErrDeadLetterQueueNotConfigured},
+		codes.ResourceExhausted:  {ErrTenantClusterBindingQuotaExceeded},
+		codes.PermissionDenied:   {ErrUnauthorized},
+		codes.Unauthenticated:    {ErrUnauthenticated},
+		codes.Unimplemented:      {es_errors.ErrValidationNotSupported, es_errors.ErrBatchNotSupported},
 	}
 	reverseErrorMap = reverseMap(errorMap)
 )
@@ -0,0 +1,79 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventschemas
+
+import (
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+)
+
+func init() {
+	RegisterEventSchemas(es.DefaultEventSchemaRegistry)
+	RegisterEventConsumers(es.DefaultEventSchemaRegistry)
+}
+
+// RegisterEventSchemas registers the data carried by the events of all aggregates.
+// ClusterBootstrapTokenCreated and the certificate events are not emitted anymore
+// and therefore not registered.
+func RegisterEventSchemas(registry es.EventSchemaRegistry) {
+	registry.RegisterEventSchema(events.UserCreated, aggregates.User, &eventdata.UserCreated{})
+	registry.RegisterEventSchema(events.UserUpdated, aggregates.User, &eventdata.UserUpdated{})
+	registry.RegisterEventSchema(events.UserDeleted, aggregates.User, nil)
+
+	registry.RegisterEventSchema(events.UserRoleBindingCreated, aggregates.UserRoleBinding, &eventdata.UserRoleAdded{})
+	registry.RegisterEventSchema(events.UserRoleBindingDeleted, aggregates.UserRoleBinding, nil)
+
+	registry.RegisterEventSchema(events.TenantCreated, aggregates.Tenant, &eventdata.TenantCreated{})
+	registry.RegisterEventSchema(events.TenantCreatedV2, aggregates.Tenant, &eventdata.TenantCreatedV2{})
+	registry.RegisterEventSchema(events.TenantUpdated, aggregates.Tenant, &eventdata.TenantUpdated{})
+	registry.RegisterEventSchema(events.TenantUpdatedV2, aggregates.Tenant, &eventdata.TenantUpdatedV2{})
+	registry.RegisterEventSchema(events.TenantDeleted, aggregates.Tenant, nil)
+
+	registry.RegisterEventSchema(events.ClusterCreated, aggregates.Cluster, &eventdata.ClusterCreated{})
+	registry.RegisterEventSchema(events.ClusterCreatedV2, aggregates.Cluster, &eventdata.ClusterCreatedV2{})
+	registry.RegisterEventSchema(events.ClusterCreatedV3, aggregates.Cluster, &eventdata.ClusterCreatedV3{})
+	registry.RegisterEventSchema(events.ClusterCreatedV4, aggregates.Cluster, &eventdata.ClusterCreatedV4{})
+	registry.RegisterEventSchema(events.ClusterUpdated, aggregates.Cluster, &eventdata.ClusterUpdated{})
+	registry.RegisterEventSchema(events.ClusterUpdatedV2, aggregates.Cluster, &eventdata.ClusterUpdatedV2{})
+	registry.RegisterEventSchema(events.ClusterUpdatedV3, aggregates.Cluster, &eventdata.ClusterUpdatedV3{})
+	registry.RegisterEventSchema(events.ClusterDeleted, aggregates.Cluster, nil)
+
+	registry.RegisterEventSchema(events.TenantClusterBindingCreated, aggregates.TenantClusterBinding, &eventdata.TenantClusterBindingCreated{})
+	registry.RegisterEventSchema(events.TenantClusterBindingDeleted, aggregates.TenantClusterBinding, nil)
+
+	registry.RegisterEventSchema(events.RoleCreated, aggregates.Role, &eventdata.RoleCreated{})
+	registry.RegisterEventSchema(events.RoleUpdated, aggregates.Role, &eventdata.RoleUpdated{})
+	registry.RegisterEventSchema(events.RoleDeleted, aggregates.Role, nil)
+}
+
+// RegisterEventConsumers registers the services subscribing to events on the message bus.
+func RegisterEventConsumers(registry es.EventSchemaRegistry) {
+	domainAggregates := []es.AggregateType{
+		aggregates.User,
+		aggregates.UserRoleBinding,
+		aggregates.Tenant,
+		aggregates.Cluster,
+		aggregates.TenantClusterBinding,
+		aggregates.Role,
+	}
+
+	// see domain.NewQueryHandlerDomain and domain.NewGatewayDomain
+	registry.RegisterEventConsumer("queryhandler", domainAggregates...)
+	registry.RegisterEventConsumer("gateway", domainAggregates...)
+	// audit forwarding of the queryhandler, see auditforwarder.AuditForwarder
+	registry.RegisterEventConsumer("auditforwarder")
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventschemas
+
+import (
+	"context"
+	"time"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("pkg/domain/eventschemas", func() {
+	It("registers a schema for every event of the domain", func() {
+		var eventTypes []es.EventType
+		eventTypes = append(eventTypes, events.UserEvents...)
+		eventTypes = append(eventTypes, events.TenantEvents...)
+		eventTypes = append(eventTypes, events.ClusterEvents...)
+		eventTypes = append(eventTypes, events.RoleEvents...)
+
+		for _, eventType := range eventTypes {
+			_, err := es.DefaultEventSchemaRegistry.GetEventSchema(eventType)
+			Expect(err).ToNot(HaveOccurred(), "event type %s", eventType)
+		}
+	})
+	It("registers the consumers of the events", func() {
+		schema, err := es.DefaultEventSchemaRegistry.GetEventSchema(events.ClusterCreatedV4)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schema.Data.FullName()).To(BeEquivalentTo("eventdata.ClusterCreatedV4"))
+		Expect(schema.Consumers).To(ConsistOf("queryhandler", "gateway", "auditforwarder"))
+	})
+	It("validates the data of events", func() {
+		event := es.NewEvent(context.Background(), events.UserCreated, es.ToEventDataFromProto(&eventdata.UserCreated{Email: "admin@monoskope.io"}), time.Now().UTC(), aggregates.User, uuid.New(), 1)
+		Expect(es.DefaultEventSchemaRegistry.Validate(event)).To(Succeed())
+
+		event = es.NewEvent(context.Background(), events.UserCreated, es.ToEventDataFromProto(&eventdata.TenantCreated{Name: "tenant"}), time.Now().UTC(), aggregates.User, uuid.New(), 1)
+		Expect(es.DefaultEventSchemaRegistry.Validate(event)).ToNot(Succeed())
+	})
+})
@@ -31,6 +31,7 @@ import (
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 func init() {
@@ -52,7 +53,8 @@ func NewClusterEventFormatter(esClient esApi.EventStoreClient) event.EventFormat
 	}
 }
 
-// GetFormattedDetails formats the cluster-aggregate-events in a human-readable format
+// GetFormattedDetails formats the cluster-aggregate-events in a human-readable format.
+// Events of previous versions are upcasted by the audit formatter, see package upcasters.
 func (f *clusterEventFormatter) GetFormattedDetails(ctx context.Context, event *esApi.Event) (string, error) {
 	switch es.EventType(event.Type) {
 	case events.ClusterDeleted:
@@ -65,54 +67,20 @@ func (f *clusterEventFormatter) GetFormattedDetails(ctx context.Context, event *
 	}
 
 	switch ed := ed.(type) {
-	case *eventdata.ClusterCreated:
-		return f.getFormattedDetailsClusterCreated(event, ed)
-	case *eventdata.ClusterCreatedV2:
-		return f.getFormattedDetailsClusterCreatedV2(event, ed)
-	case *eventdata.ClusterCreatedV3:
-		return f.getFormattedDetailsClusterCreatedV3(event, ed)
-	case *eventdata.ClusterUpdated:
-		return f.getFormattedDetailsClusterUpdated(ctx, event, ed)
-	case *eventdata.ClusterUpdatedV2:
-		return f.getFormattedDetailsClusterUpdatedV2(ctx, event, ed)
+	case *eventdata.ClusterCreatedV4:
+		return f.getFormattedDetailsClusterCreatedV4(event, ed)
+	case *eventdata.ClusterUpdatedV3:
+		return f.getFormattedDetailsClusterUpdatedV3(ctx, event, ed)
 	}
 
 	return "", errors.ErrMissingFormatterImplementationForEventType
 }
 
-func (f *clusterEventFormatter) getFormattedDetailsClusterCreated(event *esApi.Event, eventData *eventdata.ClusterCreated) (string, error) {
-	return fConsts.ClusterCreatedV2DetailsFormat.Sprint(event.Metadata[auth.HeaderAuthEmail], eventData.Name), nil
-}
-
-func (f *clusterEventFormatter) getFormattedDetailsClusterCreatedV2(event *esApi.Event, eventData *eventdata.ClusterCreatedV2) (string, error) {
+func (f *clusterEventFormatter) getFormattedDetailsClusterCreatedV4(event *esApi.Event, eventData *eventdata.ClusterCreatedV4) (string, error) {
 	return fConsts.ClusterCreatedDetailsFormat.Sprint(event.Metadata[auth.HeaderAuthEmail], eventData.Name), nil
 }
 
-func (f *clusterEventFormatter) getFormattedDetailsClusterCreatedV3(event *esApi.Event, eventData *eventdata.ClusterCreatedV3) (string, error) {
-	return fConsts.ClusterCreatedDetailsFormat.Sprint(event.Metadata[auth.HeaderAuthEmail], eventData.Name), nil
-}
-
-func (f *clusterEventFormatter) getFormattedDetailsClusterUpdated(ctx context.Context, event *esApi.Event, eventData *eventdata.ClusterUpdated) (string, error) {
-	clusterSnapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewClusterProjector())
-
-	cluster, err := clusterSnapshotter.CreateSnapshot(ctx, &esApi.EventFilter{
-		MaxTimestamp: timestamppb.New(event.GetTimestamp().AsTime().Add(time.Duration(-1) * time.Microsecond)), // exclude the update event
-		AggregateId:  &wrapperspb.StringValue{Value: event.AggregateId}},
-	)
-	if err != nil {
-		return "", err
-	}
-
-	var details strings.Builder
-	details.WriteString(fConsts.ClusterUpdatedDetailsFormat.Sprint(event.Metadata[auth.HeaderAuthEmail]))
-	f.AppendUpdate("API server address", eventData.ApiServerAddress, cluster.ApiServerAddress, &details)
-	if len(eventData.CaCertificateBundle) != 0 {
-		f.AppendUpdate("Certificate", "a new one", "", &details)
-	}
-	return details.String(), nil
-}
-
-func (f *clusterEventFormatter) getFormattedDetailsClusterUpdatedV2(ctx context.Context, event *esApi.Event, eventData *eventdata.ClusterUpdatedV2) (string, error) {
+func (f *clusterEventFormatter) getFormattedDetailsClusterUpdatedV3(ctx context.Context, event *esApi.Event, eventData *eventdata.ClusterUpdatedV3) (string, error) {
 	snapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewClusterProjector())
 
 	cluster, err := snapshotter.CreateSnapshot(ctx, &esApi.EventFilter{
@@ -134,6 +102,15 @@ func (f *clusterEventFormatter) getFormattedDetailsClusterUpdatedV2(ctx context.
 	if len(eventData.CaCertificateBundle) != 0 {
 		f.AppendUpdate("Certificate", "a new one", "", &details)
 	}
+	if eventData.Description != nil {
+		f.AppendUpdate("Description", eventData.Description.Value, cluster.Description, &details)
+	}
+	if eventData.Labels != nil {
+		f.AppendUpdate("Labels", labels.Set(eventData.Labels.Values).String(), labels.Set(cluster.Labels).String(), &details)
+	}
+	if eventData.Annotations != nil {
+		f.AppendUpdate("Annotations", labels.Set(eventData.Annotations.Values).String(), labels.Set(cluster.Annotations).String(), &details)
+	}
 	return details.String(), nil
 }
 
@@ -0,0 +1,114 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/finleap-connect/monoskope/internal/gateway/auth"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/audit/errors"
+	"github.com/finleap-connect/monoskope/pkg/audit/formatters/event"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	fConsts "github.com/finleap-connect/monoskope/pkg/domain/constants/formatters"
+	"github.com/finleap-connect/monoskope/pkg/domain/projectors"
+	"github.com/finleap-connect/monoskope/pkg/domain/snapshots"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func init() {
+	for _, eventType := range events.RoleEvents {
+		_ = event.DefaultEventFormatterRegistry.RegisterEventFormatter(eventType, NewRoleEventFormatter)
+	}
+}
+
+// roleEventFormatter EventFormatter implementation for the role-aggregate
+type roleEventFormatter struct {
+	*event.EventFormatterBase
+	esClient esApi.EventStoreClient
+}
+
+// NewRoleEventFormatter creates a new event formatter for the role-aggregate
+func NewRoleEventFormatter(esClient esApi.EventStoreClient) event.EventFormatter {
+	return &roleEventFormatter{
+		&event.EventFormatterBase{}, esClient,
+	}
+}
+
+// GetFormattedDetails formats the role-aggregate-events in a human-readable format
+func (f *roleEventFormatter) GetFormattedDetails(ctx context.Context, event *esApi.Event) (string, error) {
+	switch es.EventType(event.Type) {
+	case events.RoleDeleted:
+		return f.getFormattedDetailsRoleDeleted(ctx, event)
+	}
+
+	ed, err := es.EventData(event.Data).Unmarshal()
+	if err != nil {
+		return "", err
+	}
+
+	switch ed := ed.(type) {
+	case *eventdata.RoleCreated:
+		return fConsts.RoleCreatedDetailsFormat.Sprint(event.Metadata[auth.HeaderAuthEmail], ed.Name, strings.Join(ed.Scopes, ", ")), nil
+	case *eventdata.RoleUpdated:
+		return f.getFormattedDetailsRoleUpdated(ctx, event, ed)
+	}
+
+	return "", errors.ErrMissingFormatterImplementationForEventType
+}
+
+func (f *roleEventFormatter) getFormattedDetailsRoleUpdated(ctx context.Context, event *esApi.Event, eventData *eventdata.RoleUpdated) (string, error) {
+	snapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewRoleProjector())
+
+	role, err := snapshotter.CreateSnapshot(ctx, &esApi.EventFilter{
+		MaxTimestamp: timestamppb.New(event.GetTimestamp().AsTime().Add(time.Duration(-1) * time.Microsecond)), // exclude the update event
+		AggregateId:  &wrapperspb.StringValue{Value: event.AggregateId}},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	var details strings.Builder
+	details.WriteString(fConsts.RoleUpdatedDetailsFormat.Sprint(event.Metadata[auth.HeaderAuthEmail]))
+	if eventData.Description != nil {
+		f.AppendUpdate("Description", eventData.Description.Value, role.Description, &details)
+	}
+	if eventData.Scopes != nil {
+		f.AppendUpdate("Scopes", strings.Join(eventData.Scopes.Values, ", "), strings.Join(role.Scopes, ", "), &details)
+	}
+	if eventData.K8SClusterRole != nil {
+		f.AppendUpdate("K8s cluster role", eventData.K8SClusterRole.Value, role.K8SClusterRole, &details)
+	}
+	return details.String(), nil
+}
+
+func (f *roleEventFormatter) getFormattedDetailsRoleDeleted(ctx context.Context, event *esApi.Event) (string, error) {
+	snapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewRoleProjector())
+
+	role, err := snapshotter.CreateSnapshot(ctx, &esApi.EventFilter{
+		MaxTimestamp: event.GetTimestamp(),
+		AggregateId:  &wrapperspb.StringValue{Value: event.AggregateId}},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return fConsts.RoleDeletedDetailsFormat.Sprint(event.Metadata[auth.HeaderAuthEmail], role.Name), nil
+}
@@ -16,6 +16,7 @@ package events
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -69,8 +70,12 @@ func (f *tenantEventFormatter) GetFormattedDetails(ctx context.Context, event *e
 	switch ed := ed.(type) {
 	case *eventdata.TenantCreated:
 		return f.getFormattedDetailsTenantCreated(event, ed)
+	case *eventdata.TenantCreatedV2:
+		return f.getFormattedDetailsTenantCreatedV2(ctx, event, ed)
 	case *eventdata.TenantUpdated:
 		return f.getFormattedDetailsTenantUpdated(ctx, event, ed)
+	case *eventdata.TenantUpdatedV2:
+		return f.getFormattedDetailsTenantUpdatedV2(ctx, event, ed)
 	case *eventdata.TenantClusterBindingCreated:
 		return f.getFormattedDetailsTenantClusterBindingCreated(ctx, event, ed)
 	}
@@ -99,6 +104,74 @@ func (f *tenantEventFormatter) getFormattedDetailsTenantUpdated(ctx context.Cont
 	return details.String(), nil
 }
 
+func (f *tenantEventFormatter) getFormattedDetailsTenantCreatedV2(ctx context.Context, event *esApi.Event, eventData *eventdata.TenantCreatedV2) (string, error) {
+	if eventData.ParentId == "" {
+		return fConsts.TenantCreatedDetailsFormat.Sprint(event.Metadata[auth.HeaderAuthEmail], eventData.Name, eventData.Prefix), nil
+	}
+
+	parentName, err := f.getTenantName(ctx, eventData.ParentId, event.GetTimestamp())
+	if err != nil {
+		return "", err
+	}
+	return fConsts.TenantCreatedWithParentDetailsFormat.Sprint(event.Metadata[auth.HeaderAuthEmail], eventData.Name, eventData.Prefix, parentName), nil
+}
+
+func (f *tenantEventFormatter) getFormattedDetailsTenantUpdatedV2(ctx context.Context, event *esApi.Event, eventData *eventdata.TenantUpdatedV2) (string, error) {
+	tenantSnapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewTenantProjector())
+
+	tenant, err := tenantSnapshotter.CreateSnapshot(ctx, &esApi.EventFilter{
+		MaxTimestamp: timestamppb.New(event.GetTimestamp().AsTime().Add(time.Duration(-1) * time.Microsecond)), // exclude the update event
+		AggregateId:  &wrapperspb.StringValue{Value: event.AggregateId},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var details strings.Builder
+	details.WriteString(fConsts.TenantUpdatedDetailsFormat.Sprint(event.Metadata[auth.HeaderAuthEmail]))
+	if eventData.Name != nil {
+		f.AppendUpdate("Name", eventData.Name.Value, tenant.Name, &details)
+	}
+	if eventData.ParentId != nil {
+		newParent, err := f.getTenantName(ctx, eventData.ParentId.Value, event.GetTimestamp())
+		if err != nil {
+			return "", err
+		}
+		oldParent, err := f.getTenantName(ctx, tenant.ParentId, event.GetTimestamp())
+		if err != nil {
+			return "", err
+		}
+		f.AppendUpdate("Parent", newParent, oldParent, &details)
+	}
+	if eventData.CostCentre != nil {
+		f.AppendUpdate("Cost centre", eventData.CostCentre.Value, tenant.CostCentre, &details)
+	}
+	if eventData.Contact != nil {
+		f.AppendUpdate("Contact", eventData.Contact.Value, tenant.Contact, &details)
+	}
+	if eventData.MaxClusterBindings != nil {
+		f.AppendUpdate("Max cluster bindings", fmt.Sprint(eventData.MaxClusterBindings.Value), fmt.Sprint(tenant.MaxClusterBindings), &details)
+	}
+	return details.String(), nil
+}
+
+// getTenantName returns the name of the tenant with the given id at the given point in time
+func (f *tenantEventFormatter) getTenantName(ctx context.Context, tenantId string, timestamp *timestamppb.Timestamp) (string, error) {
+	if tenantId == "" {
+		return "", nil
+	}
+
+	tenantSnapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewTenantProjector())
+	tenant, err := tenantSnapshotter.CreateSnapshot(ctx, &esApi.EventFilter{
+		MaxTimestamp: timestamp,
+		AggregateId:  &wrapperspb.StringValue{Value: tenantId},
+	})
+	if err != nil {
+		return "", err
+	}
+	return tenant.Name, nil
+}
+
 func (f *tenantEventFormatter) getFormattedDetailsTenantClusterBindingCreated(ctx context.Context, event *esApi.Event, eventData *eventdata.TenantClusterBindingCreated) (string, error) {
 	eventFilter := &esApi.EventFilter{MaxTimestamp: event.GetTimestamp()}
 	eventFilter.AggregateId = &wrapperspb.StringValue{Value: eventData.TenantId}
@@ -108,6 +181,11 @@ func (f *tenantEventFormatter) getFormattedDetailsTenantClusterBindingCreated(ct
 	if err != nil {
 		return "", err
 	}
+
+	if eventData.ClusterSelector != "" {
+		return fConsts.TenantClusterSelectorBindingCreatedDetailsFormat.Sprint(
+			event.Metadata[auth.HeaderAuthEmail], tenant.Name, eventData.ClusterSelector), nil
+	}
 	eventFilter.AggregateId = &wrapperspb.StringValue{Value: eventData.ClusterId}
 
 	clusterSnapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewClusterProjector())
@@ -150,6 +228,11 @@ func (f *tenantEventFormatter) getFormattedDetailsTenantClusterBindingDeleted(ct
 		return "", err
 	}
 
+	if tcb.ClusterSelector != "" {
+		return fConsts.TenantClusterSelectorBindingDeletedDetailsFormat.Sprint(
+			event.Metadata[auth.HeaderAuthEmail], tcb.ClusterSelector, tenant.Name), nil
+	}
+
 	eventFilter.AggregateId = &wrapperspb.StringValue{Value: tcb.ClusterId}
 	clusterSnapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewClusterProjector())
 	cluster, err := clusterSnapshotter.CreateSnapshot(ctx, eventFilter)
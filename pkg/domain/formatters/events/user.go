@@ -26,6 +26,7 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/audit/formatters/event"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
 	fConsts "github.com/finleap-connect/monoskope/pkg/domain/constants/formatters"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
 	"github.com/finleap-connect/monoskope/pkg/domain/projectors"
 	"github.com/finleap-connect/monoskope/pkg/domain/snapshots"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
@@ -108,6 +109,15 @@ func (f *userEventFormatter) getFormattedDetailsUserRoleAdded(ctx context.Contex
 		return "", err
 	}
 
+	if eventData.Scope == string(scopes.Cluster) {
+		clusterName, err := f.getClusterName(ctx, eventData.Resource, event.GetTimestamp())
+		if err != nil {
+			return "", err
+		}
+		return fConsts.UserClusterRoleAddedDetailsFormat.Sprint(
+			event.Metadata[auth.HeaderAuthEmail], eventData.Role, clusterName, user.Email), nil
+	}
+
 	return fConsts.UserRoleAddedDetailsFormat.Sprint(
 		event.Metadata[auth.HeaderAuthEmail], eventData.Role, eventData.Scope, user.Email), nil
 }
@@ -142,6 +152,28 @@ func (f *userEventFormatter) getFormattedDetailsUserRoleBindingDeleted(ctx conte
 		return "", err
 	}
 
+	if urb.Scope == string(scopes.Cluster) {
+		clusterName, err := f.getClusterName(ctx, urb.Resource, event.GetTimestamp())
+		if err != nil {
+			return "", err
+		}
+		return fConsts.UserClusterRoleBindingDeletedDetailsFormat.Sprint(
+			event.Metadata[auth.HeaderAuthEmail], urb.Role, clusterName, user.Email), nil
+	}
+
 	return fConsts.UserRoleBindingDeletedDetailsFormat.Sprint(
 		event.Metadata[auth.HeaderAuthEmail], urb.Role, urb.Scope, user.Email), nil
 }
+
+// getClusterName returns the name of the cluster with the given id at the given point in time
+func (f *userEventFormatter) getClusterName(ctx context.Context, clusterId string, timestamp *timestamppb.Timestamp) (string, error) {
+	clusterSnapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewClusterProjector())
+	cluster, err := clusterSnapshotter.CreateSnapshot(ctx, &esApi.EventFilter{
+		MaxTimestamp: timestamp,
+		AggregateId:  &wrapperspb.StringValue{Value: clusterId},
+	})
+	if err != nil {
+		return "", err
+	}
+	return cluster.Name, nil
+}
@@ -21,6 +21,7 @@ import (
 
 	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
 	fConsts "github.com/finleap-connect/monoskope/pkg/domain/constants/formatters"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/users"
 	"github.com/finleap-connect/monoskope/pkg/domain/projections"
 	"github.com/finleap-connect/monoskope/pkg/domain/projectors"
@@ -101,6 +102,15 @@ func (f *userOverviewFormatter) GetRolesDetails(ctx context.Context, user *proje
 
 		eventFilter.AggregateId = wrapperspb.String(role.Resource)
 
+		if role.Scope == string(scopes.Cluster) {
+			clusterSnapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewClusterProjector())
+			cluster, err := clusterSnapshotter.CreateSnapshot(ctx, eventFilter)
+			if err == nil {
+				clustersDetails += fConsts.ClusterUserRoleBindingOverviewDetailsFormat.Sprint(cluster.Name, role.Role)
+			}
+			continue
+		}
+
 		tenantSnapshotter := snapshots.NewSnapshotter(f.esClient, projectors.NewTenantProjector())
 		tenant, err := tenantSnapshotter.CreateSnapshot(ctx, eventFilter)
 		if err == nil {
@@ -37,18 +37,25 @@ type GatewayDomain struct {
 	ClusterRepository              repositories.ClusterRepository
 	TenantClusterBindingRepository repositories.TenantClusterBindingRepository
 	ClusterAccessRepo              repositories.ClusterAccessRepository
+	RoleRepository                 repositories.RoleRepository
 }
 
 func NewGatewayDomain(ctx context.Context, eventBus eventsourcing.EventBusConsumer, esClient eventsourcingApi.EventStoreClient) (*GatewayDomain, error) {
 	d := new(GatewayDomain)
 
+	// Register upcasters
+	if err := registerUpcasters(); err != nil {
+		return nil, err
+	}
+
 	// Setup repositories
 	d.UserRoleBindingRepository = repositories.NewUserRoleBindingRepository(esr.NewInMemoryRepository[*projections.UserRoleBinding]())
 	d.UserRepository = repositories.NewUserRepository(esr.NewInMemoryRepository[*projections.User](), d.UserRoleBindingRepository)
 	d.TenantRepository = repositories.NewTenantRepository(esr.NewInMemoryRepository[*projections.Tenant]())
 	d.ClusterRepository = repositories.NewClusterRepository(esr.NewInMemoryRepository[*projections.Cluster]())
-	d.TenantClusterBindingRepository = repositories.NewTenantClusterBindingRepository(esr.NewInMemoryRepository[*projections.TenantClusterBinding]())
-	d.ClusterAccessRepo = repositories.NewClusterAccessRepository(d.TenantClusterBindingRepository, d.ClusterRepository, d.UserRoleBindingRepository, d.TenantRepository)
+	d.TenantClusterBindingRepository = repositories.NewTenantClusterBindingRepository(esr.NewInMemoryRepository[*projections.TenantClusterBinding](), d.ClusterRepository)
+	d.RoleRepository = repositories.NewRoleRepository(esr.NewInMemoryRepository[*projections.Role]())
+	d.ClusterAccessRepo = repositories.NewClusterAccessRepository(d.TenantClusterBindingRepository, d.ClusterRepository, d.UserRoleBindingRepository, d.TenantRepository, d.RoleRepository)
 
 	// Setup projectors
 	userProjector := projectors.NewUserProjector()
@@ -56,6 +63,7 @@ func NewGatewayDomain(ctx context.Context, eventBus eventsourcing.EventBusConsum
 	tenantProjector := projectors.NewTenantProjector()
 	clusterProjector := projectors.NewClusterProjector()
 	tenantClusterBindingProjector := projectors.NewTenantClusterBindingProjector()
+	roleProjector := projectors.NewRoleProjector()
 
 	// Setup handler
 	userProjectingHandler := eventhandler.NewProjectingEventHandler[*projections.User](userProjector, d.UserRepository)
@@ -63,6 +71,7 @@ func NewGatewayDomain(ctx context.Context, eventBus eventsourcing.EventBusConsum
 	userRoleBindingProjectingHandler := eventhandler.NewProjectingEventHandler[*projections.UserRoleBinding](userRoleBindingProjector, d.UserRoleBindingRepository)
 	clusterProjectingHandler := eventhandler.NewProjectingEventHandler[*projections.Cluster](clusterProjector, d.ClusterRepository)
 	tenantClusterBindingProjectingHandler := eventhandler.NewProjectingEventHandler[*projections.TenantClusterBinding](tenantClusterBindingProjector, d.TenantClusterBindingRepository)
+	roleProjectingHandler := eventhandler.NewProjectingEventHandler[*projections.Role](roleProjector, d.RoleRepository)
 
 	// Setup middleware
 	refreshDuration := time.Second * 30
@@ -71,6 +80,7 @@ func NewGatewayDomain(ctx context.Context, eventBus eventsourcing.EventBusConsum
 	tenantHandlerChain := eventsourcing.UseEventHandlerMiddleware(tenantProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration))
 	clusterHandlerChain := eventsourcing.UseEventHandlerMiddleware(clusterProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration))
 	tenantClusterBindingHandlerChain := eventsourcing.UseEventHandlerMiddleware(tenantClusterBindingProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration))
+	roleHandlerChain := eventsourcing.UseEventHandlerMiddleware(roleProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration))
 
 	// Setup matcher for event bus
 	userMatcher := eventBus.Matcher().MatchAggregateType(aggregates.User)
@@ -78,6 +88,7 @@ func NewGatewayDomain(ctx context.Context, eventBus eventsourcing.EventBusConsum
 	tenantMatcher := eventBus.Matcher().MatchAggregateType(aggregates.Tenant)
 	clusterMatcher := eventBus.Matcher().MatchAggregateType(aggregates.Cluster)
 	tenantClusterBindingMatcher := eventBus.Matcher().MatchAggregateType(aggregates.TenantClusterBinding)
+	roleMatcher := eventBus.Matcher().MatchAggregateType(aggregates.Role)
 
 	// Register event handler with event bus
 	if err := eventBus.AddHandler(ctx, userHandlerChain, userMatcher); err != nil {
@@ -95,6 +106,9 @@ func NewGatewayDomain(ctx context.Context, eventBus eventsourcing.EventBusConsum
 	if err := eventBus.AddHandler(ctx, tenantClusterBindingHandlerChain, tenantClusterBindingMatcher); err != nil {
 		return nil, err
 	}
+	if err := eventBus.AddHandler(ctx, roleHandlerChain, roleMatcher); err != nil {
+		return nil, err
+	}
 
 	// Start repo warming
 	if err := handler.WarmUp(ctx, esClient, aggregates.User, userHandlerChain); err != nil {
@@ -112,6 +126,9 @@ func NewGatewayDomain(ctx context.Context, eventBus eventsourcing.EventBusConsum
 	if err := handler.WarmUp(ctx, esClient, aggregates.TenantClusterBinding, tenantClusterBindingHandlerChain); err != nil {
 		return nil, err
 	}
+	if err := handler.WarmUp(ctx, esClient, aggregates.Role, roleHandlerChain); err != nil {
+		return nil, err
+	}
 
 	return d, nil
 }
@@ -0,0 +1,195 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	apiEs "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/eventhandler"
+	esr "github.com/finleap-connect/monoskope/pkg/eventsourcing/repositories"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// catchUpTolerance is how long before a checkpoint or the start of a rebuild events are applied again.
+// It covers events which have been created before but stored or projected after that point in time.
+const catchUpTolerance = time.Minute
+
+// ProjectionDrift describes a projection whose version differs from the version of its aggregate in the EventStore.
+type ProjectionDrift struct {
+	AggregateType es.AggregateType
+	AggregateID   uuid.UUID
+	// ProjectionVersion is zero if there is no projection of the aggregate.
+	ProjectionVersion uint64
+	// AggregateVersion is zero if there are no events of the aggregate.
+	AggregateVersion uint64
+}
+
+// ProjectionRebuilder rebuilds the projections of an aggregate type from the EventStore and checks them for drift.
+type ProjectionRebuilder interface {
+	// AggregateType returns the type of the aggregates the projections are based upon.
+	AggregateType() es.AggregateType
+	// Rebuild projects all events from the EventStore into a new repository and swaps it with the live one.
+	// Returns the count of projections rebuilt.
+	Rebuild(context.Context) (int, error)
+	// Check compares the versions of the live projections with the versions of the aggregates in the EventStore.
+	// Returns the count of projections checked and the drift found.
+	Check(context.Context) (int, []*ProjectionDrift, error)
+}
+
+type projectionRebuilder[T es.Projection] struct {
+	log           logger.Logger
+	esClient      apiEs.EventStoreClient
+	aggregateType es.AggregateType
+	projector     es.Projector[T]
+	repository    es.SwappableRepository[T]
+	handler       es.EventHandler
+	handlerMutex  sync.Mutex
+	rebuildMutex  sync.Mutex
+}
+
+// NewProjectionRebuilder creates a ProjectionRebuilder for the projections of the given aggregate type.
+// Use the Middleware of the rebuilder as innermost middleware of the handler chain projecting into the repository
+// to prevent events from being projected into the repository while it is swapped.
+func NewProjectionRebuilder[T es.Projection](esClient apiEs.EventStoreClient, aggregateType es.AggregateType, projector es.Projector[T], repository es.SwappableRepository[T]) *projectionRebuilder[T] {
+	return &projectionRebuilder[T]{
+		log:           logger.WithName("projection-rebuilder").WithValues("aggregateType", aggregateType),
+		esClient:      esClient,
+		aggregateType: aggregateType,
+		projector:     projector,
+		repository:    repository,
+		handler:       eventhandler.NewProjectingEventHandler[T](projector, repository),
+	}
+}
+
+// Middleware returns an EventHandlerMiddleware which holds back events while the repository is swapped.
+func (r *projectionRebuilder[T]) Middleware() es.EventHandlerMiddleware {
+	return func(h es.EventHandler) es.EventHandler {
+		r.handler = h
+		return r
+	}
+}
+
+// HandleEvent implements the HandleEvent method of the es.EventHandler interface.
+func (r *projectionRebuilder[T]) HandleEvent(ctx context.Context, event es.Event) error {
+	r.handlerMutex.Lock()
+	defer r.handlerMutex.Unlock()
+	return r.handler.HandleEvent(ctx, event)
+}
+
+// AggregateType returns the type of the aggregates the projections are based upon.
+func (r *projectionRebuilder[T]) AggregateType() es.AggregateType {
+	return r.aggregateType
+}
+
+// Rebuild projects all events from the EventStore into a new repository and swaps it with the live one.
+func (r *projectionRebuilder[T]) Rebuild(ctx context.Context) (int, error) {
+	r.rebuildMutex.Lock()
+	defer r.rebuildMutex.Unlock()
+
+	r.log.Info("Rebuilding projections...")
+	started := time.Now().UTC()
+
+	rebuilt := esr.NewInMemoryRepository[T]()
+	appliedEvents, err := applyEventsFromStore(ctx, r.esClient, &apiEs.EventFilter{
+		AggregateType: wrapperspb.String(r.aggregateType.String()),
+	}, eventhandler.NewProjectingEventHandler[T](r.projector, rebuilt))
+	if err != nil {
+		return 0, err
+	}
+
+	projections, err := rebuilt.All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	r.handlerMutex.Lock()
+	err = r.repository.Swap(ctx, rebuilt)
+	r.handlerMutex.Unlock()
+	if err != nil {
+		return 0, err
+	}
+
+	// Apply events which have been stored while rebuilding, older ones are ignored by the handler.
+	caughtUpEvents, err := applyEventsFromStore(ctx, r.esClient, &apiEs.EventFilter{
+		AggregateType: wrapperspb.String(r.aggregateType.String()),
+		MinTimestamp:  timestamppb.New(started.Add(-catchUpTolerance)),
+	}, r)
+	if err != nil {
+		return 0, err
+	}
+
+	r.log.Info("Rebuild finished.", "projections", len(projections), "eventsApplied", appliedEvents, "eventsCaughtUp", caughtUpEvents, "duration", time.Since(started))
+	return len(projections), nil
+}
+
+// Check compares the versions of the live projections with the versions of the aggregates in the EventStore.
+func (r *projectionRebuilder[T]) Check(ctx context.Context) (int, []*ProjectionDrift, error) {
+	versions := make(aggregateVersions)
+	if _, err := applyEventsFromStore(ctx, r.esClient, &apiEs.EventFilter{
+		AggregateType: wrapperspb.String(r.aggregateType.String()),
+	}, versions); err != nil {
+		return 0, nil, err
+	}
+
+	projections, err := r.repository.All(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var drift []*ProjectionDrift
+	for _, p := range projections {
+		aggregateVersion := versions[p.ID()]
+		if aggregateVersion != p.Version() {
+			drift = append(drift, &ProjectionDrift{
+				AggregateType:     r.aggregateType,
+				AggregateID:       p.ID(),
+				ProjectionVersion: p.Version(),
+				AggregateVersion:  aggregateVersion,
+			})
+		}
+		delete(versions, p.ID())
+	}
+	for id, aggregateVersion := range versions {
+		drift = append(drift, &ProjectionDrift{
+			AggregateType:    r.aggregateType,
+			AggregateID:      id,
+			AggregateVersion: aggregateVersion,
+		})
+	}
+
+	sort.Slice(drift, func(i, j int) bool {
+		return drift[i].AggregateID.String() < drift[j].AggregateID.String()
+	})
+	return len(projections), drift, nil
+}
+
+// aggregateVersions collects the latest version of each aggregate from the events handled.
+type aggregateVersions map[uuid.UUID]uint64
+
+// HandleEvent implements the HandleEvent method of the es.EventHandler interface.
+func (v aggregateVersions) HandleEvent(_ context.Context, event es.Event) error {
+	if event.AggregateVersion() > v[event.AggregateID()] {
+		v[event.AggregateID()] = event.AggregateVersion()
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	apiEs "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	"github.com/finleap-connect/monoskope/pkg/domain/metadata"
+	"github.com/finleap-connect/monoskope/pkg/domain/mock"
+	"github.com/finleap-connect/monoskope/pkg/domain/projections"
+	"github.com/finleap-connect/monoskope/pkg/domain/projectors"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/eventhandler"
+	esr "github.com/finleap-connect/monoskope/pkg/eventsourcing/repositories"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// testEventStoreClient retrieves events from memory
+type testEventStoreClient struct {
+	apiEs.EventStoreClient
+	events []*apiEs.Event
+}
+
+func (c *testEventStoreClient) Retrieve(_ context.Context, filter *apiEs.EventFilter, _ ...grpc.CallOption) (apiEs.EventStore_RetrieveClient, error) {
+	stream := new(testRetrieveClient)
+	for _, event := range c.events {
+		if event.AggregateType != filter.GetAggregateType().GetValue() {
+			continue
+		}
+		if filter.MinTimestamp != nil && event.Timestamp.AsTime().Before(filter.MinTimestamp.AsTime()) {
+			continue
+		}
+		stream.events = append(stream.events, event)
+	}
+	return stream, nil
+}
+
+type testRetrieveClient struct {
+	grpc.ClientStream
+	events []*apiEs.Event
+}
+
+func (s *testRetrieveClient) Recv() (*apiEs.Event, error) {
+	if len(s.events) == 0 {
+		return nil, io.EOF
+	}
+	event := s.events[0]
+	s.events = s.events[1:]
+	return event, nil
+}
+
+var _ = Describe("pkg/domain/handler/projectionRebuilder", func() {
+	var (
+		ctx        context.Context
+		esClient   *testEventStoreClient
+		repository es.SwappableRepository[*projections.Tenant]
+		rebuilder  *projectionRebuilder[*projections.Tenant]
+		tenantId   uuid.UUID
+	)
+
+	newTenantEvent := func(eventType es.EventType, data es.EventData, aggregateId uuid.UUID, version uint64) *apiEs.Event {
+		return es.NewProtoFromEvent(es.NewEvent(ctx, eventType, data, time.Now().UTC(), aggregates.Tenant, aggregateId, version))
+	}
+
+	BeforeEach(func() {
+		mdManager, err := metadata.NewDomainMetadataManager(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		mdManager.SetUserInformation(&metadata.UserInformation{
+			Id:    mock.TestAdminUser.ID(),
+			Name:  mock.TestAdminUser.Name,
+			Email: mock.TestAdminUser.Email,
+		})
+		ctx = mdManager.GetContext()
+
+		tenantId = uuid.New()
+		esClient = &testEventStoreClient{
+			events: []*apiEs.Event{
+				newTenantEvent(events.TenantCreatedV2, es.ToEventDataFromProto(&eventdata.TenantCreatedV2{Name: "tenant", Prefix: "t"}), tenantId, 1),
+				newTenantEvent(events.TenantUpdatedV2, es.ToEventDataFromProto(&eventdata.TenantUpdatedV2{Name: wrapperspb.String("renamed")}), tenantId, 2),
+			},
+		}
+		repository = esr.NewInMemoryRepository[*projections.Tenant]()
+		rebuilder = NewProjectionRebuilder[*projections.Tenant](esClient, aggregates.Tenant, projectors.NewTenantProjector(), repository)
+	})
+
+	It("reports projections which drifted from the event store", func() {
+		stale := projections.NewTenantProjection(tenantId)
+		stale.IncrementVersion()
+		Expect(repository.Upsert(ctx, stale)).To(Succeed())
+
+		orphan := projections.NewTenantProjection(uuid.New())
+		orphan.IncrementVersion()
+		Expect(repository.Upsert(ctx, orphan)).To(Succeed())
+
+		otherTenantId := uuid.New()
+		esClient.events = append(esClient.events, newTenantEvent(events.TenantDeleted, nil, otherTenantId, 1))
+
+		checked, drift, err := rebuilder.Check(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checked).To(Equal(2))
+		Expect(drift).To(ConsistOf(
+			&ProjectionDrift{AggregateType: aggregates.Tenant, AggregateID: tenantId, ProjectionVersion: 1, AggregateVersion: 2},
+			&ProjectionDrift{AggregateType: aggregates.Tenant, AggregateID: orphan.ID(), ProjectionVersion: 1},
+			&ProjectionDrift{AggregateType: aggregates.Tenant, AggregateID: otherTenantId, AggregateVersion: 1},
+		))
+	})
+
+	It("rebuilds projections from the event store", func() {
+		orphan := projections.NewTenantProjection(uuid.New())
+		orphan.IncrementVersion()
+		Expect(repository.Upsert(ctx, orphan)).To(Succeed())
+
+		rebuilt, err := rebuilder.Rebuild(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rebuilt).To(Equal(1))
+
+		tenant, err := repository.ById(ctx, tenantId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tenant.Name).To(Equal("renamed"))
+		Expect(tenant.Version()).To(BeNumerically("==", 2))
+
+		_, drift, err := rebuilder.Check(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift).To(BeEmpty())
+	})
+
+	It("projects live events into the swapped repository", func() {
+		handler := es.UseEventHandlerMiddleware(eventhandler.NewProjectingEventHandler[*projections.Tenant](projectors.NewTenantProjector(), repository), rebuilder.Middleware())
+		_, err := rebuilder.Rebuild(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		event, err := es.NewEventFromProto(newTenantEvent(events.TenantUpdatedV2, es.ToEventDataFromProto(&eventdata.TenantUpdatedV2{Name: wrapperspb.String("live")}), tenantId, 3))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(handler.HandleEvent(ctx, event)).To(Succeed())
+
+		tenant, err := repository.ById(ctx, tenantId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tenant.Name).To(Equal("live"))
+	})
+})
@@ -21,6 +21,7 @@ import (
 	apiEs "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/logger"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -29,13 +30,57 @@ func WarmUp(ctx context.Context, esClient apiEs.EventStoreClient, aggregateType
 	log := logger.WithName("repository-warming-middleware").WithValues("aggregateType", aggregateType)
 	log.Info("Warming up...")
 
-	// Retrieve events from store
-	eventStream, err := esClient.Retrieve(ctx, &apiEs.EventFilter{
+	appliedEvents, err := applyEventsFromStore(ctx, esClient, &apiEs.EventFilter{
 		AggregateType: wrapperspb.String(aggregateType.String()),
-	})
+	}, eventHandler)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Warmup finished.", "eventsApplied", appliedEvents)
+
+	return nil
+}
+
+// WarmUpRepository warms up the repository like WarmUp. Durable repositories only catch up with the events stored since their checkpoint.
+func WarmUpRepository[T es.Projection](ctx context.Context, esClient apiEs.EventStoreClient, aggregateType es.AggregateType, repository es.Repository[T], eventHandler es.EventHandler) error {
+	durable, ok := repository.(es.DurableRepository[T])
+	if !ok {
+		return WarmUp(ctx, esClient, aggregateType, eventHandler)
+	}
+
+	checkpoint, err := durable.Checkpoint(ctx)
 	if err != nil {
 		return err
 	}
+	if checkpoint.IsZero() {
+		return WarmUp(ctx, esClient, aggregateType, eventHandler)
+	}
+
+	log := logger.WithName("repository-warming-middleware").WithValues("aggregateType", aggregateType)
+	log.Info("Catching up from checkpoint...", "checkpoint", checkpoint)
+
+	// Events of projections which are up-to-date already are ignored by the handler.
+	appliedEvents, err := applyEventsFromStore(ctx, esClient, &apiEs.EventFilter{
+		AggregateType: wrapperspb.String(aggregateType.String()),
+		MinTimestamp:  timestamppb.New(checkpoint.Add(-catchUpTolerance)),
+	}, eventHandler)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Catch up finished.", "eventsApplied", appliedEvents)
+
+	return nil
+}
+
+// applyEventsFromStore retrieves the events matching the filter from the EventStore and lets the handler handle them.
+func applyEventsFromStore(ctx context.Context, esClient apiEs.EventStoreClient, filter *apiEs.EventFilter, eventHandler es.EventHandler) (int, error) {
+	// Retrieve events from store
+	eventStream, err := esClient.Retrieve(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
 
 	appliedEvents := 0
 	for {
@@ -47,26 +92,24 @@ func WarmUp(ctx context.Context, esClient apiEs.EventStoreClient, aggregateType
 				// End of stream
 				break
 			} else {
-				return err
+				return appliedEvents, err
 			}
 		}
 
 		// Convert event from api to es
-		esEvent, err := es.NewEventFromProto(protoEvent)
+		esEvent, err := es.UpcastEventFromProto(protoEvent)
 		if err != nil {
-			return err
+			return appliedEvents, err
 		}
 
 		// Let the next handler in the chain handle the event
 		err = eventHandler.HandleEvent(ctx, esEvent)
 		if err != nil {
-			return err
+			return appliedEvents, err
 		}
 
 		appliedEvents++
 	}
 
-	log.Info("Warmup finished.", "eventsApplied", appliedEvents)
-
-	return nil
+	return appliedEvents, nil
 }
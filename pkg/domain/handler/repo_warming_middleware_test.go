@@ -0,0 +1,113 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	apiEs "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	"github.com/finleap-connect/monoskope/pkg/domain/metadata"
+	"github.com/finleap-connect/monoskope/pkg/domain/mock"
+	"github.com/finleap-connect/monoskope/pkg/domain/projections"
+	"github.com/finleap-connect/monoskope/pkg/domain/projectors"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/eventhandler"
+	esr "github.com/finleap-connect/monoskope/pkg/eventsourcing/repositories"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// testDurableRepository is an in-memory repository with a fixed checkpoint
+type testDurableRepository struct {
+	es.SwappableRepository[*projections.Tenant]
+	checkpoint time.Time
+}
+
+func (r *testDurableRepository) Checkpoint(context.Context) (time.Time, error) {
+	return r.checkpoint, nil
+}
+
+var _ = Describe("pkg/domain/handler/WarmUpRepository", func() {
+	var (
+		ctx                  context.Context
+		esClient             *testEventStoreClient
+		oldTenant, newTenant uuid.UUID
+	)
+
+	BeforeEach(func() {
+		mdManager, err := metadata.NewDomainMetadataManager(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		mdManager.SetUserInformation(&metadata.UserInformation{
+			Id:    mock.TestAdminUser.ID(),
+			Name:  mock.TestAdminUser.Name,
+			Email: mock.TestAdminUser.Email,
+		})
+		ctx = mdManager.GetContext()
+
+		newTenantCreated := func(id uuid.UUID, timestamp time.Time) *apiEs.Event {
+			data := es.ToEventDataFromProto(&eventdata.TenantCreatedV2{Name: id.String(), Prefix: "t"})
+			return es.NewProtoFromEvent(es.NewEvent(ctx, events.TenantCreatedV2, data, timestamp, aggregates.Tenant, id, 1))
+		}
+
+		oldTenant, newTenant = uuid.New(), uuid.New()
+		esClient = &testEventStoreClient{
+			events: []*apiEs.Event{
+				newTenantCreated(oldTenant, time.Now().UTC().Add(-2*time.Hour)),
+				newTenantCreated(newTenant, time.Now().UTC()),
+			},
+		}
+	})
+
+	warmUp := func(repository es.Repository[*projections.Tenant]) {
+		handler := eventhandler.NewProjectingEventHandler[*projections.Tenant](projectors.NewTenantProjector(), repository)
+		Expect(WarmUpRepository[*projections.Tenant](ctx, esClient, aggregates.Tenant, repository, handler)).To(Succeed())
+	}
+
+	It("replays all events into repositories which are not durable", func() {
+		repository := esr.NewInMemoryRepository[*projections.Tenant]()
+		warmUp(repository)
+
+		all, err := repository.All(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(all).To(HaveLen(2))
+	})
+
+	It("replays all events into empty durable repositories", func() {
+		repository := &testDurableRepository{SwappableRepository: esr.NewInMemoryRepository[*projections.Tenant]()}
+		warmUp(repository)
+
+		all, err := repository.All(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(all).To(HaveLen(2))
+	})
+
+	It("catches up durable repositories from their checkpoint", func() {
+		repository := &testDurableRepository{
+			SwappableRepository: esr.NewInMemoryRepository[*projections.Tenant](),
+			checkpoint:          time.Now().UTC().Add(-time.Hour),
+		}
+		warmUp(repository)
+
+		_, err := repository.ById(ctx, oldTenant)
+		Expect(err).To(HaveOccurred())
+		_, err = repository.ById(ctx, newTenant)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -0,0 +1,39 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// HeaderGitOpsCommit is the gRPC metadata key of the git commit a command has been issued for by GitOps reconciliation.
+// It is recorded in the metadata of the events emitted by the command.
+const HeaderGitOpsCommit = "x-gitops-commit"
+
+// WithGitOpsCommit returns a new outgoing context sending the given git commit hash.
+func WithGitOpsCommit(ctx context.Context, commit string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, HeaderGitOpsCommit, commit)
+}
+
+// GetGitOpsCommit gets the git commit the command which created the context has been issued for, an empty string if none.
+func (m *DomainMetadataManager) GetGitOpsCommit() string {
+	res, ok := m.Get(HeaderGitOpsCommit)
+	if ok {
+		return res
+	}
+	return ""
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// HeaderIdempotencyKey is the gRPC metadata key of the optional idempotency key of a command.
+// Retries of a command with the same key return the reply of the first execution instead of executing the command again.
+const HeaderIdempotencyKey = "idempotency-key"
+
+// WithIdempotencyKey returns a new outgoing context sending the given idempotency key.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, HeaderIdempotencyKey, key)
+}
+
+// IdempotencyKeyFromIncomingContext returns the idempotency key of the incoming context, an empty string if none was sent.
+func IdempotencyKeyFromIncomingContext(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, HeaderIdempotencyKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -41,6 +41,7 @@ var (
 		auth.HeaderAuthName,
 		auth.HeaderAuthEmail,
 		auth.HeaderAuthNotBefore,
+		HeaderGitOpsCommit,
 	}
 )
 
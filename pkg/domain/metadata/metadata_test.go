@@ -21,6 +21,7 @@ import (
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
 )
 
 var _ = Describe("Managing Metadata", func() {
@@ -41,4 +42,13 @@ var _ = Describe("Managing Metadata", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(mdManager.GetMetadata()[auth.HeaderAuthId]).To(Equal(expectedUserId.String()))
 	})
+	It("should have the gitops commit from incoming context", func() {
+		ctx := WithGitOpsCommit(context.Background(), "0123456789abcdef")
+		md, ok := metadata.FromOutgoingContext(ctx)
+		Expect(ok).To(BeTrue())
+
+		mdManager, err := NewDomainMetadataManager(metadata.NewIncomingContext(context.Background(), md))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mdManager.GetGitOpsCommit()).To(Equal("0123456789abcdef"))
+	})
 })
@@ -0,0 +1,68 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projections
+
+import (
+	"time"
+
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// protoProjection is a DomainProjection backed by a proto message.
+type protoProjection[P proto.Message] interface {
+	DomainProjection
+	Proto() P
+}
+
+type protoCodec[T protoProjection[P], P proto.Message] struct {
+	newProjection func(uuid.UUID) T
+}
+
+// NewProtoCodec creates a ProjectionCodec which stores domain projections as their proto representation.
+func NewProtoCodec[T protoProjection[P], P proto.Message](newProjection func(uuid.UUID) T) es.ProjectionCodec[T] {
+	return &protoCodec[T, P]{
+		newProjection: newProjection,
+	}
+}
+
+// Marshal returns the proto representation of the projection in wire format.
+func (c *protoCodec[T, P]) Marshal(p T) ([]byte, error) {
+	return proto.Marshal(p.Proto())
+}
+
+// Unmarshal restores the projection with the given id and version from its proto representation in wire format.
+func (c *protoCodec[T, P]) Unmarshal(id uuid.UUID, version uint64, data []byte) (T, error) {
+	p := c.newProjection(id)
+
+	// Merge to keep the lifecycle metadata of the proto and the projection the same.
+	if err := (proto.UnmarshalOptions{Merge: true}).Unmarshal(data, p.Proto()); err != nil {
+		var empty T
+		return empty, err
+	}
+	for p.Version() < version {
+		p.IncrementVersion()
+	}
+	return p, nil
+}
+
+// Checkpoint returns the timestamp of the latest event projected into the projection.
+func (c *protoCodec[T, P]) Checkpoint(p T) time.Time {
+	if p.GetLastModified() == nil {
+		return time.Time{}
+	}
+	return p.GetLastModified().AsTime()
+}
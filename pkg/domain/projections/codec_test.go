@@ -0,0 +1,56 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projections
+
+import (
+	"time"
+
+	projectionsApi "github.com/finleap-connect/monoskope/pkg/api/domain/projections"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("pkg/domain/projections/protoCodec", func() {
+	codec := NewProtoCodec[*Tenant, *projectionsApi.Tenant](NewTenantProjection)
+
+	It("restores projections including version and lifecycle metadata", func() {
+		lastModified := time.Now().UTC().Truncate(time.Microsecond)
+
+		tenant := NewTenantProjection(uuid.New())
+		tenant.Name = "tenant"
+		tenant.GetLifecycleMetadata().LastModified = timestamppb.New(lastModified)
+		tenant.GetLifecycleMetadata().Deleted = timestamppb.New(lastModified)
+		tenant.IncrementVersion()
+		tenant.IncrementVersion()
+
+		data, err := codec.Marshal(tenant)
+		Expect(err).NotTo(HaveOccurred())
+
+		restored, err := codec.Unmarshal(tenant.ID(), tenant.Version(), data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored.ID()).To(Equal(tenant.ID()))
+		Expect(restored.Version()).To(BeNumerically("==", 2))
+		Expect(restored.Name).To(Equal("tenant"))
+		Expect(restored.IsDeleted()).To(BeTrue())
+		Expect(restored.Proto().Metadata).To(BeIdenticalTo(restored.GetLifecycleMetadata()))
+		Expect(codec.Checkpoint(restored)).To(Equal(lastModified))
+	})
+
+	It("returns a zero checkpoint for projections never modified", func() {
+		Expect(codec.Checkpoint(NewTenantProjection(uuid.New())).IsZero()).To(BeTrue())
+	})
+})
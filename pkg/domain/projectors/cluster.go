@@ -15,7 +15,6 @@
 package projectors
 
 import (
-	"bytes"
 	"context"
 
 	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
@@ -41,24 +40,12 @@ func (u *clusterProjector) NewProjection(id uuid.UUID) *projections.Cluster {
 }
 
 // Project updates the state of the projection according to the given event.
+// Events of previous versions are upcasted when loaded, see package upcasters.
 func (c *clusterProjector) Project(ctx context.Context, event es.Event, cluster *projections.Cluster) (*projections.Cluster, error) {
 	// Apply the changes for the event.
 	switch event.EventType() {
-	case events.ClusterCreated:
-		data := new(eventdata.ClusterCreated)
-		if err := event.Data().ToProto(data); err != nil {
-			return nil, err
-		}
-
-		cluster.Name = data.GetLabel()
-		cluster.ApiServerAddress = data.GetApiServerAddress()
-		cluster.CaCertBundle = data.GetCaCertificateBundle()
-
-		if err := c.projectCreated(event, cluster.DomainProjection); err != nil {
-			return nil, err
-		}
-	case events.ClusterCreatedV2:
-		data := new(eventdata.ClusterCreatedV2)
+	case events.ClusterCreatedV4:
+		data := new(eventdata.ClusterCreatedV4)
 		if err := event.Data().ToProto(data); err != nil {
 			return nil, err
 		}
@@ -66,36 +53,15 @@ func (c *clusterProjector) Project(ctx context.Context, event es.Event, cluster
 		cluster.Name = data.GetName()
 		cluster.ApiServerAddress = data.GetApiServerAddress()
 		cluster.CaCertBundle = data.GetCaCertificateBundle()
+		cluster.Description = data.GetDescription()
+		cluster.Labels = data.GetLabels()
+		cluster.Annotations = data.GetAnnotations()
 
 		if err := c.projectCreated(event, cluster.DomainProjection); err != nil {
 			return nil, err
 		}
-	case events.ClusterCreatedV3:
-		data := new(eventdata.ClusterCreatedV3)
-		if err := event.Data().ToProto(data); err != nil {
-			return nil, err
-		}
-
-		cluster.Name = data.GetName()
-		cluster.ApiServerAddress = data.GetApiServerAddress()
-		cluster.CaCertBundle = data.GetCaCertificateBundle()
-
-		if err := c.projectCreated(event, cluster.DomainProjection); err != nil {
-			return nil, err
-		}
-	case events.ClusterUpdated:
-		data := new(eventdata.ClusterUpdated)
-		if err := event.Data().ToProto(data); err != nil {
-			return nil, err
-		}
-		if len(data.GetApiServerAddress()) > 0 && cluster.ApiServerAddress != data.GetApiServerAddress() {
-			cluster.ApiServerAddress = data.GetApiServerAddress()
-		}
-		if len(data.GetCaCertificateBundle()) > 0 && !bytes.Equal(cluster.CaCertBundle, data.GetCaCertificateBundle()) {
-			cluster.CaCertBundle = data.GetCaCertificateBundle()
-		}
-	case events.ClusterUpdatedV2:
-		data := new(eventdata.ClusterUpdatedV2)
+	case events.ClusterUpdatedV3:
+		data := new(eventdata.ClusterUpdatedV3)
 		if err := event.Data().ToProto(data); err != nil {
 			return nil, err
 		}
@@ -108,6 +74,15 @@ func (c *clusterProjector) Project(ctx context.Context, event es.Event, cluster
 		if data.CaCertificateBundle != nil {
 			cluster.CaCertBundle = data.CaCertificateBundle
 		}
+		if data.Description != nil {
+			cluster.Description = data.Description.Value
+		}
+		if data.Labels != nil {
+			cluster.Labels = data.Labels.GetValues()
+		}
+		if data.Annotations != nil {
+			cluster.Annotations = data.Annotations.GetValues()
+		}
 	case events.ClusterDeleted:
 		if err := c.projectDeleted(event, cluster.DomainProjection); err != nil {
 			return nil, err
@@ -23,10 +23,12 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
 	metadata "github.com/finleap-connect/monoskope/pkg/domain/metadata"
 	"github.com/finleap-connect/monoskope/pkg/domain/mock"
+	"github.com/finleap-connect/monoskope/pkg/domain/upcasters"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
@@ -34,6 +36,9 @@ var (
 	expectedName                = "one-cluster"
 	expectedApiServerAddress    = "one.example.com"
 	expectedClusterCACertBundle = []byte("This should be a certificate")
+	expectedDescription         = "the one cluster for development"
+	expectedLabels              = map[string]string{"env": "dev"}
+	expectedAnnotations         = map[string]string{"monoskope.io/owner": "team-a"}
 )
 
 var _ = Describe("domain/projectors/cluster", func() {
@@ -48,6 +53,15 @@ var _ = Describe("domain/projectors/cluster", func() {
 	})
 	ctx = mdManager.GetContext()
 
+	// events of previous versions are upcasted when loaded
+	upcast := func(event es.Event) es.Event {
+		registry := es.NewUpcasterRegistry()
+		Expect(upcasters.RegisterClusterUpcasters(registry)).To(Succeed())
+		upcasted, err := registry.Upcast(event)
+		Expect(err).ToNot(HaveOccurred())
+		return upcasted
+	}
+
 	It("can handle ClusterCreated events", func() {
 		clusterProjector := NewClusterProjector()
 		clusterProjection := clusterProjector.NewProjection(uuid.New())
@@ -58,7 +72,7 @@ var _ = Describe("domain/projectors/cluster", func() {
 			CaCertificateBundle: expectedClusterCACertBundle,
 		}
 		clusterCreatedEventData := es.ToEventDataFromProto(protoClusterCreatedEventData)
-		event := es.NewEvent(ctx, events.ClusterCreated, clusterCreatedEventData, time.Now().UTC(), aggregates.Cluster, uuid.New(), 1)
+		event := upcast(es.NewEvent(ctx, events.ClusterCreated, clusterCreatedEventData, time.Now().UTC(), aggregates.Cluster, uuid.New(), 1))
 
 		clusterProjection, err := clusterProjector.Project(context.Background(), event, clusterProjection)
 		Expect(err).NotTo(HaveOccurred())
@@ -81,7 +95,7 @@ var _ = Describe("domain/projectors/cluster", func() {
 			CaCertificateBundle: expectedClusterCACertBundle,
 		}
 		clusterCreatedEventData := es.ToEventDataFromProto(protoClusterCreatedEventData)
-		event := es.NewEvent(ctx, events.ClusterCreatedV2, clusterCreatedEventData, time.Now().UTC(), aggregates.Cluster, uuid.New(), 1)
+		event := upcast(es.NewEvent(ctx, events.ClusterCreatedV2, clusterCreatedEventData, time.Now().UTC(), aggregates.Cluster, uuid.New(), 1))
 
 		clusterProjection, err := clusterProjector.Project(context.Background(), event, clusterProjection)
 		Expect(err).NotTo(HaveOccurred())
@@ -106,7 +120,7 @@ var _ = Describe("domain/projectors/cluster", func() {
 
 		clusterProjection, err := clusterProjector.Project(
 			context.Background(),
-			es.NewEvent(ctx,
+			upcast(es.NewEvent(ctx,
 				events.ClusterCreatedV2,
 				es.ToEventDataFromProto(&eventdata.ClusterCreatedV2{
 					DisplayName:         expectedDisplayName,
@@ -117,7 +131,7 @@ var _ = Describe("domain/projectors/cluster", func() {
 				time.Now().UTC(),
 				aggregates.Cluster,
 				uuid.New(),
-				1),
+				1)),
 			clusterProjection,
 		)
 		Expect(err).NotTo(HaveOccurred())
@@ -125,7 +139,7 @@ var _ = Describe("domain/projectors/cluster", func() {
 
 		clusterProjection, err = clusterProjector.Project(
 			context.Background(),
-			es.NewEvent(ctx,
+			upcast(es.NewEvent(ctx,
 				events.ClusterUpdated,
 				es.ToEventDataFromProto(&eventdata.ClusterUpdated{
 					DisplayName:         newDisplayName,
@@ -135,7 +149,7 @@ var _ = Describe("domain/projectors/cluster", func() {
 				time.Now().UTC(),
 				aggregates.Cluster,
 				uuid.New(),
-				2),
+				2)),
 			clusterProjection,
 		)
 		Expect(err).NotTo(HaveOccurred())
@@ -145,4 +159,71 @@ var _ = Describe("domain/projectors/cluster", func() {
 		Expect(clusterProjection.GetCaCertBundle()).To(Equal(newClusterCaCertificate))
 	})
 
+	It("can handle ClusterCreatedV4 and ClusterUpdatedV3 events", func() {
+		clusterProjector := NewClusterProjector()
+		clusterProjection := clusterProjector.NewProjection(uuid.New())
+
+		clusterProjection, err := clusterProjector.Project(
+			context.Background(),
+			es.NewEvent(ctx,
+				events.ClusterCreatedV4,
+				es.ToEventDataFromProto(&eventdata.ClusterCreatedV4{
+					Name:                expectedName,
+					ApiServerAddress:    expectedApiServerAddress,
+					CaCertificateBundle: expectedClusterCACertBundle,
+					Description:         expectedDescription,
+					Labels:              expectedLabels,
+					Annotations:         expectedAnnotations,
+				}),
+				time.Now().UTC(),
+				aggregates.Cluster,
+				uuid.New(),
+				1),
+			clusterProjection,
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterProjection.GetName()).To(Equal(expectedName))
+		Expect(clusterProjection.GetDescription()).To(Equal(expectedDescription))
+		Expect(clusterProjection.GetLabels()).To(Equal(expectedLabels))
+		Expect(clusterProjection.GetAnnotations()).To(Equal(expectedAnnotations))
+
+		newLabels := map[string]string{"env": "prod"}
+		clusterProjection, err = clusterProjector.Project(
+			context.Background(),
+			es.NewEvent(ctx,
+				events.ClusterUpdatedV3,
+				es.ToEventDataFromProto(&eventdata.ClusterUpdatedV3{
+					Labels: &eventdata.StringMap{Values: newLabels},
+				}),
+				time.Now().UTC(),
+				aggregates.Cluster,
+				uuid.New(),
+				2),
+			clusterProjection,
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterProjection.Version()).To(Equal(uint64(2)))
+		Expect(clusterProjection.GetLabels()).To(Equal(newLabels))
+		Expect(clusterProjection.GetAnnotations()).To(Equal(expectedAnnotations))
+		Expect(clusterProjection.GetDescription()).To(Equal(expectedDescription))
+
+		clusterProjection, err = clusterProjector.Project(
+			context.Background(),
+			es.NewEvent(ctx,
+				events.ClusterUpdatedV3,
+				es.ToEventDataFromProto(&eventdata.ClusterUpdatedV3{
+					Description: wrapperspb.String(""),
+					Annotations: &eventdata.StringMap{},
+				}),
+				time.Now().UTC(),
+				aggregates.Cluster,
+				uuid.New(),
+				3),
+			clusterProjection,
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterProjection.GetLabels()).To(Equal(newLabels))
+		Expect(clusterProjection.GetAnnotations()).To(BeEmpty())
+		Expect(clusterProjection.GetDescription()).To(BeEmpty())
+	})
 })
@@ -0,0 +1,89 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectors
+
+import (
+	"context"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	"github.com/finleap-connect/monoskope/pkg/domain/projections"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/google/uuid"
+)
+
+type roleProjector struct {
+	*domainProjector
+}
+
+func NewRoleProjector() es.Projector[*projections.Role] {
+	return &roleProjector{
+		domainProjector: NewDomainProjector(),
+	}
+}
+
+func (r *roleProjector) NewProjection(id uuid.UUID) *projections.Role {
+	return projections.NewRoleProjection(id)
+}
+
+// Project updates the state of the projection according to the given event.
+func (r *roleProjector) Project(ctx context.Context, event es.Event, p *projections.Role) (*projections.Role, error) {
+	// Apply the changes for the event.
+	switch event.EventType() {
+	case events.RoleCreated:
+		data := new(eventdata.RoleCreated)
+		if err := event.Data().ToProto(data); err != nil {
+			return p, err
+		}
+
+		p.Name = data.GetName()
+		p.Description = data.GetDescription()
+		p.Scopes = data.GetScopes()
+		p.K8SClusterRole = data.GetK8SClusterRole()
+
+		if err := r.projectCreated(event, p.DomainProjection); err != nil {
+			return nil, err
+		}
+	case events.RoleUpdated:
+		data := new(eventdata.RoleUpdated)
+		if err := event.Data().ToProto(data); err != nil {
+			return p, err
+		}
+
+		if data.Description != nil {
+			p.Description = data.Description.Value
+		}
+		if data.Scopes != nil {
+			p.Scopes = data.Scopes.Values
+		}
+		if data.K8SClusterRole != nil {
+			p.K8SClusterRole = data.K8SClusterRole.Value
+		}
+	case events.RoleDeleted:
+		if err := r.projectDeleted(event, p.DomainProjection); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.ErrInvalidEventType
+	}
+
+	if err := r.projectModified(event, p.DomainProjection); err != nil {
+		return nil, err
+	}
+	p.IncrementVersion()
+
+	return p, nil
+}
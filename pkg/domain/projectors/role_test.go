@@ -0,0 +1,77 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectors
+
+import (
+	"context"
+	"time"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	metadata "github.com/finleap-connect/monoskope/pkg/domain/metadata"
+	"github.com/finleap-connect/monoskope/pkg/domain/mock"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("process role", func() {
+	ctx := context.Background()
+
+	mdManager, err := metadata.NewDomainMetadataManager(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	mdManager.SetUserInformation(&metadata.UserInformation{
+		Id:    mock.TestAdminUser.ID(),
+		Name:  mock.TestAdminUser.Name,
+		Email: mock.TestAdminUser.Email,
+	})
+	ctx = mdManager.GetContext()
+
+	It("can handle role events", func() {
+		roleProjector := NewRoleProjector()
+		roleId := uuid.New()
+		roleProjection := roleProjector.NewProjection(roleId)
+
+		roleCreatedEventData := es.ToEventDataFromProto(&eventdata.RoleCreated{
+			Name:           "auditor",
+			Description:    "Read-only access",
+			Scopes:         []string{"tenant"},
+			K8SClusterRole: "view",
+		})
+		roleProjection, err := roleProjector.Project(ctx, es.NewEvent(ctx, events.RoleCreated, roleCreatedEventData, time.Now().UTC(), aggregates.Role, roleId, 1), roleProjection)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roleProjection.Version()).To(Equal(uint64(1)))
+		Expect(roleProjection.GetName()).To(Equal("auditor"))
+		Expect(roleProjection.GetScopes()).To(ConsistOf("tenant"))
+		Expect(roleProjection.GetK8SClusterRole()).To(Equal("view"))
+
+		roleUpdatedEventData := es.ToEventDataFromProto(&eventdata.RoleUpdated{
+			Scopes:         &eventdata.StringList{Values: []string{"tenant", "cluster"}},
+			K8SClusterRole: wrapperspb.String(""),
+		})
+		roleProjection, err = roleProjector.Project(ctx, es.NewEvent(ctx, events.RoleUpdated, roleUpdatedEventData, time.Now().UTC(), aggregates.Role, roleId, 2), roleProjection)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roleProjection.GetDescription()).To(Equal("Read-only access"))
+		Expect(roleProjection.GetScopes()).To(ConsistOf("tenant", "cluster"))
+		Expect(roleProjection.GetK8SClusterRole()).To(BeEmpty())
+
+		roleProjection, err = roleProjector.Project(ctx, es.NewEvent(ctx, events.RoleDeleted, nil, time.Now().UTC(), aggregates.Role, roleId, 3), roleProjection)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roleProjection.GetMetadata().GetDeleted()).NotTo(BeNil())
+	})
+})
@@ -61,6 +61,43 @@ func (t *tenantProjector) Project(ctx context.Context, event es.Event, p *projec
 			return p, err
 		}
 		p.Name = data.GetName().GetValue()
+	case events.TenantCreatedV2:
+		data := new(eventdata.TenantCreatedV2)
+		if err := event.Data().ToProto(data); err != nil {
+			return p, err
+		}
+
+		p.Name = data.GetName()
+		p.Prefix = data.GetPrefix()
+		p.ParentId = data.GetParentId()
+		p.CostCentre = data.GetCostCentre()
+		p.Contact = data.GetContact()
+		p.MaxClusterBindings = data.GetMaxClusterBindings()
+
+		if err := t.projectCreated(event, p.DomainProjection); err != nil {
+			return nil, err
+		}
+	case events.TenantUpdatedV2:
+		data := new(eventdata.TenantUpdatedV2)
+		if err := event.Data().ToProto(data); err != nil {
+			return p, err
+		}
+
+		if data.Name != nil {
+			p.Name = data.Name.Value
+		}
+		if data.ParentId != nil {
+			p.ParentId = data.ParentId.Value
+		}
+		if data.CostCentre != nil {
+			p.CostCentre = data.CostCentre.Value
+		}
+		if data.Contact != nil {
+			p.Contact = data.Contact.Value
+		}
+		if data.MaxClusterBindings != nil {
+			p.MaxClusterBindings = data.MaxClusterBindings.Value
+		}
 	case events.TenantDeleted:
 		if err := t.projectDeleted(event, p.DomainProjection); err != nil {
 			return nil, err
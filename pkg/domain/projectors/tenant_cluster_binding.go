@@ -51,6 +51,7 @@ func (t *tenantclusterbindingProjector) Project(ctx context.Context, event es.Ev
 
 		p.TenantId = data.GetTenantId()
 		p.ClusterId = data.GetClusterId()
+		p.ClusterSelector = data.GetClusterSelector()
 
 		if err := t.projectCreated(event, p.DomainProjection); err != nil {
 			return nil, err
@@ -27,6 +27,7 @@ import (
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var _ = Describe("process tenant", func() {
@@ -68,4 +69,42 @@ var _ = Describe("process tenant", func() {
 		Expect(dp.GetCreated()).ToNot(BeNil())
 	})
 
+	It("can handle TenantCreatedV2 and TenantUpdatedV2 events", func() {
+		tenantProjector := NewTenantProjector()
+		tenantId := uuid.New()
+		parentId := uuid.New().String()
+		tenantProjection := tenantProjector.NewProjection(tenantId)
+
+		tenantCreatedEventData := es.ToEventDataFromProto(&eventdata.TenantCreatedV2{
+			Name:               expectedName,
+			Prefix:             expectedPrefix,
+			ParentId:           parentId,
+			CostCentre:         "CC-42",
+			Contact:            "team@example.com",
+			MaxClusterBindings: 3,
+		})
+		tenantProjection, err := tenantProjector.Project(ctx, es.NewEvent(ctx, events.TenantCreatedV2, tenantCreatedEventData, time.Now().UTC(), aggregates.Tenant, tenantId, 1), tenantProjection)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(tenantProjection.GetName()).To(Equal(expectedName))
+		Expect(tenantProjection.GetPrefix()).To(Equal(expectedPrefix))
+		Expect(tenantProjection.GetParentId()).To(Equal(parentId))
+		Expect(tenantProjection.GetCostCentre()).To(Equal("CC-42"))
+		Expect(tenantProjection.GetContact()).To(Equal("team@example.com"))
+		Expect(tenantProjection.GetMaxClusterBindings()).To(Equal(uint32(3)))
+
+		tenantUpdatedEventData := es.ToEventDataFromProto(&eventdata.TenantUpdatedV2{
+			ParentId:   wrapperspb.String(""),
+			CostCentre: wrapperspb.String("CC-43"),
+		})
+		tenantProjection, err = tenantProjector.Project(ctx, es.NewEvent(ctx, events.TenantUpdatedV2, tenantUpdatedEventData, time.Now().UTC(), aggregates.Tenant, tenantId, 2), tenantProjection)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(tenantProjection.Version()).To(Equal(uint64(2)))
+		Expect(tenantProjection.GetName()).To(Equal(expectedName))
+		Expect(tenantProjection.GetParentId()).To(BeEmpty())
+		Expect(tenantProjection.GetCostCentre()).To(Equal("CC-43"))
+		Expect(tenantProjection.GetContact()).To(Equal("team@example.com"))
+		Expect(tenantProjection.GetMaxClusterBindings()).To(Equal(uint32(3)))
+	})
 })
@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	projectionsApi "github.com/finleap-connect/monoskope/pkg/api/domain/projections"
 	eventsourcingApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
 	"github.com/finleap-connect/monoskope/pkg/domain/handler"
@@ -27,6 +28,8 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/eventsourcing/eventhandler"
 	esr "github.com/finleap-connect/monoskope/pkg/eventsourcing/repositories"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/storage"
+	"github.com/go-pg/pg/v10"
 )
 
 type QueryHandlerDomain struct {
@@ -37,19 +40,65 @@ type QueryHandlerDomain struct {
 	ClusterRepository              repositories.ClusterRepository
 	TenantClusterBindingRepository repositories.TenantClusterBindingRepository
 	ClusterAccessRepo              repositories.ClusterAccessRepository
+	RoleRepository                 repositories.RoleRepository
+	VisibilityRepo                 repositories.VisibilityRepository
+	ProjectionRebuilders           []handler.ProjectionRebuilder
 }
 
 func NewQueryHandlerDomain(ctx context.Context, eventBus eventsourcing.EventBusConsumer, esClient eventsourcingApi.EventStoreClient) (*QueryHandlerDomain, error) {
+	return newQueryHandlerDomain(ctx, eventBus, esClient, nil)
+}
+
+// NewQueryHandlerDomainWithPostgres creates a QueryHandlerDomain which keeps the projections in the given database
+// instead of memory. On startup only the events stored since the projections have been checkpointed are applied.
+func NewQueryHandlerDomainWithPostgres(ctx context.Context, eventBus eventsourcing.EventBusConsumer, esClient eventsourcingApi.EventStoreClient, db *pg.DB) (*QueryHandlerDomain, error) {
+	return newQueryHandlerDomain(ctx, eventBus, esClient, db)
+}
+
+func newQueryHandlerDomain(ctx context.Context, eventBus eventsourcing.EventBusConsumer, esClient eventsourcingApi.EventStoreClient, db *pg.DB) (*QueryHandlerDomain, error) {
 	d := new(QueryHandlerDomain)
 
+	// Register upcasters
+	if err := registerUpcasters(); err != nil {
+		return nil, err
+	}
+
+	// Setup stores of the projections
+	userRoleBindingStore, err := newProjectionStore(ctx, db, aggregates.UserRoleBinding, projections.NewProtoCodec[*projections.UserRoleBinding, *projectionsApi.UserRoleBinding](projections.NewUserRoleBinding))
+	if err != nil {
+		return nil, err
+	}
+	userStore, err := newProjectionStore(ctx, db, aggregates.User, projections.NewProtoCodec[*projections.User, *projectionsApi.User](projections.NewUserProjection))
+	if err != nil {
+		return nil, err
+	}
+	tenantStore, err := newProjectionStore(ctx, db, aggregates.Tenant, projections.NewProtoCodec[*projections.Tenant, *projectionsApi.Tenant](projections.NewTenantProjection))
+	if err != nil {
+		return nil, err
+	}
+	clusterStore, err := newProjectionStore(ctx, db, aggregates.Cluster, projections.NewProtoCodec[*projections.Cluster, *projectionsApi.Cluster](projections.NewClusterProjection))
+	if err != nil {
+		return nil, err
+	}
+	tenantClusterBindingStore, err := newProjectionStore(ctx, db, aggregates.TenantClusterBinding, projections.NewProtoCodec[*projections.TenantClusterBinding, *projectionsApi.TenantClusterBinding](projections.NewTenantClusterBindingProjection))
+	if err != nil {
+		return nil, err
+	}
+	roleStore, err := newProjectionStore(ctx, db, aggregates.Role, projections.NewProtoCodec[*projections.Role, *projectionsApi.Role](projections.NewRoleProjection))
+	if err != nil {
+		return nil, err
+	}
+
 	// Setup repositories
-	d.UserRoleBindingRepository = repositories.NewUserRoleBindingRepository(esr.NewInMemoryRepository[*projections.UserRoleBinding]())
-	d.UserRepository = repositories.NewUserRepository(esr.NewInMemoryRepository[*projections.User](), d.UserRoleBindingRepository)
-	d.TenantRepository = repositories.NewTenantRepository(esr.NewInMemoryRepository[*projections.Tenant]())
+	d.UserRoleBindingRepository = repositories.NewUserRoleBindingRepository(userRoleBindingStore)
+	d.UserRepository = repositories.NewUserRepository(userStore, d.UserRoleBindingRepository)
+	d.TenantRepository = repositories.NewTenantRepository(tenantStore)
 	d.TenantUserRepository = repositories.NewTenantUserRepository(d.UserRepository, d.UserRoleBindingRepository, d.TenantRepository)
-	d.ClusterRepository = repositories.NewClusterRepository(esr.NewInMemoryRepository[*projections.Cluster]())
-	d.TenantClusterBindingRepository = repositories.NewTenantClusterBindingRepository(esr.NewInMemoryRepository[*projections.TenantClusterBinding]())
-	d.ClusterAccessRepo = repositories.NewClusterAccessRepository(d.TenantClusterBindingRepository, d.ClusterRepository, d.UserRoleBindingRepository, d.TenantRepository)
+	d.ClusterRepository = repositories.NewClusterRepository(clusterStore)
+	d.TenantClusterBindingRepository = repositories.NewTenantClusterBindingRepository(tenantClusterBindingStore, d.ClusterRepository)
+	d.RoleRepository = repositories.NewRoleRepository(roleStore)
+	d.ClusterAccessRepo = repositories.NewClusterAccessRepository(d.TenantClusterBindingRepository, d.ClusterRepository, d.UserRoleBindingRepository, d.TenantRepository, d.RoleRepository)
+	d.VisibilityRepo = repositories.NewVisibilityRepository(d.UserRoleBindingRepository, d.TenantRepository, d.ClusterAccessRepo)
 
 	// Setup projectors
 	userProjector := projectors.NewUserProjector()
@@ -57,6 +106,16 @@ func NewQueryHandlerDomain(ctx context.Context, eventBus eventsourcing.EventBusC
 	tenantProjector := projectors.NewTenantProjector()
 	clusterProjector := projectors.NewClusterProjector()
 	tenantClusterBindingProjector := projectors.NewTenantClusterBindingProjector()
+	roleProjector := projectors.NewRoleProjector()
+
+	// Setup rebuilders
+	userRebuilder := handler.NewProjectionRebuilder[*projections.User](esClient, aggregates.User, userProjector, userStore)
+	userRoleBindingRebuilder := handler.NewProjectionRebuilder[*projections.UserRoleBinding](esClient, aggregates.UserRoleBinding, userRoleBindingProjector, userRoleBindingStore)
+	tenantRebuilder := handler.NewProjectionRebuilder[*projections.Tenant](esClient, aggregates.Tenant, tenantProjector, tenantStore)
+	clusterRebuilder := handler.NewProjectionRebuilder[*projections.Cluster](esClient, aggregates.Cluster, clusterProjector, clusterStore)
+	tenantClusterBindingRebuilder := handler.NewProjectionRebuilder[*projections.TenantClusterBinding](esClient, aggregates.TenantClusterBinding, tenantClusterBindingProjector, tenantClusterBindingStore)
+	roleRebuilder := handler.NewProjectionRebuilder[*projections.Role](esClient, aggregates.Role, roleProjector, roleStore)
+	d.ProjectionRebuilders = []handler.ProjectionRebuilder{userRebuilder, userRoleBindingRebuilder, tenantRebuilder, clusterRebuilder, tenantClusterBindingRebuilder, roleRebuilder}
 
 	// Setup handler
 	userProjectingHandler := eventhandler.NewProjectingEventHandler[*projections.User](userProjector, d.UserRepository)
@@ -64,14 +123,16 @@ func NewQueryHandlerDomain(ctx context.Context, eventBus eventsourcing.EventBusC
 	userRoleBindingProjectingHandler := eventhandler.NewProjectingEventHandler[*projections.UserRoleBinding](userRoleBindingProjector, d.UserRoleBindingRepository)
 	clusterProjectingHandler := eventhandler.NewProjectingEventHandler[*projections.Cluster](clusterProjector, d.ClusterRepository)
 	tenantClusterBindingProjectingHandler := eventhandler.NewProjectingEventHandler[*projections.TenantClusterBinding](tenantClusterBindingProjector, d.TenantClusterBindingRepository)
+	roleProjectingHandler := eventhandler.NewProjectingEventHandler[*projections.Role](roleProjector, d.RoleRepository)
 
 	// Setup middleware
 	refreshDuration := time.Second * 30
-	userHandlerChain := eventsourcing.UseEventHandlerMiddleware(userProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration))
-	userRoleBindingHandlerChain := eventsourcing.UseEventHandlerMiddleware(userRoleBindingProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration))
-	tenantHandlerChain := eventsourcing.UseEventHandlerMiddleware(tenantProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration))
-	clusterHandlerChain := eventsourcing.UseEventHandlerMiddleware(clusterProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration))
-	tenantClusterBindingHandlerChain := eventsourcing.UseEventHandlerMiddleware(tenantClusterBindingProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration))
+	userHandlerChain := eventsourcing.UseEventHandlerMiddleware(userProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration), userRebuilder.Middleware())
+	userRoleBindingHandlerChain := eventsourcing.UseEventHandlerMiddleware(userRoleBindingProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration), userRoleBindingRebuilder.Middleware())
+	tenantHandlerChain := eventsourcing.UseEventHandlerMiddleware(tenantProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration), tenantRebuilder.Middleware())
+	clusterHandlerChain := eventsourcing.UseEventHandlerMiddleware(clusterProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration), clusterRebuilder.Middleware())
+	tenantClusterBindingHandlerChain := eventsourcing.UseEventHandlerMiddleware(tenantClusterBindingProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration), tenantClusterBindingRebuilder.Middleware())
+	roleHandlerChain := eventsourcing.UseEventHandlerMiddleware(roleProjectingHandler, eventhandler.NewEventStoreReplayMiddleware(esClient), eventhandler.NewEventStoreRefreshMiddleware(esClient, refreshDuration), roleRebuilder.Middleware())
 
 	// Setup matcher for event bus
 	userMatcher := eventBus.Matcher().MatchAggregateType(aggregates.User)
@@ -79,6 +140,7 @@ func NewQueryHandlerDomain(ctx context.Context, eventBus eventsourcing.EventBusC
 	userRoleBindingMatcher := eventBus.Matcher().MatchAggregateType(aggregates.UserRoleBinding)
 	clusterMatcher := eventBus.Matcher().MatchAggregateType(aggregates.Cluster)
 	tenantClusterBindingMatcher := eventBus.Matcher().MatchAggregateType(aggregates.TenantClusterBinding)
+	roleMatcher := eventBus.Matcher().MatchAggregateType(aggregates.Role)
 
 	// Register event handler with event bus
 	if err := eventBus.AddHandler(ctx, userHandlerChain, userMatcher); err != nil {
@@ -96,23 +158,37 @@ func NewQueryHandlerDomain(ctx context.Context, eventBus eventsourcing.EventBusC
 	if err := eventBus.AddHandler(ctx, tenantClusterBindingHandlerChain, tenantClusterBindingMatcher); err != nil {
 		return nil, err
 	}
+	if err := eventBus.AddHandler(ctx, roleHandlerChain, roleMatcher); err != nil {
+		return nil, err
+	}
 
 	// Start repo warming
-	if err := handler.WarmUp(ctx, esClient, aggregates.User, userHandlerChain); err != nil {
+	if err := handler.WarmUpRepository[*projections.User](ctx, esClient, aggregates.User, userStore, userHandlerChain); err != nil {
+		return nil, err
+	}
+	if err := handler.WarmUpRepository[*projections.UserRoleBinding](ctx, esClient, aggregates.UserRoleBinding, userRoleBindingStore, userRoleBindingHandlerChain); err != nil {
 		return nil, err
 	}
-	if err := handler.WarmUp(ctx, esClient, aggregates.UserRoleBinding, userRoleBindingHandlerChain); err != nil {
+	if err := handler.WarmUpRepository[*projections.Tenant](ctx, esClient, aggregates.Tenant, tenantStore, tenantHandlerChain); err != nil {
 		return nil, err
 	}
-	if err := handler.WarmUp(ctx, esClient, aggregates.Tenant, tenantHandlerChain); err != nil {
+	if err := handler.WarmUpRepository[*projections.Cluster](ctx, esClient, aggregates.Cluster, clusterStore, clusterHandlerChain); err != nil {
 		return nil, err
 	}
-	if err := handler.WarmUp(ctx, esClient, aggregates.Cluster, clusterHandlerChain); err != nil {
+	if err := handler.WarmUpRepository[*projections.TenantClusterBinding](ctx, esClient, aggregates.TenantClusterBinding, tenantClusterBindingStore, tenantClusterBindingHandlerChain); err != nil {
 		return nil, err
 	}
-	if err := handler.WarmUp(ctx, esClient, aggregates.TenantClusterBinding, tenantClusterBindingHandlerChain); err != nil {
+	if err := handler.WarmUpRepository[*projections.Role](ctx, esClient, aggregates.Role, roleStore, roleHandlerChain); err != nil {
 		return nil, err
 	}
 
 	return d, nil
 }
+
+// newProjectionStore creates a repository backed by the database if given, in memory otherwise.
+func newProjectionStore[T eventsourcing.Projection](ctx context.Context, db *pg.DB, aggregateType eventsourcing.AggregateType, codec eventsourcing.ProjectionCodec[T]) (eventsourcing.SwappableRepository[T], error) {
+	if db == nil {
+		return esr.NewInMemoryRepository[T](), nil
+	}
+	return storage.NewPostgresRepository(ctx, db, aggregateType.String(), codec)
+}
@@ -21,15 +21,20 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
 	domain_projections "github.com/finleap-connect/monoskope/pkg/domain/projections"
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
 	"github.com/finleap-connect/monoskope/pkg/k8s"
+	"github.com/finleap-connect/monoskope/pkg/logger"
 	"github.com/google/uuid"
+	"k8s.io/utils/strings/slices"
 )
 
 type clusterAccessRepository struct {
+	log                      logger.Logger
 	tenantRepo               TenantRepository
 	clusterRepo              ClusterRepository
 	userRoleBindingRepo      UserRoleBindingRepository
 	tenantClusterBindingRepo TenantClusterBindingRepository
+	roleRepo                 RoleRepository
 }
 
 // ClusterAccessRepository is a repository for reading accesses to a cluster.
@@ -41,12 +46,14 @@ type ClusterAccessRepository interface {
 }
 
 // NewClusterAccessRepository creates a repository for reading cluster access projections.
-func NewClusterAccessRepository(tenantClusterBindingRepo TenantClusterBindingRepository, clusterRepo ClusterRepository, userRoleBindingRepo UserRoleBindingRepository, tenantRepo TenantRepository) ClusterAccessRepository {
+func NewClusterAccessRepository(tenantClusterBindingRepo TenantClusterBindingRepository, clusterRepo ClusterRepository, userRoleBindingRepo UserRoleBindingRepository, tenantRepo TenantRepository, roleRepo RoleRepository) ClusterAccessRepository {
 	return &clusterAccessRepository{
+		log:                      logger.WithName("cluster-access-repository"),
 		clusterRepo:              clusterRepo,
 		userRoleBindingRepo:      userRoleBindingRepo,
 		tenantClusterBindingRepo: tenantClusterBindingRepo,
 		tenantRepo:               tenantRepo,
+		roleRepo:                 roleRepo,
 	}
 }
 
@@ -60,7 +67,10 @@ func (r *clusterAccessRepository) GetClustersAccessibleByUserId(ctx context.Cont
 	for _, clusterV2 := range clustersV2 {
 		var roles []string
 		for _, clusterRole := range clusterV2.ClusterRoles {
-			roles = append(roles, clusterRole.Role)
+			// the same role can be granted by different scopes
+			if !slices.Contains(roles, clusterRole.Role) {
+				roles = append(roles, clusterRole.Role)
+			}
 		}
 		clusters = append(clusters, &projections.ClusterAccess{
 			Cluster: clusterV2.Cluster,
@@ -114,34 +124,59 @@ func (r *clusterAccessRepository) GetClustersAccessibleByUserIdV2(ctx context.Co
 		return
 	}
 
-	tenantMap := make(map[string]bool)
+	// regular users have access based on tenant membership, memberships are inherited by child tenants
+	var tenants []*domain_projections.Tenant
+	tenants, err = r.tenantRepo.AllWith(ctx, false)
+	if err != nil {
+		return
+	}
+	// custom roles with a K8s cluster role grant a cluster role of the same name
+	var definedRoles []*domain_projections.Role
+	definedRoles, err = r.roleRepo.AllWith(ctx, false)
+	if err != nil {
+		return
+	}
+	k8sMappedRoles := make(map[string]bool)
+	for _, role := range definedRoles {
+		if role.K8SClusterRole != "" {
+			k8sMappedRoles[role.Name] = true
+		}
+	}
+
+	tenantsById := make(map[string]*domain_projections.Tenant)
+	childrenById := make(map[string][]*domain_projections.Tenant)
+	for _, tenant := range tenants {
+		tenantsById[tenant.Id] = tenant
+		childrenById[tenant.ParentId] = append(childrenById[tenant.ParentId], tenant)
+	}
 
-	// regular users have access based on tenant membership
+	// collect tenant scoped roles of the user per tenant
+	tenantRoles := make(map[string][]string)
 	for _, binding := range roleBindings {
-		// search rolebindings for tenant scoped bindings
 		if binding.Scope == string(scopes.Tenant) {
-			// check if we already had this tenant
-			if _, ok := tenantMap[binding.Resource]; ok {
-				continue
-			}
-			tenantMap[binding.Resource] = true
-			tenantId := uuid.MustParse(binding.Resource)
+			tenantRoles[binding.Resource] = append(tenantRoles[binding.Resource], binding.Role)
+		}
+	}
 
-			var tenant *domain_projections.Tenant
-			tenant, err = r.tenantRepo.ById(ctx, tenantId)
-			if err != nil {
-				return
-			}
-			// Skip deleted tenants
-			if tenant.Metadata.Deleted != nil {
-				continue
-			}
+	tenantMap := make(map[string]bool)
+	for _, binding := range roleBindings {
+		// search rolebindings for tenant scoped bindings
+		if binding.Scope != string(scopes.Tenant) {
+			continue
+		}
 
-			var tenantBindings []*domain_projections.UserRoleBinding
-			tenantBindings, err = r.userRoleBindingRepo.ByUserIdScopeAndResource(ctx, id, scopes.Tenant, binding.Resource)
-			if err != nil {
-				return
+		// Skip deleted tenants
+		tenant, ok := tenantsById[binding.Resource]
+		if !ok {
+			continue
+		}
+
+		for _, accessibleTenant := range getTenantWithDescendants(tenant, childrenById) {
+			// check if we already had this tenant
+			if tenantMap[accessibleTenant.Id] {
+				continue
 			}
+			tenantMap[accessibleTenant.Id] = true
 
 			// Set roles within cluster
 			var k8sRoles = []*projections.ClusterRole{
@@ -151,44 +186,192 @@ func (r *clusterAccessRepository) GetClustersAccessibleByUserIdV2(ctx context.Co
 				},
 			}
 
-			for _, tenantBinding := range tenantBindings {
-				if tenantBinding.Role == string(roles.Admin) {
+			for _, role := range getInheritedTenantRoles(accessibleTenant, tenantsById, tenantRoles) {
+				if role == string(roles.Admin) {
 					k8sRoles = append(k8sRoles, &projections.ClusterRole{
 						Scope: projections.ClusterRole_TENANT,
 						Role:  string(k8s.AdminRole),
 					})
 				}
-				if tenantBinding.Role == string(roles.OnCall) {
+				if role == string(roles.OnCall) {
 					k8sRoles = append(k8sRoles, &projections.ClusterRole{
 						Scope: projections.ClusterRole_TENANT,
 						Role:  string(k8s.OnCallRole),
 					})
 				}
+				if k8sMappedRoles[role] {
+					k8sRoles = append(k8sRoles, &projections.ClusterRole{
+						Scope: projections.ClusterRole_TENANT,
+						Role:  role,
+					})
+				}
 			}
 
 			// get accessible cluster by tenant and append
 			var tenantClusterBindings []*domain_projections.TenantClusterBinding
-			tenantClusterBindings, err = r.tenantClusterBindingRepo.GetByTenantId(ctx, tenantId)
+			tenantClusterBindings, err = r.tenantClusterBindingRepo.GetByTenantId(ctx, uuid.MustParse(accessibleTenant.Id))
 			if err != nil {
 				return
 			}
 
-			for _, tcb := range tenantClusterBindings {
-				var cluster *domain_projections.Cluster
-				cluster, err = r.clusterRepo.ById(ctx, uuid.MustParse(tcb.ClusterId))
-				if err != nil {
-					return
-				}
-
-				// Skip deleted clusters
-				if cluster.Metadata.Deleted != nil {
-					continue
-				}
+			var tenantClusters []*domain_projections.Cluster
+			tenantClusters, err = r.getClustersOfBindings(ctx, tenantClusterBindings)
+			if err != nil {
+				return
+			}
 
-				clusters = append(clusters, &projections.ClusterAccessV2{Cluster: cluster.Cluster, ClusterRoles: k8sRoles})
+			for _, cluster := range tenantClusters {
+				clusters = appendClusterAccess(clusters, cluster, k8sRoles)
 			}
 		}
 	}
 
+	// cluster scoped role bindings grant access to a single cluster
+	for _, binding := range roleBindings {
+		if binding.Scope != string(scopes.Cluster) {
+			continue
+		}
+
+		clusterId, parseErr := uuid.Parse(binding.Resource)
+		if parseErr != nil {
+			r.log.Info("Skipping cluster scoped role binding with invalid resource.", "roleBindingId", binding.Id, "resource", binding.Resource)
+			continue
+		}
+
+		cluster, clusterErr := r.clusterRepo.ById(ctx, clusterId)
+		if clusterErr == esErrors.ErrProjectionNotFound {
+			r.log.Info("Skipping cluster scoped role binding of unknown cluster.", "roleBindingId", binding.Id, "clusterId", clusterId)
+			continue
+		}
+		if clusterErr != nil {
+			err = clusterErr
+			return
+		}
+		// Skip deleted clusters
+		if cluster.Metadata.Deleted != nil {
+			continue
+		}
+
+		var k8sRoles = []*projections.ClusterRole{
+			{
+				Scope: projections.ClusterRole_CLUSTER,
+				Role:  string(k8s.DefaultRole),
+			},
+		}
+		if binding.Role == string(roles.Admin) {
+			k8sRoles = append(k8sRoles, &projections.ClusterRole{
+				Scope: projections.ClusterRole_CLUSTER,
+				Role:  string(k8s.AdminRole),
+			})
+		}
+		if binding.Role == string(roles.OnCall) {
+			k8sRoles = append(k8sRoles, &projections.ClusterRole{
+				Scope: projections.ClusterRole_CLUSTER,
+				Role:  string(k8s.OnCallRole),
+			})
+		}
+		if k8sMappedRoles[binding.Role] {
+			k8sRoles = append(k8sRoles, &projections.ClusterRole{
+				Scope: projections.ClusterRole_CLUSTER,
+				Role:  binding.Role,
+			})
+		}
+		clusters = appendClusterAccess(clusters, cluster, k8sRoles)
+	}
+
 	return
 }
+
+// appendClusterAccess appends the access to the given cluster or merges the roles into an existing access to the same cluster
+func appendClusterAccess(clusterAccesses []*projections.ClusterAccessV2, cluster *domain_projections.Cluster, clusterRoles []*projections.ClusterRole) []*projections.ClusterAccessV2 {
+	for _, clusterAccess := range clusterAccesses {
+		if clusterAccess.Cluster.Id != cluster.Id {
+			continue
+		}
+
+		mergedRoles := append([]*projections.ClusterRole{}, clusterAccess.ClusterRoles...)
+		for _, clusterRole := range clusterRoles {
+			if !containsClusterRole(mergedRoles, clusterRole) {
+				mergedRoles = append(mergedRoles, clusterRole)
+			}
+		}
+		clusterAccess.ClusterRoles = mergedRoles
+		return clusterAccesses
+	}
+	return append(clusterAccesses, &projections.ClusterAccessV2{Cluster: cluster.Cluster, ClusterRoles: clusterRoles})
+}
+
+func containsClusterRole(clusterRoles []*projections.ClusterRole, clusterRole *projections.ClusterRole) bool {
+	for _, r := range clusterRoles {
+		if r.Scope == clusterRole.Scope && r.Role == clusterRole.Role {
+			return true
+		}
+	}
+	return false
+}
+
+// getTenantWithDescendants returns the given tenant followed by all of its descendants
+func getTenantWithDescendants(tenant *domain_projections.Tenant, childrenById map[string][]*domain_projections.Tenant) []*domain_projections.Tenant {
+	result := []*domain_projections.Tenant{tenant}
+	visited := map[string]bool{tenant.Id: true}
+	for i := 0; i < len(result); i++ {
+		for _, child := range childrenById[result[i].Id] {
+			if !visited[child.Id] {
+				visited[child.Id] = true
+				result = append(result, child)
+			}
+		}
+	}
+	return result
+}
+
+// getInheritedTenantRoles returns the distinct roles of the tenant and all of its ancestors
+func getInheritedTenantRoles(tenant *domain_projections.Tenant, tenantsById map[string]*domain_projections.Tenant, tenantRoles map[string][]string) []string {
+	var result []string
+	seenRoles := make(map[string]bool)
+	visited := make(map[string]bool)
+	for current := tenant; current != nil && !visited[current.Id]; current = tenantsById[current.ParentId] {
+		visited[current.Id] = true
+		for _, role := range tenantRoles[current.Id] {
+			if !seenRoles[role] {
+				seenRoles[role] = true
+				result = append(result, role)
+			}
+		}
+	}
+	return result
+}
+
+// getClustersOfBindings returns the distinct, not deleted clusters referenced by the given bindings either by id or by label selector
+func (r *clusterAccessRepository) getClustersOfBindings(ctx context.Context, tenantClusterBindings []*domain_projections.TenantClusterBinding) ([]*domain_projections.Cluster, error) {
+	var clusters []*domain_projections.Cluster
+	clusterMap := make(map[string]bool)
+	appendCluster := func(cluster *domain_projections.Cluster) {
+		// Skip deleted and already added clusters
+		if cluster.Metadata.Deleted != nil || clusterMap[cluster.Id] {
+			return
+		}
+		clusterMap[cluster.Id] = true
+		clusters = append(clusters, cluster)
+	}
+
+	for _, tcb := range tenantClusterBindings {
+		if tcb.ClusterSelector != "" {
+			selectedClusters, err := r.clusterRepo.AllWithLabelSelector(ctx, false, tcb.ClusterSelector)
+			if err != nil {
+				return nil, err
+			}
+			for _, cluster := range selectedClusters {
+				appendCluster(cluster)
+			}
+			continue
+		}
+
+		cluster, err := r.clusterRepo.ById(ctx, uuid.MustParse(tcb.ClusterId))
+		if err != nil {
+			return nil, err
+		}
+		appendCluster(cluster)
+	}
+	return clusters, nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
 	"github.com/finleap-connect/monoskope/pkg/domain/projections"
 	es_repos "github.com/finleap-connect/monoskope/pkg/eventsourcing/repositories"
+	"github.com/finleap-connect/monoskope/pkg/k8s"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -59,6 +60,15 @@ var _ = Describe("pkg/domain/repositories/clusterAccessRepository", func() {
 	binding.ClusterId = clusterId.String()
 	binding.TenantId = tenantId.String()
 
+	findClusterAccess := func(clusters []*projectionsApi.ClusterAccessV2, id string) *projectionsApi.ClusterAccessV2 {
+		for _, clusterAccess := range clusters {
+			if clusterAccess.Cluster.Id == id {
+				return clusterAccess
+			}
+		}
+		return nil
+	}
+
 	It("can read/write projections", func() {
 		inMemoryRoleRepo := es_repos.NewInMemoryRepository[*projections.UserRoleBinding]()
 		Expect(inMemoryRoleRepo.Upsert(context.Background(), adminRoleBinding)).NotTo(HaveOccurred())
@@ -76,9 +86,9 @@ var _ = Describe("pkg/domain/repositories/clusterAccessRepository", func() {
 
 		inMemoryTenantClusterBindingRepo := es_repos.NewInMemoryRepository[*projections.TenantClusterBinding]()
 		Expect(inMemoryTenantClusterBindingRepo.Upsert(context.Background(), binding)).NotTo(HaveOccurred())
-		tenantClusterBindingRepo := NewTenantClusterBindingRepository(inMemoryTenantClusterBindingRepo)
+		tenantClusterBindingRepo := NewTenantClusterBindingRepository(inMemoryTenantClusterBindingRepo, clusterRepo)
 
-		clusterAccessRepo := NewClusterAccessRepository(tenantClusterBindingRepo, clusterRepo, userRoleBindingRepo, tenantRepo)
+		clusterAccessRepo := NewClusterAccessRepository(tenantClusterBindingRepo, clusterRepo, userRoleBindingRepo, tenantRepo, NewRoleRepository(es_repos.NewInMemoryRepository[*projections.Role]()))
 
 		clusters, err := clusterAccessRepo.GetClustersAccessibleByUserId(context.Background(), otherUserId)
 		Expect(err).NotTo(HaveOccurred())
@@ -92,4 +102,231 @@ var _ = Describe("pkg/domain/repositories/clusterAccessRepository", func() {
 		Expect(len(clustersV2)).To(BeNumerically("==", 1))
 		Expect(clustersV2[0].Cluster.Id).To(Equal(clusterId.String()))
 	})
+	It("resolves bindings by cluster selector", func() {
+		inMemoryRoleRepo := es_repos.NewInMemoryRepository[*projections.UserRoleBinding]()
+		Expect(inMemoryRoleRepo.Upsert(context.Background(), otherUserRoleBinding)).NotTo(HaveOccurred())
+
+		devCluster := projections.NewClusterProjection(uuid.New())
+		devCluster.Name = "dev-cluster"
+		devCluster.Labels = map[string]string{"env": "dev"}
+		prodCluster := projections.NewClusterProjection(uuid.New())
+		prodCluster.Name = "prod-cluster"
+		prodCluster.Labels = map[string]string{"env": "prod"}
+
+		inMemoryClusterRepo := es_repos.NewInMemoryRepository[*projections.Cluster]()
+		Expect(inMemoryClusterRepo.Upsert(context.Background(), devCluster)).NotTo(HaveOccurred())
+		Expect(inMemoryClusterRepo.Upsert(context.Background(), prodCluster)).NotTo(HaveOccurred())
+
+		inMemoryTenantRepo := es_repos.NewInMemoryRepository[*projections.Tenant]()
+		Expect(inMemoryTenantRepo.Upsert(context.Background(), tenant)).NotTo(HaveOccurred())
+
+		// the dev cluster is bound by id and by selector but must only be returned once
+		selectorBinding := projections.NewTenantClusterBindingProjection(uuid.New())
+		selectorBinding.ClusterSelector = "env=dev"
+		selectorBinding.TenantId = tenantId.String()
+		idBinding := projections.NewTenantClusterBindingProjection(uuid.New())
+		idBinding.ClusterId = devCluster.Id
+		idBinding.TenantId = tenantId.String()
+
+		inMemoryTenantClusterBindingRepo := es_repos.NewInMemoryRepository[*projections.TenantClusterBinding]()
+		Expect(inMemoryTenantClusterBindingRepo.Upsert(context.Background(), selectorBinding)).NotTo(HaveOccurred())
+		Expect(inMemoryTenantClusterBindingRepo.Upsert(context.Background(), idBinding)).NotTo(HaveOccurred())
+
+		clusterAccessRepo := NewClusterAccessRepository(
+			NewTenantClusterBindingRepository(inMemoryTenantClusterBindingRepo, NewClusterRepository(inMemoryClusterRepo)),
+			NewClusterRepository(inMemoryClusterRepo),
+			NewUserRoleBindingRepository(inMemoryRoleRepo),
+			NewTenantRepository(inMemoryTenantRepo),
+			NewRoleRepository(es_repos.NewInMemoryRepository[*projections.Role]()),
+		)
+
+		clustersV2, err := clusterAccessRepo.GetClustersAccessibleByUserIdV2(context.Background(), otherUserId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clustersV2).To(HaveLen(1))
+		Expect(clustersV2[0].Cluster.Id).To(Equal(devCluster.Id))
+	})
+	It("inherits tenant role bindings to child tenants", func() {
+		inMemoryRoleRepo := es_repos.NewInMemoryRepository[*projections.UserRoleBinding]()
+		Expect(inMemoryRoleRepo.Upsert(context.Background(), adminRoleBinding)).NotTo(HaveOccurred())
+
+		childTenant := projections.NewTenantProjection(uuid.New())
+		childTenant.Name = "child-tenant"
+		childTenant.Prefix = "ct"
+		childTenant.ParentId = tenantId.String()
+
+		childCluster := projections.NewClusterProjection(uuid.New())
+		childCluster.Name = "child-cluster"
+
+		inMemoryClusterRepo := es_repos.NewInMemoryRepository[*projections.Cluster]()
+		Expect(inMemoryClusterRepo.Upsert(context.Background(), cluster)).NotTo(HaveOccurred())
+		Expect(inMemoryClusterRepo.Upsert(context.Background(), childCluster)).NotTo(HaveOccurred())
+
+		inMemoryTenantRepo := es_repos.NewInMemoryRepository[*projections.Tenant]()
+		Expect(inMemoryTenantRepo.Upsert(context.Background(), tenant)).NotTo(HaveOccurred())
+		Expect(inMemoryTenantRepo.Upsert(context.Background(), childTenant)).NotTo(HaveOccurred())
+
+		childBinding := projections.NewTenantClusterBindingProjection(uuid.New())
+		childBinding.ClusterId = childCluster.Id
+		childBinding.TenantId = childTenant.Id
+
+		inMemoryTenantClusterBindingRepo := es_repos.NewInMemoryRepository[*projections.TenantClusterBinding]()
+		Expect(inMemoryTenantClusterBindingRepo.Upsert(context.Background(), binding)).NotTo(HaveOccurred())
+		Expect(inMemoryTenantClusterBindingRepo.Upsert(context.Background(), childBinding)).NotTo(HaveOccurred())
+
+		clusterAccessRepo := NewClusterAccessRepository(
+			NewTenantClusterBindingRepository(inMemoryTenantClusterBindingRepo, NewClusterRepository(inMemoryClusterRepo)),
+			NewClusterRepository(inMemoryClusterRepo),
+			NewUserRoleBindingRepository(inMemoryRoleRepo),
+			NewTenantRepository(inMemoryTenantRepo),
+			NewRoleRepository(es_repos.NewInMemoryRepository[*projections.Role]()),
+		)
+
+		clustersV2, err := clusterAccessRepo.GetClustersAccessibleByUserIdV2(context.Background(), adminUserId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clustersV2).To(HaveLen(2))
+		for _, id := range []string{clusterId.String(), childCluster.Id} {
+			clusterAccess := findClusterAccess(clustersV2, id)
+			Expect(clusterAccess).ToNot(BeNil())
+			Expect(clusterAccess.ClusterRoles).To(ConsistOf(
+				&projectionsApi.ClusterRole{Scope: projectionsApi.ClusterRole_CLUSTER, Role: string(k8s.DefaultRole)},
+				&projectionsApi.ClusterRole{Scope: projectionsApi.ClusterRole_TENANT, Role: string(k8s.AdminRole)},
+			))
+		}
+	})
+	It("grants access to single clusters by cluster scoped role bindings", func() {
+		clusterOnCallRoleBinding := projections.NewUserRoleBinding(uuid.New())
+		clusterOnCallRoleBinding.UserId = otherUser.Id
+		clusterOnCallRoleBinding.Role = string(roles.OnCall)
+		clusterOnCallRoleBinding.Scope = string(scopes.Cluster)
+		clusterOnCallRoleBinding.Resource = clusterId.String()
+
+		otherCluster := projections.NewClusterProjection(uuid.New())
+		otherCluster.Name = "other-cluster"
+		clusterAdminRoleBinding := projections.NewUserRoleBinding(uuid.New())
+		clusterAdminRoleBinding.UserId = otherUser.Id
+		clusterAdminRoleBinding.Role = string(roles.Admin)
+		clusterAdminRoleBinding.Scope = string(scopes.Cluster)
+		clusterAdminRoleBinding.Resource = otherCluster.Id
+
+		// bindings of unknown clusters and invalid resources are skipped
+		unknownClusterRoleBinding := projections.NewUserRoleBinding(uuid.New())
+		unknownClusterRoleBinding.UserId = otherUser.Id
+		unknownClusterRoleBinding.Role = string(roles.Admin)
+		unknownClusterRoleBinding.Scope = string(scopes.Cluster)
+		unknownClusterRoleBinding.Resource = uuid.New().String()
+		invalidResourceRoleBinding := projections.NewUserRoleBinding(uuid.New())
+		invalidResourceRoleBinding.UserId = otherUser.Id
+		invalidResourceRoleBinding.Role = string(roles.Admin)
+		invalidResourceRoleBinding.Scope = string(scopes.Cluster)
+		invalidResourceRoleBinding.Resource = "invalid"
+
+		inMemoryRoleRepo := es_repos.NewInMemoryRepository[*projections.UserRoleBinding]()
+		Expect(inMemoryRoleRepo.Upsert(context.Background(), otherUserRoleBinding)).NotTo(HaveOccurred())
+		Expect(inMemoryRoleRepo.Upsert(context.Background(), clusterOnCallRoleBinding)).NotTo(HaveOccurred())
+		Expect(inMemoryRoleRepo.Upsert(context.Background(), clusterAdminRoleBinding)).NotTo(HaveOccurred())
+		Expect(inMemoryRoleRepo.Upsert(context.Background(), unknownClusterRoleBinding)).NotTo(HaveOccurred())
+		Expect(inMemoryRoleRepo.Upsert(context.Background(), invalidResourceRoleBinding)).NotTo(HaveOccurred())
+
+		inMemoryClusterRepo := es_repos.NewInMemoryRepository[*projections.Cluster]()
+		Expect(inMemoryClusterRepo.Upsert(context.Background(), cluster)).NotTo(HaveOccurred())
+		Expect(inMemoryClusterRepo.Upsert(context.Background(), otherCluster)).NotTo(HaveOccurred())
+
+		inMemoryTenantRepo := es_repos.NewInMemoryRepository[*projections.Tenant]()
+		Expect(inMemoryTenantRepo.Upsert(context.Background(), tenant)).NotTo(HaveOccurred())
+
+		inMemoryTenantClusterBindingRepo := es_repos.NewInMemoryRepository[*projections.TenantClusterBinding]()
+		Expect(inMemoryTenantClusterBindingRepo.Upsert(context.Background(), binding)).NotTo(HaveOccurred())
+
+		clusterAccessRepo := NewClusterAccessRepository(
+			NewTenantClusterBindingRepository(inMemoryTenantClusterBindingRepo, NewClusterRepository(inMemoryClusterRepo)),
+			NewClusterRepository(inMemoryClusterRepo),
+			NewUserRoleBindingRepository(inMemoryRoleRepo),
+			NewTenantRepository(inMemoryTenantRepo),
+			NewRoleRepository(es_repos.NewInMemoryRepository[*projections.Role]()),
+		)
+
+		clustersV2, err := clusterAccessRepo.GetClustersAccessibleByUserIdV2(context.Background(), otherUserId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clustersV2).To(HaveLen(2))
+
+		// access via tenant and cluster scope is merged
+		clusterAccess := findClusterAccess(clustersV2, clusterId.String())
+		Expect(clusterAccess).ToNot(BeNil())
+		Expect(clusterAccess.ClusterRoles).To(ConsistOf(
+			&projectionsApi.ClusterRole{Scope: projectionsApi.ClusterRole_CLUSTER, Role: string(k8s.DefaultRole)},
+			&projectionsApi.ClusterRole{Scope: projectionsApi.ClusterRole_TENANT, Role: string(k8s.OnCallRole)},
+			&projectionsApi.ClusterRole{Scope: projectionsApi.ClusterRole_CLUSTER, Role: string(k8s.OnCallRole)},
+		))
+
+		otherClusterAccess := findClusterAccess(clustersV2, otherCluster.Id)
+		Expect(otherClusterAccess).ToNot(BeNil())
+		Expect(otherClusterAccess.ClusterRoles).To(ConsistOf(
+			&projectionsApi.ClusterRole{Scope: projectionsApi.ClusterRole_CLUSTER, Role: string(k8s.DefaultRole)},
+			&projectionsApi.ClusterRole{Scope: projectionsApi.ClusterRole_CLUSTER, Role: string(k8s.AdminRole)},
+		))
+
+		clusters, err := clusterAccessRepo.GetClustersAccessibleByUserId(context.Background(), otherUserId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(HaveLen(2))
+		for _, access := range clusters {
+			if access.Cluster.Id == clusterId.String() {
+				Expect(access.Roles).To(ConsistOf(string(k8s.DefaultRole), string(k8s.OnCallRole)))
+			} else {
+				Expect(access.Roles).To(ConsistOf(string(k8s.DefaultRole), string(k8s.AdminRole)))
+			}
+		}
+	})
+	It("grants cluster roles for defined roles with a K8s cluster role", func() {
+		auditorRole := projections.NewRoleProjection(uuid.New())
+		auditorRole.Name = "auditor"
+		auditorRole.Scopes = []string{string(scopes.Tenant), string(scopes.Cluster)}
+		auditorRole.K8SClusterRole = "view"
+		plainRole := projections.NewRoleProjection(uuid.New())
+		plainRole.Name = "billing"
+		plainRole.Scopes = []string{string(scopes.Tenant)}
+
+		auditorRoleBinding := projections.NewUserRoleBinding(uuid.New())
+		auditorRoleBinding.UserId = otherUser.Id
+		auditorRoleBinding.Role = auditorRole.Name
+		auditorRoleBinding.Scope = string(scopes.Tenant)
+		auditorRoleBinding.Resource = tenantId.String()
+		billingRoleBinding := projections.NewUserRoleBinding(uuid.New())
+		billingRoleBinding.UserId = otherUser.Id
+		billingRoleBinding.Role = plainRole.Name
+		billingRoleBinding.Scope = string(scopes.Tenant)
+		billingRoleBinding.Resource = tenantId.String()
+
+		inMemoryRoleRepo := es_repos.NewInMemoryRepository[*projections.UserRoleBinding]()
+		Expect(inMemoryRoleRepo.Upsert(context.Background(), auditorRoleBinding)).NotTo(HaveOccurred())
+		Expect(inMemoryRoleRepo.Upsert(context.Background(), billingRoleBinding)).NotTo(HaveOccurred())
+
+		inMemoryDefinedRoleRepo := es_repos.NewInMemoryRepository[*projections.Role]()
+		Expect(inMemoryDefinedRoleRepo.Upsert(context.Background(), auditorRole)).NotTo(HaveOccurred())
+		Expect(inMemoryDefinedRoleRepo.Upsert(context.Background(), plainRole)).NotTo(HaveOccurred())
+
+		inMemoryClusterRepo := es_repos.NewInMemoryRepository[*projections.Cluster]()
+		Expect(inMemoryClusterRepo.Upsert(context.Background(), cluster)).NotTo(HaveOccurred())
+
+		inMemoryTenantRepo := es_repos.NewInMemoryRepository[*projections.Tenant]()
+		Expect(inMemoryTenantRepo.Upsert(context.Background(), tenant)).NotTo(HaveOccurred())
+
+		inMemoryTenantClusterBindingRepo := es_repos.NewInMemoryRepository[*projections.TenantClusterBinding]()
+		Expect(inMemoryTenantClusterBindingRepo.Upsert(context.Background(), binding)).NotTo(HaveOccurred())
+
+		clusterAccessRepo := NewClusterAccessRepository(
+			NewTenantClusterBindingRepository(inMemoryTenantClusterBindingRepo, NewClusterRepository(inMemoryClusterRepo)),
+			NewClusterRepository(inMemoryClusterRepo),
+			NewUserRoleBindingRepository(inMemoryRoleRepo),
+			NewTenantRepository(inMemoryTenantRepo),
+			NewRoleRepository(inMemoryDefinedRoleRepo),
+		)
+
+		clustersV2, err := clusterAccessRepo.GetClustersAccessibleByUserIdV2(context.Background(), otherUserId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clustersV2).To(HaveLen(1))
+		Expect(clustersV2[0].ClusterRoles).To(ConsistOf(
+			&projectionsApi.ClusterRole{Scope: projectionsApi.ClusterRole_CLUSTER, Role: string(k8s.DefaultRole)},
+			&projectionsApi.ClusterRole{Scope: projectionsApi.ClusterRole_TENANT, Role: auditorRole.Name},
+		))
+	})
 })
@@ -20,6 +20,7 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/errors"
 	"github.com/finleap-connect/monoskope/pkg/domain/projections"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 type clusterRepository struct {
@@ -31,6 +32,8 @@ type ClusterRepository interface {
 	DomainRepository[*projections.Cluster]
 	// ByName searches for the a tenant projection by it's name
 	ByClusterName(context.Context, string) (*projections.Cluster, error)
+	// AllWithLabelSelector returns all cluster projections matching the given label selector
+	AllWithLabelSelector(ctx context.Context, includeDeleted bool, labelSelector string) ([]*projections.Cluster, error)
 }
 
 // NewClusterRepository creates a repository for reading and writing cluster projections.
@@ -55,3 +58,34 @@ func (r *clusterRepository) ByClusterName(ctx context.Context, clusterName strin
 
 	return nil, errors.ErrClusterNotFound
 }
+
+// AllWithLabelSelector returns all cluster projections matching the given label selector, e.g. "env=dev".
+// An empty selector matches all clusters.
+func (r *clusterRepository) AllWithLabelSelector(ctx context.Context, includeDeleted bool, labelSelector string) ([]*projections.Cluster, error) {
+	selector, err := ParseLabelSelector(labelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := r.AllWith(ctx, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	var clusters []*projections.Cluster
+	for _, c := range ps {
+		if selector.Matches(labels.Set(c.Labels)) {
+			clusters = append(clusters, c)
+		}
+	}
+	return clusters, nil
+}
+
+// ParseLabelSelector parses a label selector following the K8s syntax, e.g. "env=dev,region in (eu,us)".
+func ParseLabelSelector(labelSelector string) (labels.Selector, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, errors.ErrInvalidArgument(err.Error())
+	}
+	return selector, nil
+}
@@ -77,4 +77,33 @@ var _ = Describe("domain/cluster_repo", func() {
 		Expect(cluster.Name).To(Equal(expectedClusterName))
 		Expect(cluster.GetLifecycleMetadata().Created).NotTo(BeNil())
 	})
+	It("can retrieve clusters by label selector", func() {
+		inMemClusterRepo := es_repos.NewInMemoryRepository[*projections.Cluster]()
+		clusterRepo := NewClusterRepository(inMemClusterRepo)
+
+		devCluster := projections.NewClusterProjection(uuid.New())
+		devCluster.Name = "dev-cluster"
+		devCluster.Labels = map[string]string{"env": "dev", "region": "eu"}
+		prodCluster := projections.NewClusterProjection(uuid.New())
+		prodCluster.Name = "prod-cluster"
+		prodCluster.Labels = map[string]string{"env": "prod", "region": "eu"}
+
+		Expect(inMemClusterRepo.Upsert(context.Background(), devCluster)).To(Succeed())
+		Expect(inMemClusterRepo.Upsert(context.Background(), prodCluster)).To(Succeed())
+
+		clusters, err := clusterRepo.AllWithLabelSelector(context.Background(), false, "env=dev")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(ConsistOf(devCluster))
+
+		clusters, err = clusterRepo.AllWithLabelSelector(context.Background(), false, "region in (eu,us),env!=dev")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(ConsistOf(prodCluster))
+
+		clusters, err = clusterRepo.AllWithLabelSelector(context.Background(), false, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(HaveLen(2))
+
+		_, err = clusterRepo.AllWithLabelSelector(context.Background(), false, "env in dev")
+		Expect(err).To(HaveOccurred())
+	})
 })
@@ -0,0 +1,99 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	"github.com/finleap-connect/monoskope/pkg/domain/projections"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/k8s"
+)
+
+type roleRepository struct {
+	DomainRepository[*projections.Role]
+}
+
+// RoleRepository is a repository for reading and writing role projections.
+type RoleRepository interface {
+	DomainRepository[*projections.Role]
+	// ByName searches for a role projection by its name
+	ByName(context.Context, string) (*projections.Role, error)
+	// IsRegistered returns if the given role is either built-in or defined and not deleted
+	IsRegistered(context.Context, string) (bool, error)
+	// ValidateK8sRole returns an error if the given role is neither a built-in K8s role nor a defined role granting a K8s cluster role
+	ValidateK8sRole(context.Context, string) error
+}
+
+// NewRoleRepository creates a repository for reading and writing role projections.
+func NewRoleRepository(repository es.Repository[*projections.Role]) RoleRepository {
+	return &roleRepository{
+		NewDomainRepository(repository),
+	}
+}
+
+// ByName searches for a role projection by its name.
+func (r *roleRepository) ByName(ctx context.Context, name string) (*projections.Role, error) {
+	ps, err := r.AllWith(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range ps {
+		if name == role.Name {
+			return role, nil
+		}
+	}
+
+	return nil, errors.ErrRoleNotFound
+}
+
+// IsRegistered returns if the given role is either built-in or defined and not deleted.
+func (r *roleRepository) IsRegistered(ctx context.Context, name string) (bool, error) {
+	if err := roles.ValidateRole(name); err == nil {
+		return true, nil
+	}
+
+	_, err := r.ByName(ctx, name)
+	if err == errors.ErrRoleNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ValidateK8sRole returns an error if the given role is neither a built-in K8s role nor a defined role granting a K8s cluster role.
+func (r *roleRepository) ValidateK8sRole(ctx context.Context, name string) error {
+	validationErr := k8s.ValidateRole(name)
+	if validationErr == nil {
+		return nil
+	}
+
+	role, err := r.ByName(ctx, name)
+	if err == errors.ErrRoleNotFound {
+		return validationErr
+	}
+	if err != nil {
+		return err
+	}
+	if role.K8SClusterRole == "" {
+		return validationErr
+	}
+	return nil
+}
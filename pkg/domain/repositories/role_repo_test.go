@@ -0,0 +1,58 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/finleap-connect/monoskope/pkg/domain/projections"
+	es_repos "github.com/finleap-connect/monoskope/pkg/eventsourcing/repositories"
+	"github.com/finleap-connect/monoskope/pkg/k8s"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("domain/role_repo", func() {
+	ctx := context.Background()
+
+	newRole := func(name, k8sClusterRole string) *projections.Role {
+		role := projections.NewRoleProjection(uuid.New())
+		role.Name = name
+		role.K8SClusterRole = k8sClusterRole
+		return role
+	}
+
+	var repo RoleRepository
+
+	BeforeEach(func() {
+		inMemoryRoleRepo := es_repos.NewInMemoryRepository[*projections.Role]()
+		Expect(inMemoryRoleRepo.Upsert(ctx, newRole("auditor", "view"))).To(Succeed())
+		Expect(inMemoryRoleRepo.Upsert(ctx, newRole("billing", ""))).To(Succeed())
+
+		repo = NewRoleRepository(inMemoryRoleRepo)
+	})
+
+	It("accepts built-in K8s roles", func() {
+		Expect(repo.ValidateK8sRole(ctx, string(k8s.AdminRole))).To(Succeed())
+	})
+	It("accepts defined roles granting a K8s cluster role", func() {
+		Expect(repo.ValidateK8sRole(ctx, "auditor")).To(Succeed())
+	})
+	It("rejects defined roles without K8s cluster role and unknown roles", func() {
+		Expect(repo.ValidateK8sRole(ctx, "billing")).ToNot(Succeed())
+		Expect(repo.ValidateK8sRole(ctx, "unknown")).ToNot(Succeed())
+	})
+})
@@ -20,11 +20,14 @@ import (
 	"github.com/finleap-connect/monoskope/pkg/domain/errors"
 	"github.com/finleap-connect/monoskope/pkg/domain/projections"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
 	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 type tenantClusterBindingRepository struct {
 	DomainRepository[*projections.TenantClusterBinding]
+	clusterRepo ClusterRepository
 }
 
 // TenantClusterBindingRepository is a repository for reading and writing tenantclusterbinding projections.
@@ -36,9 +39,11 @@ type TenantClusterBindingRepository interface {
 }
 
 // NewTenantClusterBindingRepository creates a repository for reading and writing tenantclusterbinding projections.
-func NewTenantClusterBindingRepository(repository es.Repository[*projections.TenantClusterBinding]) TenantClusterBindingRepository {
+// The cluster repository is used to resolve bindings by label selector.
+func NewTenantClusterBindingRepository(repository es.Repository[*projections.TenantClusterBinding], clusterRepo ClusterRepository) TenantClusterBindingRepository {
 	return &tenantClusterBindingRepository{
 		NewDomainRepository(repository),
+		clusterRepo,
 	}
 }
 
@@ -58,8 +63,13 @@ func (r *tenantClusterBindingRepository) GetByTenantId(ctx context.Context, tena
 	return bindings, nil
 }
 
-// GetByClusterId searches for the TenantClusterBinding projections by cluster id.
+// GetByClusterId searches for the TenantClusterBinding projections binding the cluster by id or by label selector.
 func (r *tenantClusterBindingRepository) GetByClusterId(ctx context.Context, clusterId uuid.UUID) ([]*projections.TenantClusterBinding, error) {
+	matches, err := r.clusterMatcher(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
 	ps, err := r.AllWith(ctx, false)
 	if err != nil {
 		return nil, err
@@ -67,24 +77,54 @@ func (r *tenantClusterBindingRepository) GetByClusterId(ctx context.Context, clu
 
 	var bindings []*projections.TenantClusterBinding
 	for _, p := range ps {
-		if p.ClusterId == clusterId.String() {
+		if matches(p) {
 			bindings = append(bindings, p)
 		}
 	}
 	return bindings, nil
 }
 
-// GetByTenantAndClusterId searches the TenantClusterBinding projection by tenant and cluster id.
+// GetByTenantAndClusterId searches the TenantClusterBinding projection binding the cluster by id or by label selector to the tenant.
 func (r *tenantClusterBindingRepository) GetByTenantAndClusterId(ctx context.Context, tenantId, clusterId uuid.UUID) (*projections.TenantClusterBinding, error) {
+	matches, err := r.clusterMatcher(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
 	ps, err := r.AllWith(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, p := range ps {
-		if p.TenantId == tenantId.String() && p.ClusterId == clusterId.String() {
+		if p.TenantId == tenantId.String() && matches(p) {
 			return p, nil
 		}
 	}
 	return nil, errors.ErrTenantClusterBindingNotFound
 }
+
+// clusterMatcher returns a function telling whether a binding binds the given cluster either by id or by label selector.
+// Bindings of clusters which don't exist anymore are matched by id only.
+func (r *tenantClusterBindingRepository) clusterMatcher(ctx context.Context, clusterId uuid.UUID) (func(*projections.TenantClusterBinding) bool, error) {
+	var clusterLabels labels.Set
+	cluster, err := r.clusterRepo.ById(ctx, clusterId)
+	switch {
+	case err == nil:
+		clusterLabels = labels.Set(cluster.Labels)
+	case err != esErrors.ErrProjectionNotFound:
+		return nil, err
+	}
+
+	return func(binding *projections.TenantClusterBinding) bool {
+		if binding.ClusterSelector == "" {
+			return binding.ClusterId == clusterId.String()
+		}
+		if clusterLabels == nil {
+			return false
+		}
+		// Selectors are validated when bindings are created
+		selector, err := labels.Parse(binding.ClusterSelector)
+		return err == nil && selector.Matches(clusterLabels)
+	}, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	projections "github.com/finleap-connect/monoskope/pkg/domain/projections"
+	es_repos "github.com/finleap-connect/monoskope/pkg/eventsourcing/repositories"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("domain/tenant_cluster_binding_repo", func() {
+	ctx := context.Background()
+
+	devCluster := projections.NewClusterProjection(uuid.New())
+	devCluster.Name = "dev-cluster"
+	devCluster.Labels = map[string]string{"env": "dev"}
+	prodCluster := projections.NewClusterProjection(uuid.New())
+	prodCluster.Name = "prod-cluster"
+	prodCluster.Labels = map[string]string{"env": "prod"}
+
+	newBinding := func(tenantId uuid.UUID, clusterId, clusterSelector string) *projections.TenantClusterBinding {
+		binding := projections.NewTenantClusterBindingProjection(uuid.New())
+		binding.TenantId = tenantId.String()
+		binding.ClusterId = clusterId
+		binding.ClusterSelector = clusterSelector
+		return binding
+	}
+
+	selectorTenantId, idTenantId := uuid.New(), uuid.New()
+	selectorBinding := newBinding(selectorTenantId, "", "env=dev")
+	idBinding := newBinding(idTenantId, devCluster.Id, "")
+	prodBinding := newBinding(idTenantId, prodCluster.Id, "")
+
+	var repo TenantClusterBindingRepository
+
+	BeforeEach(func() {
+		inMemoryClusterRepo := es_repos.NewInMemoryRepository[*projections.Cluster]()
+		Expect(inMemoryClusterRepo.Upsert(ctx, devCluster)).To(Succeed())
+		Expect(inMemoryClusterRepo.Upsert(ctx, prodCluster)).To(Succeed())
+
+		inMemoryTenantClusterBindingRepo := es_repos.NewInMemoryRepository[*projections.TenantClusterBinding]()
+		Expect(inMemoryTenantClusterBindingRepo.Upsert(ctx, selectorBinding)).To(Succeed())
+		Expect(inMemoryTenantClusterBindingRepo.Upsert(ctx, idBinding)).To(Succeed())
+		Expect(inMemoryTenantClusterBindingRepo.Upsert(ctx, prodBinding)).To(Succeed())
+
+		repo = NewTenantClusterBindingRepository(inMemoryTenantClusterBindingRepo, NewClusterRepository(inMemoryClusterRepo))
+	})
+
+	It("gets bindings of a cluster by id and by selector", func() {
+		bindings, err := repo.GetByClusterId(ctx, uuid.MustParse(devCluster.Id))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bindings).To(ConsistOf(selectorBinding, idBinding))
+
+		bindings, err = repo.GetByClusterId(ctx, uuid.MustParse(prodCluster.Id))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bindings).To(ConsistOf(prodBinding))
+
+		bindings, err = repo.GetByClusterId(ctx, uuid.New())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bindings).To(BeEmpty())
+	})
+	It("gets the binding of a tenant and cluster by selector", func() {
+		binding, err := repo.GetByTenantAndClusterId(ctx, selectorTenantId, uuid.MustParse(devCluster.Id))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(binding).To(Equal(selectorBinding))
+
+		_, err = repo.GetByTenantAndClusterId(ctx, selectorTenantId, uuid.MustParse(prodCluster.Id))
+		Expect(err).To(Equal(errors.ErrTenantClusterBindingNotFound))
+	})
+})
@@ -0,0 +1,162 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/users"
+	"github.com/finleap-connect/monoskope/pkg/domain/projections"
+	"github.com/google/uuid"
+)
+
+// Visibility describes which users, tenants and clusters are visible to a user.
+type Visibility struct {
+	all      bool
+	users    map[string]bool
+	tenants  map[string]bool
+	clusters map[string]bool
+}
+
+// CanSeeAll returns if everything is visible, which is the case for system admins.
+func (v *Visibility) CanSeeAll() bool {
+	return v.all
+}
+
+// CanSeeUser returns if the user with the given id is visible.
+func (v *Visibility) CanSeeUser(id string) bool {
+	return v.all || v.users[id]
+}
+
+// CanSeeTenant returns if the tenant with the given id is visible.
+func (v *Visibility) CanSeeTenant(id string) bool {
+	return v.all || v.tenants[id]
+}
+
+// CanSeeCluster returns if the cluster with the given id is visible.
+func (v *Visibility) CanSeeCluster(id string) bool {
+	return v.all || v.clusters[id]
+}
+
+// CanSeeRoleBinding returns if the given role binding is visible.
+func (v *Visibility) CanSeeRoleBinding(scope, resource string) bool {
+	switch scope {
+	case string(scopes.Tenant):
+		return v.CanSeeTenant(resource)
+	case string(scopes.Cluster):
+		return v.CanSeeCluster(resource)
+	default:
+		return true
+	}
+}
+
+type visibilityRepository struct {
+	userRoleBindingRepo UserRoleBindingRepository
+	tenantRepo          TenantRepository
+	clusterAccessRepo   ClusterAccessRepository
+}
+
+// VisibilityRepository is a repository for reading which projections are visible to a user.
+type VisibilityRepository interface {
+	// GetVisibilityForUserId returns what is visible to the user identified by user id
+	GetVisibilityForUserId(ctx context.Context, id uuid.UUID) (*Visibility, error)
+}
+
+// NewVisibilityRepository creates a repository for reading which projections are visible to a user.
+func NewVisibilityRepository(userRoleBindingRepo UserRoleBindingRepository, tenantRepo TenantRepository, clusterAccessRepo ClusterAccessRepository) VisibilityRepository {
+	return &visibilityRepository{
+		userRoleBindingRepo: userRoleBindingRepo,
+		tenantRepo:          tenantRepo,
+		clusterAccessRepo:   clusterAccessRepo,
+	}
+}
+
+// GetVisibilityForUserId returns what is visible to the user identified by user id.
+// System admins see everything, other users see themselves, the tenants they are bound to including descendants,
+// the members of these tenants and the clusters they have access to.
+func (r *visibilityRepository) GetVisibilityForUserId(ctx context.Context, id uuid.UUID) (*Visibility, error) {
+	v := &Visibility{
+		users:    map[string]bool{id.String(): true},
+		tenants:  make(map[string]bool),
+		clusters: make(map[string]bool),
+	}
+
+	var roleBindings []*projections.UserRoleBinding
+	if systemUser, ok := users.AvailableSystemUsers[id]; ok {
+		for _, roleBinding := range systemUser.Roles {
+			roleBindings = append(roleBindings, &projections.UserRoleBinding{UserRoleBinding: roleBinding})
+		}
+	} else {
+		var err error
+		roleBindings, err = r.userRoleBindingRepo.ByUserId(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, roleBinding := range roleBindings {
+		if roleBinding.Scope == string(scopes.System) && roleBinding.Role == string(roles.Admin) {
+			v.all = true
+			return v, nil
+		}
+	}
+
+	// tenant memberships are inherited by child tenants
+	tenants, err := r.tenantRepo.AllWith(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	tenantsById := make(map[string]*projections.Tenant)
+	childrenById := make(map[string][]*projections.Tenant)
+	for _, tenant := range tenants {
+		tenantsById[tenant.Id] = tenant
+		childrenById[tenant.ParentId] = append(childrenById[tenant.ParentId], tenant)
+	}
+	for _, roleBinding := range roleBindings {
+		if roleBinding.Scope != string(scopes.Tenant) {
+			continue
+		}
+		tenant, ok := tenantsById[roleBinding.Resource]
+		if !ok {
+			continue
+		}
+		for _, visibleTenant := range getTenantWithDescendants(tenant, childrenById) {
+			v.tenants[visibleTenant.Id] = true
+		}
+	}
+
+	// members of visible tenants are visible too
+	allRoleBindings, err := r.userRoleBindingRepo.AllWith(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, roleBinding := range allRoleBindings {
+		if roleBinding.Scope == string(scopes.Tenant) && v.tenants[roleBinding.Resource] {
+			v.users[roleBinding.UserId] = true
+		}
+	}
+
+	clusterAccesses, err := r.clusterAccessRepo.GetClustersAccessibleByUserIdV2(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, clusterAccess := range clusterAccesses {
+		v.clusters[clusterAccess.Cluster.Id] = true
+	}
+
+	return v, nil
+}
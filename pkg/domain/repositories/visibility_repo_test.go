@@ -0,0 +1,143 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/users"
+	"github.com/finleap-connect/monoskope/pkg/domain/projections"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	es_repos "github.com/finleap-connect/monoskope/pkg/eventsourcing/repositories"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("pkg/domain/repositories/visibilityRepository", func() {
+	newRoleBinding := func(userId uuid.UUID, role es.Role, scope es.Scope, resource string) *projections.UserRoleBinding {
+		roleBinding := projections.NewUserRoleBinding(uuid.New())
+		roleBinding.UserId = userId.String()
+		roleBinding.Role = string(role)
+		roleBinding.Scope = string(scope)
+		roleBinding.Resource = resource
+		return roleBinding
+	}
+
+	adminId, memberId, coMemberId, otherId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tenant := projections.NewTenantProjection(uuid.New())
+	tenant.Name = "tenant"
+	childTenant := projections.NewTenantProjection(uuid.New())
+	childTenant.Name = "child"
+	childTenant.ParentId = tenant.Id
+	otherTenant := projections.NewTenantProjection(uuid.New())
+	otherTenant.Name = "other"
+
+	cluster := projections.NewClusterProjection(uuid.New())
+	cluster.Name = "cluster"
+	otherCluster := projections.NewClusterProjection(uuid.New())
+	otherCluster.Name = "other-cluster"
+
+	binding := projections.NewTenantClusterBindingProjection(uuid.New())
+	binding.TenantId = tenant.Id
+	binding.ClusterId = cluster.Id
+	otherBinding := projections.NewTenantClusterBindingProjection(uuid.New())
+	otherBinding.TenantId = otherTenant.Id
+	otherBinding.ClusterId = otherCluster.Id
+
+	var visibilityRepo VisibilityRepository
+
+	BeforeEach(func() {
+		ctx := context.Background()
+
+		inMemoryRoleBindingRepo := es_repos.NewInMemoryRepository[*projections.UserRoleBinding]()
+		for _, roleBinding := range []*projections.UserRoleBinding{
+			newRoleBinding(adminId, roles.Admin, scopes.System, ""),
+			newRoleBinding(memberId, roles.User, scopes.Tenant, tenant.Id),
+			newRoleBinding(coMemberId, roles.Admin, scopes.Tenant, childTenant.Id),
+			newRoleBinding(otherId, roles.User, scopes.Tenant, otherTenant.Id),
+		} {
+			Expect(inMemoryRoleBindingRepo.Upsert(ctx, roleBinding)).To(Succeed())
+		}
+
+		inMemoryTenantRepo := es_repos.NewInMemoryRepository[*projections.Tenant]()
+		for _, t := range []*projections.Tenant{tenant, childTenant, otherTenant} {
+			Expect(inMemoryTenantRepo.Upsert(ctx, t)).To(Succeed())
+		}
+
+		inMemoryClusterRepo := es_repos.NewInMemoryRepository[*projections.Cluster]()
+		Expect(inMemoryClusterRepo.Upsert(ctx, cluster)).To(Succeed())
+		Expect(inMemoryClusterRepo.Upsert(ctx, otherCluster)).To(Succeed())
+
+		inMemoryTenantClusterBindingRepo := es_repos.NewInMemoryRepository[*projections.TenantClusterBinding]()
+		Expect(inMemoryTenantClusterBindingRepo.Upsert(ctx, binding)).To(Succeed())
+		Expect(inMemoryTenantClusterBindingRepo.Upsert(ctx, otherBinding)).To(Succeed())
+
+		userRoleBindingRepo := NewUserRoleBindingRepository(inMemoryRoleBindingRepo)
+		tenantRepo := NewTenantRepository(inMemoryTenantRepo)
+		clusterAccessRepo := NewClusterAccessRepository(
+			NewTenantClusterBindingRepository(inMemoryTenantClusterBindingRepo, NewClusterRepository(inMemoryClusterRepo)),
+			NewClusterRepository(inMemoryClusterRepo),
+			userRoleBindingRepo,
+			tenantRepo,
+			NewRoleRepository(es_repos.NewInMemoryRepository[*projections.Role]()),
+		)
+		visibilityRepo = NewVisibilityRepository(userRoleBindingRepo, tenantRepo, clusterAccessRepo)
+	})
+
+	It("lets system admins and system users see everything", func() {
+		for _, id := range []uuid.UUID{adminId, users.SCIMServerUser.ID()} {
+			visibility, err := visibilityRepo.GetVisibilityForUserId(context.Background(), id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(visibility.CanSeeAll()).To(BeTrue())
+			Expect(visibility.CanSeeTenant(otherTenant.Id)).To(BeTrue())
+			Expect(visibility.CanSeeCluster(otherCluster.Id)).To(BeTrue())
+			Expect(visibility.CanSeeUser(otherId.String())).To(BeTrue())
+		}
+	})
+
+	It("lets tenant members see their tenants, clusters and co-members", func() {
+		visibility, err := visibilityRepo.GetVisibilityForUserId(context.Background(), memberId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(visibility.CanSeeAll()).To(BeFalse())
+
+		Expect(visibility.CanSeeTenant(tenant.Id)).To(BeTrue())
+		Expect(visibility.CanSeeTenant(childTenant.Id)).To(BeTrue())
+		Expect(visibility.CanSeeTenant(otherTenant.Id)).To(BeFalse())
+
+		Expect(visibility.CanSeeCluster(cluster.Id)).To(BeTrue())
+		Expect(visibility.CanSeeCluster(otherCluster.Id)).To(BeFalse())
+
+		Expect(visibility.CanSeeUser(memberId.String())).To(BeTrue())
+		Expect(visibility.CanSeeUser(coMemberId.String())).To(BeTrue())
+		Expect(visibility.CanSeeUser(otherId.String())).To(BeFalse())
+		Expect(visibility.CanSeeUser(adminId.String())).To(BeFalse())
+
+		Expect(visibility.CanSeeRoleBinding(string(scopes.System), "")).To(BeTrue())
+		Expect(visibility.CanSeeRoleBinding(string(scopes.Tenant), otherTenant.Id)).To(BeFalse())
+	})
+
+	It("does not inherit memberships of child tenants to parents", func() {
+		visibility, err := visibilityRepo.GetVisibilityForUserId(context.Background(), coMemberId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(visibility.CanSeeTenant(childTenant.Id)).To(BeTrue())
+		Expect(visibility.CanSeeTenant(tenant.Id)).To(BeFalse())
+		Expect(visibility.CanSeeCluster(cluster.Id)).To(BeFalse())
+		Expect(visibility.CanSeeUser(memberId.String())).To(BeFalse())
+	})
+})
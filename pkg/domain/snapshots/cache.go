@@ -0,0 +1,204 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+
+	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type cacheContextKey struct{}
+
+// Cache keeps the history and the latest snapshots of aggregates in memory so that point-in-time snapshots of the
+// same aggregates can be created repeatedly without replaying their entire history from the EventStore. Snapshots are
+// projected incrementally from the previous one. They are shared and must not be modified. A Cache is meant to live
+// as long as a single stream of events is processed in chronological order, e.g. while formatting an audit log.
+type Cache struct {
+	mutex         sync.Mutex
+	entries       map[uuid.UUID]*cacheEntry
+	observedUntil time.Time
+}
+
+// cacheEntry is the known history of a single aggregate
+type cacheEntry struct {
+	events      []es.Event
+	loadedUntil time.Time
+	snapshots   map[reflect.Type]*cachedSnapshot
+}
+
+// cachedSnapshot is the latest snapshot of an aggregate created by a certain type of projector
+type cachedSnapshot struct {
+	projection es.Projection
+	applied    int
+}
+
+// NewCache creates an empty Cache
+func NewCache() *Cache {
+	return &Cache{
+		entries: make(map[uuid.UUID]*cacheEntry),
+	}
+}
+
+// WithCache returns a context which makes all Snapshotters use the given cache
+func WithCache(ctx context.Context, cache *Cache) context.Context {
+	return context.WithValue(ctx, cacheContextKey{}, cache)
+}
+
+// cacheFromContext returns the cache of the context if any
+func cacheFromContext(ctx context.Context) *Cache {
+	cache, _ := ctx.Value(cacheContextKey{}).(*Cache)
+	return cache
+}
+
+// Observe incrementally updates the cache with an event of the stream being processed. It must be called before the
+// event is processed and only for streams which contain all events of the EventStore in chronological order, e.g. all
+// events of a date range. Cached aggregates are then kept up-to-date without any further requests to the EventStore.
+func (c *Cache) Observe(protoEvent *esApi.Event) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if timestamp := protoEvent.GetTimestamp().AsTime(); timestamp.After(c.observedUntil) {
+		c.observedUntil = timestamp
+	}
+
+	id, err := uuid.Parse(protoEvent.GetAggregateId())
+	if err != nil {
+		return
+	}
+	entry, ok := c.entries[id]
+	if !ok {
+		return
+	}
+	event, err := es.UpcastEventFromProto(protoEvent)
+	if err != nil {
+		return
+	}
+	entry.append(event)
+}
+
+// snapshot returns the snapshot of the aggregate up to and including the given timestamp. Only the events which are
+// not known by the cache yet are retrieved from the EventStore and only the events which are not part of the previous
+// snapshot of the same type are projected.
+func (c *Cache) snapshot(ctx context.Context, esClient esApi.EventStoreClient, id uuid.UUID, maxTimestamp time.Time, projectorType reflect.Type, newProjection func() es.Projection, project func(es.Event, es.Projection) (es.Projection, error)) (es.Projection, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[id]
+	if !ok {
+		// load everything observed already, later events are added by Observe
+		loadUntil := maxTimestamp
+		if c.observedUntil.After(loadUntil) {
+			loadUntil = c.observedUntil
+		}
+		entry = &cacheEntry{snapshots: make(map[reflect.Type]*cachedSnapshot)}
+		if err := entry.load(ctx, esClient, id, nil, loadUntil); err != nil {
+			return nil, err
+		}
+		c.entries[id] = entry
+	} else if completeUntil := c.completeUntil(entry); maxTimestamp.After(completeUntil) {
+		if err := entry.load(ctx, esClient, id, &completeUntil, maxTimestamp); err != nil {
+			return nil, err
+		}
+	}
+
+	aggregateEvents := entry.eventsUntil(maxTimestamp)
+	cached, ok := entry.snapshots[projectorType]
+	if !ok || cached.applied > len(aggregateEvents) {
+		// going back in time requires starting from scratch
+		cached = &cachedSnapshot{projection: newProjection()}
+	}
+	for _, event := range aggregateEvents[cached.applied:] {
+		projection, err := project(event, cached.projection)
+		if err != nil {
+			return nil, err
+		}
+		cached.projection = projection
+		cached.applied++
+	}
+	entry.snapshots[projectorType] = cached
+
+	return cached.projection, nil
+}
+
+// completeUntil returns the timestamp up to which the cache knows all events of the given entry
+func (c *Cache) completeUntil(entry *cacheEntry) time.Time {
+	if c.observedUntil.After(entry.loadedUntil) {
+		return c.observedUntil
+	}
+	return entry.loadedUntil
+}
+
+// load retrieves the events of the aggregate within the given time range and adds them to the entry
+func (e *cacheEntry) load(ctx context.Context, esClient esApi.EventStoreClient, id uuid.UUID, minTimestamp *time.Time, maxTimestamp time.Time) error {
+	eventFilter := &esApi.EventFilter{
+		AggregateId:  wrapperspb.String(id.String()),
+		MaxTimestamp: timestamppb.New(maxTimestamp),
+	}
+	if minTimestamp != nil {
+		eventFilter.MinTimestamp = timestamppb.New(*minTimestamp)
+	}
+
+	aggregateEvents, err := esClient.Retrieve(ctx, eventFilter)
+	if err != nil {
+		return err
+	}
+	for {
+		protoEvent, err := aggregateEvents.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		event, err := es.UpcastEventFromProto(protoEvent)
+		if err != nil {
+			return err
+		}
+		e.append(event)
+	}
+
+	if maxTimestamp.After(e.loadedUntil) {
+		e.loadedUntil = maxTimestamp
+	}
+	return nil
+}
+
+// append adds the event if it is newer than the known events of the aggregate
+func (e *cacheEntry) append(event es.Event) {
+	if len(e.events) > 0 && event.AggregateVersion() <= e.events[len(e.events)-1].AggregateVersion() {
+		return
+	}
+	e.events = append(e.events, event)
+}
+
+// eventsUntil returns the known events up to and including the given timestamp
+func (e *cacheEntry) eventsUntil(maxTimestamp time.Time) []es.Event {
+	for i, event := range e.events {
+		if event.Timestamp().After(maxTimestamp) {
+			return e.events[:i]
+		}
+	}
+	return e.events
+}
@@ -0,0 +1,229 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/common"
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	meta "github.com/finleap-connect/monoskope/pkg/domain/metadata"
+	"github.com/finleap-connect/monoskope/pkg/domain/mock"
+	"github.com/finleap-connect/monoskope/pkg/domain/projectors"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// memoryEventStoreClient serves Retrieve from an in-memory, chronologically ordered list of events
+type memoryEventStoreClient struct {
+	esApi.EventStoreClient
+	events         []*esApi.Event
+	retrieveCalls  int
+	eventsReturned int
+}
+
+// memoryRetrieveClient streams the events retrieved from memoryEventStoreClient
+type memoryRetrieveClient struct {
+	esApi.EventStore_RetrieveClient
+	events []*esApi.Event
+}
+
+func (c *memoryRetrieveClient) Recv() (*esApi.Event, error) {
+	if len(c.events) == 0 {
+		return nil, io.EOF
+	}
+	e := c.events[0]
+	c.events = c.events[1:]
+	return e, nil
+}
+
+func (c *memoryEventStoreClient) Retrieve(ctx context.Context, filter *esApi.EventFilter, opts ...grpc.CallOption) (esApi.EventStore_RetrieveClient, error) {
+	c.retrieveCalls++
+	var result []*esApi.Event
+	for _, e := range c.events {
+		if filter.AggregateId != nil && e.AggregateId != filter.AggregateId.Value {
+			continue
+		}
+		if filter.MinTimestamp != nil && e.Timestamp.AsTime().Before(filter.MinTimestamp.AsTime()) {
+			continue
+		}
+		if filter.MaxTimestamp != nil && e.Timestamp.AsTime().After(filter.MaxTimestamp.AsTime()) {
+			continue
+		}
+		result = append(result, e)
+	}
+	c.eventsReturned += len(result)
+	return &memoryRetrieveClient{events: result}, nil
+}
+
+// newUserHistory creates the events of a user which has been renamed the given number of times
+func newUserHistory(userId uuid.UUID, renames int, start time.Time) []*esApi.Event {
+	metaMgr, err := meta.NewDomainMetadataManager(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	metaMgr.SetUserInformation(&meta.UserInformation{Id: mock.TestAdminUser.ID()})
+	ctx := metaMgr.GetContext()
+
+	history := []*esApi.Event{es.NewProtoFromEvent(es.NewEvent(ctx, events.UserCreated, es.ToEventDataFromProto(&eventdata.UserCreated{
+		Email:  "jane.doe@monoskope.io",
+		Name:   "name 0",
+		Source: common.UserSource_INTERNAL,
+	}), start, aggregates.User, userId, 1))}
+	for i := 1; i <= renames; i++ {
+		history = append(history, es.NewProtoFromEvent(es.NewEvent(ctx, events.UserUpdated, es.ToEventDataFromProto(&eventdata.UserUpdated{
+			Name: fmt.Sprintf("name %d", i),
+		}), start.Add(time.Duration(i)*time.Second), aggregates.User, userId, uint64(i+1))))
+	}
+	return history
+}
+
+// snapshotBeforeEach creates a snapshot of the aggregate right before each event like the audit formatters do
+func snapshotBeforeEach(ctx context.Context, esClient *memoryEventStoreClient, cache *Cache) ([]string, error) {
+	snapshotter := NewSnapshotter(esClient, projectors.NewUserProjector())
+	var names []string
+	for _, e := range esClient.events[1:] {
+		if cache != nil {
+			cache.Observe(e)
+		}
+		user, err := snapshotter.CreateSnapshot(ctx, &esApi.EventFilter{
+			MaxTimestamp: timestamppb.New(e.Timestamp.AsTime().Add(-time.Microsecond)),
+			AggregateId:  wrapperspb.String(e.AggregateId),
+		})
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, user.Name)
+	}
+	return names, nil
+}
+
+var _ = Describe("pkg/domain/snapshots/cache", func() {
+	ctx := context.Background()
+	userId := uuid.New()
+	start := time.Now().UTC().Truncate(time.Microsecond)
+
+	It("creates the same snapshots as without cache", func() {
+		esClient := &memoryEventStoreClient{events: newUserHistory(userId, 10, start)}
+		expected, err := snapshotBeforeEach(ctx, esClient, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expected[0]).To(Equal("name 0"))
+		Expect(expected[9]).To(Equal("name 9"))
+
+		cache := NewCache()
+		actual, err := snapshotBeforeEach(WithCache(ctx, cache), esClient, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(expected))
+
+		observingCache := NewCache()
+		actual, err = snapshotBeforeEach(WithCache(ctx, observingCache), esClient, observingCache)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(expected))
+	})
+
+	It("retrieves the history only once when observing the stream", func() {
+		esClient := &memoryEventStoreClient{events: newUserHistory(userId, 10, start)}
+		cache := NewCache()
+		_, err := snapshotBeforeEach(WithCache(ctx, cache), esClient, cache)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(esClient.retrieveCalls).To(Equal(1))
+	})
+
+	It("retrieves only new events without observing the stream", func() {
+		esClient := &memoryEventStoreClient{events: newUserHistory(userId, 10, start)}
+		_, err := snapshotBeforeEach(WithCache(ctx, NewCache()), esClient, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(esClient.retrieveCalls).To(Equal(10))
+		Expect(esClient.eventsReturned).To(BeNumerically("<", 2*11))
+	})
+
+	It("creates snapshots of earlier points in time from the cache", func() {
+		esClient := &memoryEventStoreClient{events: newUserHistory(userId, 10, start)}
+		ctx := WithCache(ctx, NewCache())
+		snapshotter := NewSnapshotter(esClient, projectors.NewUserProjector())
+
+		user, err := snapshotter.CreateSnapshot(ctx, &esApi.EventFilter{MaxTimestamp: timestamppb.New(start.Add(10 * time.Second)), AggregateId: wrapperspb.String(userId.String())})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(user.Name).To(Equal("name 10"))
+
+		user, err = snapshotter.CreateSnapshot(ctx, &esApi.EventFilter{MaxTimestamp: timestamppb.New(start.Add(5 * time.Second)), AggregateId: wrapperspb.String(userId.String())})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(user.Name).To(Equal("name 5"))
+		Expect(esClient.retrieveCalls).To(Equal(1))
+	})
+
+	It("bypasses the cache for other filters", func() {
+		esClient := &memoryEventStoreClient{events: newUserHistory(userId, 1, start)}
+		ctx := WithCache(ctx, NewCache())
+		snapshotter := NewSnapshotter(esClient, projectors.NewUserProjector())
+
+		filter := &esApi.EventFilter{AggregateId: wrapperspb.String(userId.String()), MinVersion: wrapperspb.UInt64(1)}
+		for i := 0; i < 2; i++ {
+			_, err := snapshotter.CreateSnapshot(ctx, filter)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(esClient.retrieveCalls).To(Equal(2))
+	})
+})
+
+func benchmarkSnapshotBeforeEach(b *testing.B, renames int, useCache, observe bool) {
+	ctx := context.Background()
+	esClient := &memoryEventStoreClient{events: newUserHistory(uuid.New(), renames, time.Now().UTC())}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var cache *Cache
+		snapshotCtx := ctx
+		if useCache {
+			cache = NewCache()
+			snapshotCtx = WithCache(ctx, cache)
+		}
+		var observingCache *Cache
+		if observe {
+			observingCache = cache
+		}
+		if _, err := snapshotBeforeEach(snapshotCtx, esClient, observingCache); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(esClient.retrieveCalls)/float64(b.N), "retrieves/op")
+	b.ReportMetric(float64(esClient.eventsReturned)/float64(b.N), "events/op")
+}
+
+func BenchmarkSnapshots(b *testing.B) {
+	for _, renames := range []int{100, 1000} {
+		b.Run(fmt.Sprintf("history=%d/uncached", renames), func(b *testing.B) {
+			benchmarkSnapshotBeforeEach(b, renames, false, false)
+		})
+		b.Run(fmt.Sprintf("history=%d/cached", renames), func(b *testing.B) {
+			benchmarkSnapshotBeforeEach(b, renames, true, false)
+		})
+		b.Run(fmt.Sprintf("history=%d/cached-observed", renames), func(b *testing.B) {
+			benchmarkSnapshotBeforeEach(b, renames, true, true)
+		})
+	}
+}
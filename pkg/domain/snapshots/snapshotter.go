@@ -18,10 +18,12 @@ import (
 	"context"
 	"errors"
 	"io"
+	"reflect"
 
 	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 )
 
 type Snapshotter[T es.Projection] struct {
@@ -35,9 +37,14 @@ func NewSnapshotter[T es.Projection](esClient esApi.EventStoreClient, projector
 
 // CreateSnapshot creates a snapshot based on an event-filter and the corresponding projector for
 // the aggregate of which the id is to be specified in the filter.
+// If the context carries a Cache, the aggregate's history is taken from it as far as possible.
 func (s *Snapshotter[T]) CreateSnapshot(ctx context.Context, eventFilter *esApi.EventFilter) (T, error) {
 	var nilResult T
 
+	if cache := cacheFromContext(ctx); cache != nil && isCacheable(eventFilter) {
+		return s.createSnapshotFromCache(ctx, cache, eventFilter)
+	}
+
 	id, err := uuid.Parse(eventFilter.AggregateId.Value)
 	if err != nil {
 		id = uuid.New()
@@ -57,7 +64,7 @@ func (s *Snapshotter[T]) CreateSnapshot(ctx context.Context, eventFilter *esApi.
 			return nilResult, err
 		}
 
-		event, err := es.NewEventFromProto(e)
+		event, err := es.UpcastEventFromProto(e)
 		if err != nil {
 			return nilResult, err
 		}
@@ -73,3 +80,40 @@ func (s *Snapshotter[T]) CreateSnapshot(ctx context.Context, eventFilter *esApi.
 	}
 	return projection, nil
 }
+
+// createSnapshotFromCache creates the snapshot incrementally from the events and snapshots known by the cache
+func (s *Snapshotter[T]) createSnapshotFromCache(ctx context.Context, cache *Cache, eventFilter *esApi.EventFilter) (T, error) {
+	var nilResult T
+
+	id := uuid.MustParse(eventFilter.AggregateId.Value)
+	projection, err := cache.snapshot(ctx, s.esClient, id, eventFilter.MaxTimestamp.AsTime(), reflect.TypeOf(s.projector),
+		func() es.Projection {
+			return s.projector.NewProjection(id)
+		},
+		func(event es.Event, projection es.Projection) (es.Projection, error) {
+			return s.projector.Project(ctx, event, projection.(T))
+		},
+	)
+	if err != nil {
+		return nilResult, err
+	}
+
+	if projection.Version() == 0 {
+		return nilResult, errors.New("no events found to create a snapshot for aggregate ID: " + eventFilter.AggregateId.Value)
+	}
+	return projection.(T), nil
+}
+
+// isCacheable returns true for filters selecting a single aggregate up to a point in time
+func isCacheable(eventFilter *esApi.EventFilter) bool {
+	if eventFilter.GetAggregateId() == nil || eventFilter.GetMaxTimestamp() == nil {
+		return false
+	}
+	if _, err := uuid.Parse(eventFilter.GetAggregateId().GetValue()); err != nil {
+		return false
+	}
+	return proto.Equal(eventFilter, &esApi.EventFilter{
+		AggregateId:  eventFilter.GetAggregateId(),
+		MaxTimestamp: eventFilter.GetMaxTimestamp(),
+	})
+}
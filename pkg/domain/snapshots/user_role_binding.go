@@ -58,7 +58,7 @@ func (s *UserRoleBindingSnapshotter) CreateAllSnapshots(ctx context.Context, use
 			continue
 		}
 
-		e, err := es.NewEventFromProto(eventPorto)
+		e, err := es.UpcastEventFromProto(eventPorto)
 		if err != nil {
 			continue
 		}
@@ -0,0 +1,36 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"sync"
+
+	"github.com/finleap-connect/monoskope/pkg/domain/upcasters"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+)
+
+var (
+	registerUpcastersOnce sync.Once
+	registerUpcastersErr  error
+)
+
+// registerUpcasters registers the upcasters of all domain events with the DefaultUpcasterRegistry.
+// It is safe to set up several parts of the domain within the same process.
+func registerUpcasters() error {
+	registerUpcastersOnce.Do(func() {
+		registerUpcastersErr = upcasters.RegisterClusterUpcasters(es.DefaultUpcasterRegistry)
+	})
+	return registerUpcastersErr
+}
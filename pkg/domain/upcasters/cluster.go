@@ -0,0 +1,91 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upcasters
+
+import (
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// RegisterClusterUpcasters registers the upcasters transforming the cluster events to
+// ClusterCreatedV4 and ClusterUpdatedV3.
+func RegisterClusterUpcasters(registry es.UpcasterRegistry) error {
+	upcasters := []struct {
+		eventType es.EventType
+		upcaster  es.Upcaster
+	}{
+		{events.ClusterCreated, es.NewProtoUpcaster(events.ClusterCreatedV2, upcastClusterCreated)},
+		{events.ClusterCreatedV2, es.NewProtoUpcaster(events.ClusterCreatedV3, upcastClusterCreatedV2)},
+		{events.ClusterCreatedV3, es.NewProtoUpcaster(events.ClusterCreatedV4, upcastClusterCreatedV3)},
+		{events.ClusterUpdated, es.NewProtoUpcaster(events.ClusterUpdatedV2, upcastClusterUpdated)},
+		{events.ClusterUpdatedV2, es.NewProtoUpcaster(events.ClusterUpdatedV3, upcastClusterUpdatedV2)},
+	}
+	for _, u := range upcasters {
+		if err := registry.RegisterUpcaster(u.eventType, u.upcaster); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upcastClusterCreated uses the label as name since it has been the unique name of the cluster.
+func upcastClusterCreated(data *eventdata.ClusterCreated) *eventdata.ClusterCreatedV2 {
+	return &eventdata.ClusterCreatedV2{
+		Name:                data.GetLabel(),
+		DisplayName:         data.GetName(),
+		ApiServerAddress:    data.GetApiServerAddress(),
+		CaCertificateBundle: data.GetCaCertificateBundle(),
+	}
+}
+
+// upcastClusterCreatedV2 drops the display name which is not in use anymore.
+func upcastClusterCreatedV2(data *eventdata.ClusterCreatedV2) *eventdata.ClusterCreatedV3 {
+	return &eventdata.ClusterCreatedV3{
+		Name:                data.GetName(),
+		ApiServerAddress:    data.GetApiServerAddress(),
+		CaCertificateBundle: data.GetCaCertificateBundle(),
+	}
+}
+
+// upcastClusterCreatedV3 creates clusters without description, labels and annotations.
+func upcastClusterCreatedV3(data *eventdata.ClusterCreatedV3) *eventdata.ClusterCreatedV4 {
+	return &eventdata.ClusterCreatedV4{
+		Name:                data.GetName(),
+		ApiServerAddress:    data.GetApiServerAddress(),
+		CaCertificateBundle: data.GetCaCertificateBundle(),
+	}
+}
+
+// upcastClusterUpdated drops the display name and treats empty values as unchanged.
+func upcastClusterUpdated(data *eventdata.ClusterUpdated) *eventdata.ClusterUpdatedV2 {
+	upcasted := &eventdata.ClusterUpdatedV2{
+		CaCertificateBundle: data.GetCaCertificateBundle(),
+	}
+	if len(data.GetApiServerAddress()) > 0 {
+		upcasted.ApiServerAddress = wrapperspb.String(data.GetApiServerAddress())
+	}
+	return upcasted
+}
+
+// upcastClusterUpdatedV2 updates neither description, labels nor annotations.
+func upcastClusterUpdatedV2(data *eventdata.ClusterUpdatedV2) *eventdata.ClusterUpdatedV3 {
+	return &eventdata.ClusterUpdatedV3{
+		Name:                data.GetName(),
+		ApiServerAddress:    data.GetApiServerAddress(),
+		CaCertificateBundle: data.GetCaCertificateBundle(),
+	}
+}
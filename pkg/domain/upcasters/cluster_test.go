@@ -0,0 +1,119 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upcasters
+
+import (
+	"context"
+	"time"
+
+	"github.com/finleap-connect/monoskope/pkg/api/domain/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/events"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("pkg/domain/upcasters/cluster", func() {
+	caCertBundle := []byte("ca-cert")
+
+	var registry es.UpcasterRegistry
+
+	BeforeEach(func() {
+		registry = es.NewUpcasterRegistry()
+		Expect(RegisterClusterUpcasters(registry)).To(Succeed())
+	})
+
+	expectUpcast := func(eventType es.EventType, data proto.Message, expectedType es.EventType, expected proto.Message) {
+		event := es.NewEvent(context.Background(), eventType, es.ToEventDataFromProto(data), time.Now().UTC(), aggregates.Cluster, uuid.New(), 1)
+
+		upcasted, err := registry.Upcast(event)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upcasted.EventType()).To(Equal(expectedType))
+		Expect(upcasted.AggregateID()).To(Equal(event.AggregateID()))
+		Expect(upcasted.Timestamp()).To(Equal(event.Timestamp()))
+
+		actual, err := upcasted.Data().Unmarshal()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(proto.Equal(actual, expected)).To(BeTrue(), "expected %v to equal %v", actual, expected)
+	}
+
+	It("upcasts ClusterCreated using the label as name", func() {
+		expectUpcast(events.ClusterCreated, &eventdata.ClusterCreated{
+			Name:                "The Cluster",
+			Label:               "the-cluster",
+			ApiServerAddress:    "https://the-cluster",
+			CaCertificateBundle: caCertBundle,
+		}, events.ClusterCreatedV4, &eventdata.ClusterCreatedV4{
+			Name:                "the-cluster",
+			ApiServerAddress:    "https://the-cluster",
+			CaCertificateBundle: caCertBundle,
+		})
+	})
+	It("upcasts ClusterCreatedV2 dropping the display name", func() {
+		expectUpcast(events.ClusterCreatedV2, &eventdata.ClusterCreatedV2{
+			Name:                "the-cluster",
+			DisplayName:         "The Cluster",
+			ApiServerAddress:    "https://the-cluster",
+			CaCertificateBundle: caCertBundle,
+		}, events.ClusterCreatedV4, &eventdata.ClusterCreatedV4{
+			Name:                "the-cluster",
+			ApiServerAddress:    "https://the-cluster",
+			CaCertificateBundle: caCertBundle,
+		})
+	})
+	It("upcasts ClusterCreatedV3", func() {
+		expectUpcast(events.ClusterCreatedV3, &eventdata.ClusterCreatedV3{
+			Name:             "the-cluster",
+			ApiServerAddress: "https://the-cluster",
+		}, events.ClusterCreatedV4, &eventdata.ClusterCreatedV4{
+			Name:             "the-cluster",
+			ApiServerAddress: "https://the-cluster",
+		})
+	})
+	It("upcasts ClusterUpdated treating empty values as unchanged", func() {
+		expectUpcast(events.ClusterUpdated, &eventdata.ClusterUpdated{
+			DisplayName:         "The Cluster",
+			CaCertificateBundle: caCertBundle,
+		}, events.ClusterUpdatedV3, &eventdata.ClusterUpdatedV3{
+			CaCertificateBundle: caCertBundle,
+		})
+		expectUpcast(events.ClusterUpdated, &eventdata.ClusterUpdated{
+			ApiServerAddress: "https://the-new-cluster",
+		}, events.ClusterUpdatedV3, &eventdata.ClusterUpdatedV3{
+			ApiServerAddress: wrapperspb.String("https://the-new-cluster"),
+		})
+	})
+	It("upcasts ClusterUpdatedV2", func() {
+		expectUpcast(events.ClusterUpdatedV2, &eventdata.ClusterUpdatedV2{
+			Name:             wrapperspb.String("the-new-cluster"),
+			ApiServerAddress: wrapperspb.String("https://the-new-cluster"),
+		}, events.ClusterUpdatedV3, &eventdata.ClusterUpdatedV3{
+			Name:             wrapperspb.String("the-new-cluster"),
+			ApiServerAddress: wrapperspb.String("https://the-new-cluster"),
+		})
+	})
+	It("keeps the latest versions as they are", func() {
+		for _, eventType := range []es.EventType{events.ClusterCreatedV4, events.ClusterUpdatedV3, events.ClusterDeleted} {
+			event := es.NewEvent(context.Background(), eventType, nil, time.Now().UTC(), aggregates.Cluster, uuid.New(), 1)
+			upcasted, err := registry.Upcast(event)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(upcasted).To(Equal(event))
+		}
+	})
+})
@@ -0,0 +1,125 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsourcing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// aggregateKey identifies an aggregate.
+type aggregateKey struct {
+	aggregateType AggregateType
+	id            uuid.UUID
+}
+
+// aggregateBatchContextKey is the context key of the AggregateBatch.
+type aggregateBatchContextKey struct{}
+
+// AggregateBatch collects the changes several commands make to aggregates so that they can be stored at once.
+// Within a context created by WithAggregateBatch the AggregateStore returns the changed aggregates of the batch
+// instead of the stored ones, so every command sees the changes of the previous commands of the batch.
+type AggregateBatch struct {
+	mutex      sync.Mutex
+	aggregates map[aggregateKey]Aggregate
+	events     []Event
+}
+
+// NewAggregateBatch creates an empty batch.
+func NewAggregateBatch() *AggregateBatch {
+	return &AggregateBatch{
+		aggregates: make(map[aggregateKey]Aggregate),
+	}
+}
+
+// WithAggregateBatch returns a new context in which aggregates are loaded including the changes of the batch.
+func WithAggregateBatch(ctx context.Context, batch *AggregateBatch) context.Context {
+	return context.WithValue(ctx, aggregateBatchContextKey{}, batch)
+}
+
+// aggregateBatchFromContext returns the batch of the context if any.
+func aggregateBatchFromContext(ctx context.Context) (*AggregateBatch, bool) {
+	batch, ok := ctx.Value(aggregateBatchContextKey{}).(*AggregateBatch)
+	return batch, ok
+}
+
+// Load returns the aggregate the command has to be handled by including the changes of the batch.
+// Aggregates which do not exist yet get the id of the command unless it is nil, so that later commands of the batch can refer to them.
+func (b *AggregateBatch) Load(ctx context.Context, store AggregateStore, cmd Command) (Aggregate, error) {
+	aggregate, err := store.Get(WithAggregateBatch(ctx, b), cmd.AggregateType(), cmd.AggregateID())
+	if err != nil {
+		return nil, err
+	}
+	if !aggregate.Exists() && cmd.AggregateID() != uuid.Nil {
+		aggregate.setId(cmd.AggregateID())
+	}
+	return aggregate, nil
+}
+
+// Apply applies the uncommitted events of the aggregate on it and adds them to the batch.
+func (b *AggregateBatch) Apply(aggregate Aggregate) error {
+	events := aggregate.UncommittedEvents()
+	for _, event := range events {
+		if err := aggregate.ApplyEvent(event); err != nil {
+			return err
+		}
+		aggregate.IncrementVersion()
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.aggregates[aggregateKey{aggregate.Type(), aggregate.ID()}] = aggregate
+	b.events = append(b.events, events...)
+	return nil
+}
+
+// Events returns the events of all changes in the order they have been applied.
+func (b *AggregateBatch) Events() []Event {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return append([]Event{}, b.events...)
+}
+
+// get returns the changed aggregate of the given type and id.
+func (b *AggregateBatch) get(aggregateType AggregateType, id uuid.UUID) (Aggregate, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	aggregate, ok := b.aggregates[aggregateKey{aggregateType, id}]
+	return aggregate, ok
+}
+
+// merge replaces the given stored aggregates of a type with the changed ones and adds the aggregates created by the batch.
+func (b *AggregateBatch) merge(aggregateType AggregateType, stored []Aggregate) []Aggregate {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	merged := make([]Aggregate, 0, len(stored))
+	seen := make(map[uuid.UUID]bool)
+	for _, aggregate := range stored {
+		if changed, ok := b.aggregates[aggregateKey{aggregateType, aggregate.ID()}]; ok {
+			aggregate = changed
+		}
+		seen[aggregate.ID()] = true
+		merged = append(merged, aggregate)
+	}
+	for key, aggregate := range b.aggregates {
+		if key.aggregateType == aggregateType && !seen[key.id] {
+			merged = append(merged, aggregate)
+		}
+	}
+	return merged
+}
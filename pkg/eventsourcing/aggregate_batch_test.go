@@ -0,0 +1,101 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsourcing
+
+import (
+	"context"
+	"io"
+
+	mock_eventsourcing "github.com/finleap-connect/monoskope/internal/test/api/eventsourcing"
+	cmdApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing/commands"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("aggregate_batch", func() {
+	ctx := context.Background()
+
+	var mockCtrl *gomock.Controller
+	var esClient *mock_eventsourcing.MockEventStoreClient
+	var store AggregateStore
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+
+		esClient = mock_eventsourcing.NewMockEventStoreClient(mockCtrl)
+		esRetrieveClient := mock_eventsourcing.NewMockEventStore_RetrieveClient(mockCtrl)
+		esClient.EXPECT().Retrieve(gomock.Any(), gomock.Any()).Return(esRetrieveClient, nil).AnyTimes()
+		esRetrieveClient.EXPECT().Recv().Return(nil, io.EOF).AnyTimes()
+
+		registry := NewAggregateRegistry()
+		registry.RegisterAggregate(func() Aggregate { return newTestAggregate() })
+		store = NewAggregateManager(registry, esClient)
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("loads aggregates including the changes of the batch", func() {
+		batch := NewAggregateBatch()
+		cmd := &testCommand{aggregateId: uuid.New(), TestCommandData: cmdApi.TestCommandData{Test: "hello"}}
+
+		aggregate, err := batch.Load(ctx, store, cmd)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(aggregate.ID()).To(Equal(cmd.AggregateID()))
+		Expect(aggregate.Exists()).To(BeFalse())
+
+		_, err = aggregate.HandleCommand(ctx, cmd)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(batch.Apply(aggregate)).To(Succeed())
+		Expect(aggregate.Version()).To(Equal(uint64(1)))
+
+		reloaded, err := batch.Load(ctx, store, cmd)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reloaded).To(BeIdenticalTo(aggregate))
+
+		aggregates, err := store.All(WithAggregateBatch(ctx, batch), testAggregateType)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(aggregates).To(ConsistOf(aggregate))
+
+		aggregates, err = store.All(ctx, testAggregateType)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(aggregates).To(BeEmpty())
+
+		Expect(batch.Events()).To(HaveLen(1))
+	})
+	It("stores the events of all changes at once", func() {
+		batch := NewAggregateBatch()
+		for i := 0; i < 2; i++ {
+			cmd := &testCommand{aggregateId: uuid.New(), TestCommandData: cmdApi.TestCommandData{Test: "hello"}}
+			aggregate, err := batch.Load(ctx, store, cmd)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = aggregate.HandleCommand(ctx, cmd)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batch.Apply(aggregate)).To(Succeed())
+		}
+
+		esStoreClient := mock_eventsourcing.NewMockEventStore_StoreClient(mockCtrl)
+		esClient.EXPECT().Store(gomock.Any()).Return(esStoreClient, nil).Times(1)
+		esStoreClient.EXPECT().Send(gomock.Any()).Return(nil).Times(2)
+		esStoreClient.EXPECT().CloseAndRecv().Return(nil, nil).Times(1)
+
+		batchStore, ok := store.(BatchAggregateStore)
+		Expect(ok).To(BeTrue())
+		Expect(batchStore.UpdateAll(ctx, batch)).To(Succeed())
+	})
+})
@@ -41,6 +41,14 @@ type AggregateStore interface {
 	Update(context.Context, Aggregate) error
 }
 
+// BatchAggregateStore is implemented by aggregate stores which can store the changes of several aggregates at once.
+type BatchAggregateStore interface {
+	AggregateStore
+
+	// UpdateAll stores the events of all changes of the batch, either all of them or none.
+	UpdateAll(context.Context, *AggregateBatch) error
+}
+
 // aggregateStore handles storing and loading aggregates from/to the EventStore.
 type aggregateStore struct {
 	registry AggregateRegistry
@@ -87,7 +95,7 @@ func (r *aggregateStore) All(ctx context.Context, aggregateType AggregateType) (
 			return nil, err
 		}
 
-		event, err := NewEventFromProto(protoEvent)
+		event, err := UpcastEventFromProto(protoEvent)
 		if err != nil { // Error converting
 			return nil, err
 		}
@@ -125,6 +133,9 @@ func (r *aggregateStore) All(ctx context.Context, aggregateType AggregateType) (
 		aggregates[event.AggregateID()] = aggregate
 	}
 
+	if batch, ok := aggregateBatchFromContext(ctx); ok {
+		return batch.merge(aggregateType, toAggregateArray(aggregates)), nil
+	}
 	return toAggregateArray(aggregates), nil
 }
 
@@ -147,6 +158,13 @@ func (r *aggregateStore) Get(ctx context.Context, aggregateType AggregateType, i
 	))
 	defer span.End()
 
+	// Return aggregates changed by the batch of commands currently handled
+	if batch, ok := aggregateBatchFromContext(ctx); ok {
+		if aggregate, ok := batch.get(aggregateType, id); ok {
+			return aggregate, nil
+		}
+	}
+
 	// Retrieve events from store
 	stream, err := r.esClient.Retrieve(ctx, &esApi.EventFilter{
 		AggregateId:   wrapperspb.String(id.String()),
@@ -169,7 +187,7 @@ func (r *aggregateStore) Get(ctx context.Context, aggregateType AggregateType, i
 			return nil, err
 		}
 
-		event, err := NewEventFromProto(protoEvent)
+		event, err := UpcastEventFromProto(protoEvent)
 		if err != nil { // Error converting
 			return nil, err
 		}
@@ -250,3 +268,34 @@ func (r *aggregateStore) Update(ctx context.Context, aggregate Aggregate) error
 	_, err = stream.CloseAndRecv()
 	return err
 }
+
+// UpdateAll stores the events of all changes of the batch with a single stream, so they are stored all or none.
+func (r *aggregateStore) UpdateAll(ctx context.Context, batch *AggregateBatch) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	ctx, span := telemetry.GetSpan(ctx, "AggregateStore.UpdateAll")
+	defer span.End()
+
+	events := batch.Events()
+
+	// Check that there are events in-flight.
+	if len(events) == 0 {
+		return nil
+	}
+
+	// Create stream to send events to store.
+	stream, err := r.esClient.Store(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		// Send event to store
+		if err := stream.Send(NewProtoFromEvent(event)); err != nil {
+			return err
+		}
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
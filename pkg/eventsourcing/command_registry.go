@@ -26,6 +26,8 @@ import (
 
 type CommandRegistry interface {
 	CommandHandler
+	CommandValidator
+	BatchCommandHandler
 	RegisterCommand(func(uuid.UUID) Command)
 	CreateCommand(id uuid.UUID, commandType CommandType, data *anypb.Any) (Command, error)
 	GetRegisteredCommandTypes() []CommandType
@@ -125,6 +127,51 @@ func (r *commandRegistry) HandleCommand(ctx context.Context, cmd Command) (*Comm
 	return nil, errors.ErrHandlerNotFound
 }
 
+// ValidateCommand validates a command with a handler capable of handling it.
+func (r *commandRegistry) ValidateCommand(ctx context.Context, cmd Command) (*CommandReply, []Event, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	handler, ok := r.handlers[cmd.CommandType()]
+	if !ok {
+		r.log.Info("trying to validate a command of non-registered type", "commandType", cmd.CommandType())
+		return nil, nil, errors.ErrHandlerNotFound
+	}
+
+	validator, ok := handler.(CommandValidator)
+	if !ok {
+		return nil, nil, errors.ErrValidationNotSupported
+	}
+	return validator.ValidateCommand(ctx, cmd)
+}
+
+// HandleCommands handles a batch of commands with the handler capable of handling all of them at once.
+func (r *commandRegistry) HandleCommands(ctx context.Context, cmds []Command, dryRun bool) ([]*CommandReply, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var batchHandler BatchCommandHandler
+	for i, cmd := range cmds {
+		handler, ok := r.handlers[cmd.CommandType()]
+		if !ok {
+			r.log.Info("trying to handle a command of non-registered type", "commandType", cmd.CommandType())
+			return nil, &CommandBatchError{Index: i, Err: errors.ErrHandlerNotFound}
+		}
+
+		// All commands of a batch have to be handled by the same handler to be stored at once
+		h, ok := handler.(BatchCommandHandler)
+		if !ok || (batchHandler != nil && batchHandler != h) {
+			return nil, &CommandBatchError{Index: i, Err: errors.ErrBatchNotSupported}
+		}
+		batchHandler = h
+	}
+
+	if batchHandler == nil {
+		return nil, nil
+	}
+	return batchHandler.HandleCommands(ctx, cmds, dryRun)
+}
+
 // SetHandler adds a handler for a specific command.
 func (r *commandRegistry) SetHandler(handler CommandHandler, commandType CommandType) {
 	r.mutex.Lock()
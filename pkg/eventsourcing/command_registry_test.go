@@ -16,8 +16,10 @@ package eventsourcing
 
 import (
 	"context"
+	"errors"
 
 	cmdApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing/commands"
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -81,4 +83,29 @@ var _ = Describe("command_registry", func() {
 		Expect(reply.Id).ToNot(Equal(inId))
 		Expect(reply.Version).To(Equal(uint64(0)))
 	})
+	It("can't validate commands if the handler does not support it", func() {
+		registry := NewCommandRegistry()
+
+		registry.SetHandler(newTestCommandHandler(), testCommandType)
+
+		_, _, err := registry.ValidateCommand(context.Background(), &testCommand{
+			aggregateId:     uuid.New(),
+			TestCommandData: cmdApi.TestCommandData{Test: "world!"},
+		})
+		Expect(err).To(Equal(esErrors.ErrValidationNotSupported))
+	})
+	It("can't handle commands as batch if the handler does not support it", func() {
+		registry := NewCommandRegistry()
+
+		registry.SetHandler(newTestCommandHandler(), testCommandType)
+
+		_, err := registry.HandleCommands(context.Background(), []Command{&testCommand{
+			aggregateId:     uuid.New(),
+			TestCommandData: cmdApi.TestCommandData{Test: "world!"},
+		}}, false)
+		var batchErr *CommandBatchError
+		Expect(errors.As(err, &batchErr)).To(BeTrue())
+		Expect(batchErr.Index).To(Equal(0))
+		Expect(batchErr.Err).To(Equal(esErrors.ErrBatchNotSupported))
+	})
 })
@@ -20,6 +20,7 @@ import (
 
 	"github.com/finleap-connect/monoskope/internal/telemetry"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -72,3 +73,89 @@ func (h *storingAggregateHandler) HandleCommand(ctx context.Context, cmd es.Comm
 
 	return reply, err
 }
+
+// ValidateCommand implements the CommandValidator interface
+func (h *storingAggregateHandler) ValidateCommand(ctx context.Context, cmd es.Command) (*es.CommandReply, []es.Event, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	ctx, span := telemetry.GetSpan(ctx, "StoringAggregateHandler.ValidateCommand", trace.WithAttributes(
+		attribute.String("AggregateType", cmd.AggregateType().String()),
+		attribute.String("AggregateID", cmd.AggregateID().String()),
+	))
+	defer span.End()
+
+	// Load the aggregate from the store, it is rebuilt from the events and not shared
+	aggregate, err := h.aggregateManager.Get(ctx, cmd.AggregateType(), cmd.AggregateID())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Apply the command to the aggregate
+	reply, err := aggregate.HandleCommand(ctx, cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Return the emitted events instead of storing them
+	events := aggregate.UncommittedEvents()
+
+	// Set the version the aggregate would have after handling the command.
+	reply.Version = aggregate.Version() + uint64(len(events))
+
+	return reply, events, nil
+}
+
+// HandleCommands implements the BatchCommandHandler interface
+func (h *storingAggregateHandler) HandleCommands(ctx context.Context, cmds []es.Command, dryRun bool) ([]*es.CommandReply, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	ctx, span := telemetry.GetSpan(ctx, "StoringAggregateHandler.HandleCommands", trace.WithAttributes(
+		attribute.Int("Commands", len(cmds)),
+		attribute.Bool("DryRun", dryRun),
+	))
+	defer span.End()
+
+	batchStore, ok := h.aggregateManager.(es.BatchAggregateStore)
+	if !ok {
+		return nil, errors.ErrBatchNotSupported
+	}
+
+	batch := es.NewAggregateBatch()
+	batchCtx := es.WithAggregateBatch(ctx, batch)
+
+	replies := make([]*es.CommandReply, 0, len(cmds))
+	for i, cmd := range cmds {
+		// Load the aggregate including the changes of the previous commands
+		aggregate, err := batch.Load(ctx, batchStore, cmd)
+		if err != nil {
+			return nil, &es.CommandBatchError{Index: i, Err: err}
+		}
+
+		// Apply the command to the aggregate
+		reply, err := aggregate.HandleCommand(batchCtx, cmd)
+		if err != nil {
+			return nil, &es.CommandBatchError{Index: i, Err: err}
+		}
+
+		// Keep emitted events until all commands have been handled
+		if err := batch.Apply(aggregate); err != nil {
+			return nil, &es.CommandBatchError{Index: i, Err: err}
+		}
+
+		// Set the version the aggregate now has after handling the command.
+		reply.Version = aggregate.Version()
+		replies = append(replies, reply)
+	}
+
+	if dryRun {
+		return replies, nil
+	}
+
+	// Store the emitted events of all commands at once
+	if err := batchStore.UpdateAll(ctx, batch); err != nil {
+		return nil, err
+	}
+	return replies, nil
+}
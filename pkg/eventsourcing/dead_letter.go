@@ -0,0 +1,48 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsourcing
+
+import (
+	"context"
+	"time"
+)
+
+// DeadLetterQueue is implemented by event bus consumers which quarantine events
+// their handlers failed to handle even after retrying.
+type DeadLetterQueue interface {
+	// GetDeadLetters returns all quarantined events.
+	GetDeadLetters(context.Context) ([]*DeadLetter, error)
+	// RetryDeadLetter hands the quarantined event to the handler which failed again
+	// and removes it from the queue if it has been handled successfully.
+	RetryDeadLetter(context.Context, string) error
+	// DiscardDeadLetter removes the quarantined event from the queue.
+	DiscardDeadLetter(context.Context, string) error
+}
+
+// DeadLetter is an event a handler failed to handle.
+type DeadLetter struct {
+	// Unique ID of the dead letter
+	ID string
+	// Name of the handler which failed to handle the event
+	Handler string
+	// Event which could not be handled, nil if the message could not be decoded
+	Event Event
+	// Error returned by the handler
+	Error string
+	// Count of retries before the event has been quarantined
+	Retries int
+	// Timestamp of when the event has been quarantined
+	Timestamp time.Time
+}
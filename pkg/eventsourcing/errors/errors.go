@@ -38,6 +38,15 @@ var (
 
 	// ErrConnectionClosed is when connection with underlying storage has been closed
 	ErrConnectionClosed = errors.New("connection to storage closed")
+
+	// ErrCheckpointKeyNotConfigured is when a checkpoint should be signed but no key has been configured
+	ErrCheckpointKeyNotConfigured = errors.New("no key configured to sign checkpoints")
+
+	// ErrIntegrityVerificationNotSupported is when the store does not chain events by hashes
+	ErrIntegrityVerificationNotSupported = errors.New("store does not support integrity verification")
+
+	// ErrInvalidCheckpointKey is when the configured checkpoint key is not a PEM encoded ed25519 key
+	ErrInvalidCheckpointKey = errors.New("checkpoint key must be a PEM encoded ed25519 key")
 )
 
 // MessageBus Errors
@@ -69,11 +78,23 @@ var (
 	// ErrCouldNotParseAggregateId is when an aggregate id could not be parsed as uuid
 	ErrCouldNotParseAggregateId = errors.New("could not parse aggregate id")
 
+	// ErrCouldNotParseIssuerId is when an issuer id could not be parsed as uuid
+	ErrCouldNotParseIssuerId = errors.New("could not parse issuer id")
+
+	// ErrCouldNotParseTenantId is when a tenant id could not be parsed as uuid
+	ErrCouldNotParseTenantId = errors.New("could not parse tenant id")
+
+	// ErrCouldNotParseUserId is when a user id could not be parsed as uuid
+	ErrCouldNotParseUserId = errors.New("could not parse user id")
+
 	// ErrConfigNameRequired is when the config doesn't include a name.
 	ErrConfigNameRequired = errors.New("name must not be empty")
 
 	// ErrConfigUrlRequired is when the config doesn't include a name.
 	ErrConfigUrlRequired = errors.New("url must not be empty")
+
+	// ErrConfigMaxRetriesNegative is when the config includes a negative count of retries.
+	ErrConfigMaxRetriesNegative = errors.New("max retries must not be negative")
 )
 
 // Command Registry Errors
@@ -95,6 +116,12 @@ var (
 
 	// ErrHandlerNotFound is when no handler can be found.
 	ErrHandlerNotFound = errors.New("no handlers for command")
+
+	// ErrValidationNotSupported is when a command can not be validated without executing it.
+	ErrValidationNotSupported = errors.New("command can not be validated")
+
+	// ErrBatchNotSupported is when the commands of a batch can not be handled at once.
+	ErrBatchNotSupported = errors.New("commands can not be handled as batch")
 )
 
 // Repository Errors
@@ -122,3 +149,45 @@ var (
 	// ErrAggregateNotRegistered is when no aggregate factory was registered.
 	ErrAggregateNotRegistered = errors.New("aggregate not registered")
 )
+
+// Upcaster Registry Errors
+var (
+	// ErrEmptyEventType is when an event type given is empty.
+	ErrEmptyEventType = errors.New("event type must not be empty")
+
+	// ErrUpcasterInvalid is when an upcaster given is nil.
+	ErrUpcasterInvalid = errors.New("upcaster must not be nil")
+
+	// ErrUpcasterAlreadyRegistered is when an upcaster was already registered for an event type.
+	ErrUpcasterAlreadyRegistered = errors.New("upcaster already registered for event type")
+
+	// ErrUpcasterCycle is when upcasting an event leads to an event type which has been upcasted before.
+	ErrUpcasterCycle = errors.New("upcasters of event type form a cycle")
+
+	// ErrInvalidEventData is when the data of an event does not match the event type.
+	ErrInvalidEventData = errors.New("event data does not match event type")
+)
+
+// Event Schema Registry Errors
+var (
+	// ErrEventSchemaAlreadyRegistered is when a schema was already registered for an event type.
+	ErrEventSchemaAlreadyRegistered = errors.New("event schema already registered for event type")
+
+	// ErrEventSchemaNotRegistered is when no schema was registered for an event type.
+	ErrEventSchemaNotRegistered = errors.New("event schema not registered")
+
+	// ErrEmptyConsumerName is when the name of an event consumer given is empty.
+	ErrEmptyConsumerName = errors.New("consumer name must not be empty")
+)
+
+// Dead Letter Queue Errors
+var (
+	// ErrDeadLetterNotFound is when the requested dead letter is not in the queue.
+	ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+	// ErrDeadLetterHandlerNotFound is when the handler which failed to handle a dead letter is not registered anymore.
+	ErrDeadLetterHandlerNotFound = errors.New("handler of dead letter not registered")
+
+	// ErrDeadLetterQueueNotConfigured is when dead lettering has been disabled.
+	ErrDeadLetterQueueNotConfigured = errors.New("dead letter queue not configured")
+)
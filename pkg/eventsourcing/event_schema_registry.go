@@ -0,0 +1,216 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsourcing
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// EventSchema describes the data carried by events of a type and the services consuming them.
+type EventSchema struct {
+	// EventType is the type of the event.
+	EventType EventType
+	// AggregateType is the type of the aggregate emitting the event.
+	AggregateType AggregateType
+	// Data is the descriptor of the proto message carried as data, nil if the event carries no data.
+	Data protoreflect.MessageDescriptor
+	// Consumers are the names of the services consuming the event from the message bus.
+	Consumers []string
+}
+
+// EventSchemaRegistry maps event types to the proto message carried as their data.
+type EventSchemaRegistry interface {
+	// RegisterEventSchema registers the proto message carried as data by events of a type, nil if the event carries no data.
+	RegisterEventSchema(EventType, AggregateType, proto.Message)
+	// RegisterEventConsumer registers a service consuming events of the given aggregate types or of any type if none are given.
+	RegisterEventConsumer(string, ...AggregateType)
+	// GetEventSchema returns the schema of an event type.
+	GetEventSchema(EventType) (*EventSchema, error)
+	// GetEventSchemas returns the schemas of all registered event types ordered by event type.
+	GetEventSchemas() []*EventSchema
+	// Validate checks the data of an event against the schema registered for its type.
+	// Events of types without a registered schema are not validated.
+	Validate(Event) error
+}
+
+type eventSchema struct {
+	aggregateType AggregateType
+	data          proto.Message
+}
+
+type eventConsumer struct {
+	name           string
+	aggregateTypes []AggregateType
+}
+
+type eventSchemaRegistry struct {
+	log       logger.Logger
+	mutex     sync.RWMutex
+	schemas   map[EventType]*eventSchema
+	consumers []*eventConsumer
+}
+
+var DefaultEventSchemaRegistry EventSchemaRegistry
+
+func init() {
+	DefaultEventSchemaRegistry = NewEventSchemaRegistry()
+}
+
+// NewEventSchemaRegistry creates a new event schema registry
+func NewEventSchemaRegistry() EventSchemaRegistry {
+	return &eventSchemaRegistry{
+		log:     logger.WithName("event-schema-registry"),
+		schemas: make(map[EventType]*eventSchema),
+	}
+}
+
+// RegisterEventSchema registers the proto message carried as data by events of a type.
+//
+// An example would be:
+//
+//	RegisterEventSchema(events.UserCreated, aggregates.User, &eventdata.UserCreated{})
+func (r *eventSchemaRegistry) RegisterEventSchema(eventType EventType, aggregateType AggregateType, data proto.Message) {
+	if eventType.String() == "" {
+		r.log.Info("attempt to register schema for empty event type")
+		panic(errors.ErrEmptyEventType)
+	}
+	if aggregateType.String() == "" {
+		r.log.Info("attempt to register schema for empty aggregate type", "eventType", eventType)
+		panic(errors.ErrEmptyAggregateType)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.schemas[eventType]; ok {
+		r.log.Info("attempt to register schema already registered", "eventType", eventType)
+		panic(errors.ErrEventSchemaAlreadyRegistered)
+	}
+	r.schemas[eventType] = &eventSchema{aggregateType: aggregateType, data: data}
+
+	r.log.V(logger.DebugLevel).Info("event schema has been registered.", "eventType", eventType)
+}
+
+// RegisterEventConsumer registers a service consuming events of the given aggregate types.
+func (r *eventSchemaRegistry) RegisterEventConsumer(name string, aggregateTypes ...AggregateType) {
+	if name == "" {
+		r.log.Info("attempt to register consumer with empty name")
+		panic(errors.ErrEmptyConsumerName)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.consumers = append(r.consumers, &eventConsumer{name: name, aggregateTypes: aggregateTypes})
+
+	r.log.V(logger.DebugLevel).Info("event consumer has been registered.", "consumer", name)
+}
+
+// GetEventSchema implements the GetEventSchema method of the EventSchemaRegistry interface.
+func (r *eventSchemaRegistry) GetEventSchema(eventType EventType) (*EventSchema, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	schema, ok := r.schemas[eventType]
+	if !ok {
+		return nil, errors.ErrEventSchemaNotRegistered
+	}
+	return r.toEventSchema(eventType, schema), nil
+}
+
+// GetEventSchemas implements the GetEventSchemas method of the EventSchemaRegistry interface.
+func (r *eventSchemaRegistry) GetEventSchemas() []*EventSchema {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var schemas []*EventSchema
+	for eventType, schema := range r.schemas {
+		schemas = append(schemas, r.toEventSchema(eventType, schema))
+	}
+	sort.Slice(schemas, func(i, j int) bool {
+		return schemas[i].EventType < schemas[j].EventType
+	})
+	return schemas
+}
+
+// Validate implements the Validate method of the EventSchemaRegistry interface.
+func (r *eventSchemaRegistry) Validate(event Event) error {
+	r.mutex.RLock()
+	schema, ok := r.schemas[event.EventType()]
+	r.mutex.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	if event.AggregateType() != schema.aggregateType {
+		return fmt.Errorf("%w: event type '%s' is emitted by aggregate type '%s'", errors.ErrInvalidAggregateType, event.EventType(), schema.aggregateType)
+	}
+
+	data := event.Data()
+	if len(data) == 0 {
+		return nil
+	}
+	if schema.data == nil {
+		return fmt.Errorf("%w: event type '%s' carries no data", errors.ErrInvalidEventData, event.EventType())
+	}
+
+	a, err := data.toAny()
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrInvalidEventData, err)
+	}
+	expected := schema.data.ProtoReflect().Descriptor().FullName()
+	if a.MessageName() != expected {
+		return fmt.Errorf("%w: event type '%s' carries '%s' but got '%s'", errors.ErrInvalidEventData, event.EventType(), expected, a.MessageName())
+	}
+	if err := a.UnmarshalTo(schema.data.ProtoReflect().New().Interface()); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrInvalidEventData, err)
+	}
+	return nil
+}
+
+func (r *eventSchemaRegistry) toEventSchema(eventType EventType, schema *eventSchema) *EventSchema {
+	eventSchema := &EventSchema{
+		EventType:     eventType,
+		AggregateType: schema.aggregateType,
+	}
+	if schema.data != nil {
+		eventSchema.Data = schema.data.ProtoReflect().Descriptor()
+	}
+	for _, consumer := range r.consumers {
+		if consumer.consumes(schema.aggregateType) {
+			eventSchema.Consumers = append(eventSchema.Consumers, consumer.name)
+		}
+	}
+	return eventSchema
+}
+
+func (c *eventConsumer) consumes(aggregateType AggregateType) bool {
+	if len(c.aggregateTypes) == 0 {
+		return true
+	}
+	for _, t := range c.aggregateTypes {
+		if t == aggregateType {
+			return true
+		}
+	}
+	return false
+}
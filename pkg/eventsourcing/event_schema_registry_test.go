@@ -0,0 +1,84 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsourcing
+
+import (
+	"context"
+	"time"
+
+	testEd "github.com/finleap-connect/monoskope/pkg/api/eventsourcing/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("event_schema_registry", func() {
+	const (
+		testEventCreated EventType = "TestEventCreated"
+		testEventDeleted EventType = "TestEventDeleted"
+	)
+
+	newRegistry := func() EventSchemaRegistry {
+		registry := NewEventSchemaRegistry()
+		registry.RegisterEventSchema(testEventCreated, testAggregateType, &testEd.TestEventData{})
+		registry.RegisterEventSchema(testEventDeleted, testAggregateType, nil)
+		return registry
+	}
+	newEvent := func(eventType EventType, aggregateType AggregateType, data EventData) Event {
+		return NewEvent(context.Background(), eventType, data, time.Now().UTC(), aggregateType, uuid.New(), 1)
+	}
+
+	It("can't register schemas for the same event type twice", func() {
+		registry := newRegistry()
+
+		defer func() {
+			Expect(recover()).To(Equal(errors.ErrEventSchemaAlreadyRegistered))
+		}()
+		registry.RegisterEventSchema(testEventCreated, testAggregateType, &testEd.TestEventData{})
+	})
+	It("returns the schemas ordered by event type along with their consumers", func() {
+		registry := newRegistry()
+		registry.RegisterEventConsumer("test-consumer", testAggregateType)
+		registry.RegisterEventConsumer("other-consumer", AggregateType("OtherAggregate"))
+		registry.RegisterEventConsumer("any-consumer")
+
+		schemas := registry.GetEventSchemas()
+		Expect(schemas).To(HaveLen(2))
+		Expect(schemas[0].EventType).To(Equal(testEventCreated))
+		Expect(schemas[0].AggregateType).To(Equal(testAggregateType))
+		Expect(schemas[0].Data.FullName()).To(BeEquivalentTo("eventdata.TestEventData"))
+		Expect(schemas[0].Consumers).To(Equal([]string{"test-consumer", "any-consumer"}))
+		Expect(schemas[1].EventType).To(Equal(testEventDeleted))
+		Expect(schemas[1].Data).To(BeNil())
+
+		_, err := registry.GetEventSchema(EventType("Unknown"))
+		Expect(err).To(Equal(errors.ErrEventSchemaNotRegistered))
+	})
+	It("accepts events matching their schema", func() {
+		registry := newRegistry()
+		Expect(registry.Validate(newEvent(testEventCreated, testAggregateType, ToEventDataFromProto(&testEd.TestEventData{Hello: "world"})))).To(Succeed())
+		Expect(registry.Validate(newEvent(testEventDeleted, testAggregateType, nil))).To(Succeed())
+		Expect(registry.Validate(newEvent(EventType("Unknown"), testAggregateType, ToEventDataFromProto(wrapperspb.String("world"))))).To(Succeed())
+	})
+	It("rejects events not matching their schema", func() {
+		registry := newRegistry()
+		Expect(registry.Validate(newEvent(testEventCreated, testAggregateType, ToEventDataFromProto(wrapperspb.String("world"))))).To(MatchError(errors.ErrInvalidEventData))
+		Expect(registry.Validate(newEvent(testEventCreated, testAggregateType, EventData("{}")))).To(MatchError(errors.ErrInvalidEventData))
+		Expect(registry.Validate(newEvent(testEventDeleted, testAggregateType, ToEventDataFromProto(&testEd.TestEventData{})))).To(MatchError(errors.ErrInvalidEventData))
+		Expect(registry.Validate(newEvent(testEventDeleted, AggregateType("OtherAggregate"), nil))).To(MatchError(errors.ErrInvalidAggregateType))
+	})
+})
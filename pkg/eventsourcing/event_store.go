@@ -40,6 +40,35 @@ type EventStore interface {
 	Close() error
 }
 
+// BatchEventStore is implemented by event stores which can save the events of several aggregates at once.
+type BatchEventStore interface {
+	// SaveAll appends the events of several aggregates to the store, either all of them or none.
+	// The events of each aggregate have to be in version order like for Save, the events of different aggregates may be interleaved.
+	SaveAll(context.Context, []Event) error
+}
+
+// GroupEventsByAggregate groups events by their aggregate keeping the order of the events and the aggregates.
+func GroupEventsByAggregate(events []Event) [][]Event {
+	type aggregateKey struct {
+		aggregateType AggregateType
+		aggregateID   uuid.UUID
+	}
+
+	var groups [][]Event
+	indices := make(map[aggregateKey]int)
+	for _, event := range events {
+		key := aggregateKey{event.AggregateType(), event.AggregateID()}
+		i, ok := indices[key]
+		if !ok {
+			i = len(groups)
+			indices[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], event)
+	}
+	return groups
+}
+
 // StoreQuery contains query information on how to retrieve events from an event store
 type StoreQuery struct {
 	// Filter events by aggregate id
@@ -54,6 +83,14 @@ type StoreQuery struct {
 	MinTimestamp *time.Time
 	// Filter events with a Timestamp <= MaxTimestamp
 	MaxTimestamp *time.Time
+	// Filter events of a specific event type
+	EventType *EventType
+	// Filter events issued by the user with the given id
+	IssuerId *uuid.UUID
+	// Filter events of the tenant with the given id or referencing it
+	TenantId *uuid.UUID
+	// Filter events of the user with the given id or referencing it
+	UserId *uuid.UUID
 }
 
 type EventStreamReceiver interface {
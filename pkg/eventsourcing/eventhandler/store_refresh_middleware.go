@@ -110,7 +110,7 @@ func (m *eventStoreRefreshEventHandler) applyEventsFromStore(ctx context.Context
 		}
 
 		// Convert event from api to es
-		event, err := es.NewEventFromProto(protoEvent)
+		event, err := es.UpcastEventFromProto(protoEvent)
 		if err != nil {
 			return err
 		}
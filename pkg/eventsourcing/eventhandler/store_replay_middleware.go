@@ -88,7 +88,7 @@ func (m *eventStoreReplayEventHandler) applyEventsFromStore(ctx context.Context,
 		}
 
 		// Convert event from api to es
-		esEvent, err := es.NewEventFromProto(protoEvent)
+		esEvent, err := es.UpcastEventFromProto(protoEvent)
 		if err != nil {
 			return err
 		}
@@ -16,6 +16,7 @@ package eventsourcing
 
 import (
 	"context"
+	"fmt"
 )
 
 // CommandHandler is an interface that all handlers of commands must implement.
@@ -24,6 +25,37 @@ type CommandHandler interface {
 	HandleCommand(context.Context, Command) (*CommandReply, error)
 }
 
+// CommandValidator is implemented by command handlers which can validate commands without storing any changes.
+type CommandValidator interface {
+	// ValidateCommand handles the command on a copy of its aggregate and returns the events which would be emitted.
+	ValidateCommand(context.Context, Command) (*CommandReply, []Event, error)
+}
+
+// BatchCommandHandler is implemented by command handlers which can handle several commands at once.
+type BatchCommandHandler interface {
+	// HandleCommands validates and handles all commands in order, each seeing the changes of the previous ones.
+	// The resulting events are either stored all or none. With dryRun nothing is stored at all.
+	HandleCommands(ctx context.Context, cmds []Command, dryRun bool) ([]*CommandReply, error)
+}
+
+// CommandBatchError is returned by a BatchCommandHandler when one of the commands of a batch failed.
+type CommandBatchError struct {
+	// Index is the position of the failed command in the batch.
+	Index int
+	// Err is the error the command failed with.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *CommandBatchError) Error() string {
+	return fmt.Sprintf("command %d of batch failed: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error the command failed with.
+func (e *CommandBatchError) Unwrap() error {
+	return e.Err
+}
+
 // EventHandler is an interface that all handlers of events must implement.
 type EventHandler interface {
 	// HandleEvent handles an event.
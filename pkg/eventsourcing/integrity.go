@@ -0,0 +1,90 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsourcing
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IntegrityVerifier is implemented by event stores which chain the stored events by hashes.
+type IntegrityVerifier interface {
+	// VerifyIntegrity verifies the hash chain of all stored events and the signed checkpoints.
+	VerifyIntegrity(context.Context) (*IntegrityReport, error)
+
+	// Checkpoint signs the heads of the hash chains of all aggregates and stores them as checkpoint.
+	Checkpoint(context.Context) (*Checkpoint, error)
+}
+
+// IntegrityReport is the result of verifying the integrity of an event store.
+type IntegrityReport struct {
+	// Count of events whose hash and link to the predecessor have been verified
+	VerifiedEvents uint64
+	// Count of events stored before hash chaining has been enabled
+	UnchainedEvents uint64
+	// Count of checkpoints whose signature has been verified
+	VerifiedCheckpoints uint64
+	// Count of checkpoints which could not be verified since no key is configured
+	UnverifiedCheckpoints uint64
+	// Count of broken links found
+	BrokenLinks uint64
+	// Earliest broken link found, nil if the store is intact
+	FirstBrokenLink *BrokenLink
+	// Most recent checkpoint which has been verified successfully
+	LatestCheckpoint *Checkpoint
+}
+
+// BrokenLink describes a link of the hash chain which does not match the stored history.
+type BrokenLink struct {
+	// ID of the event, uuid.Nil if the event or checkpoint is missing entirely
+	EventID uuid.UUID
+	// Type of the aggregate the broken link belongs to
+	AggregateType AggregateType
+	// ID of the aggregate the broken link belongs to
+	AggregateID uuid.UUID
+	// Version of the aggregate the broken link belongs to
+	AggregateVersion uint64
+	// Timestamp of the event or checkpoint
+	Timestamp time.Time
+	// Human readable reason why the link is broken
+	Reason string
+}
+
+// Checkpoint is a signed digest over the heads of the hash chains of all aggregates.
+type Checkpoint struct {
+	// Timestamp of when the checkpoint was created
+	Timestamp time.Time
+	// Count of aggregates covered by the checkpoint
+	Aggregates uint64
+	// Hex encoded SHA-256 digest over the heads of all aggregates
+	Digest string
+	// Base64 encoded ed25519 signature of the digest
+	Signature string
+}
+
+// Intact returns whether no broken link has been found.
+func (r *IntegrityReport) Intact() bool {
+	return r.BrokenLinks == 0
+}
+
+// AddBrokenLink counts the given broken link and keeps it as first broken link if it is the earliest one so far.
+func (r *IntegrityReport) AddBrokenLink(link *BrokenLink) {
+	r.BrokenLinks++
+	if r.FirstBrokenLink == nil || link.Timestamp.Before(r.FirstBrokenLink.Timestamp) {
+		r.FirstBrokenLink = link
+	}
+}
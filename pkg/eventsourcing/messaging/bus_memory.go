@@ -0,0 +1,364 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messaging
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	evs "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// InMemoryEventBus is an event bus delivering events to the handlers within the same process.
+type InMemoryEventBus interface {
+	evs.EventBusPublisher
+	evs.EventBusConsumer
+	evs.DeadLetterQueue
+}
+
+// memoryEventBus implements an EventBus delivering events to handlers within the same process.
+// It is meant for development and tests, events not handled yet are lost when the process exits.
+type memoryEventBus struct {
+	log           logger.Logger
+	name          string
+	maxRetries    int
+	retryInterval time.Duration
+	ctx           context.Context
+	cancel        context.CancelFunc
+
+	queuesMutex sync.RWMutex
+	queues      map[string]*memoryQueue
+
+	// handlers of the dead letters by id, names of handlers are not unique if consumers share the bus
+	deadLetterMutex    sync.Mutex
+	deadLetters        []*evs.DeadLetter
+	deadLetterHandlers map[string]evs.EventHandler
+}
+
+// memoryQueue buffers the events for the handlers bound to it.
+// Handlers sharing a queue compete for the events like workers of a rabbitmq work queue.
+type memoryQueue struct {
+	name     string
+	mutex    sync.Mutex
+	matchers []*memoryMatcher
+	handlers []evs.EventHandler
+	next     int
+	events   []evs.Event
+	notify   chan struct{}
+}
+
+// NewInMemoryEventBus creates a new in-process event bus. Events a handler fails to handle
+// are retried maxRetries times starting with retryInterval before they are dead-lettered.
+func NewInMemoryEventBus(name string, maxRetries int, retryInterval time.Duration) (InMemoryEventBus, error) {
+	if name == "" {
+		return nil, errors.ErrConfigNameRequired
+	}
+	if maxRetries < 0 {
+		return nil, errors.ErrConfigMaxRetriesNegative
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &memoryEventBus{
+		log:           logger.WithName("memory-bus").WithValues("name", name),
+		name:          name,
+		maxRetries:    maxRetries,
+		retryInterval: retryInterval,
+		ctx:           ctx,
+		cancel:        cancel,
+		queues:        make(map[string]*memoryQueue),
+
+		deadLetterHandlers: make(map[string]evs.EventHandler),
+	}, nil
+}
+
+// PublishEvent publishes the event on the bus.
+func (b *memoryEventBus) PublishEvent(ctx context.Context, event evs.Event) error {
+	if b.ctx.Err() != nil {
+		return errors.ErrMessageNotConnected
+	}
+
+	b.queuesMutex.RLock()
+	defer b.queuesMutex.RUnlock()
+	for _, queue := range b.queues {
+		queue.push(event)
+	}
+	return nil
+}
+
+// AddHandler adds a handler for events matching one of the given EventMatcher.
+func (b *memoryEventBus) AddHandler(ctx context.Context, handler evs.EventHandler, matchers ...evs.EventMatcher) error {
+	err := b.addHandler(ctx, handler, "", matchers...)
+	if err != nil {
+		b.log.Error(err, "Adding handler failed.")
+		return err
+	}
+	b.log.Info("Handler added.")
+	return nil
+}
+
+// AddWorker behave similar to AddHandler but distributes events among the handlers with the same
+// work queue name according to the competing consumers pattern.
+func (b *memoryEventBus) AddWorker(ctx context.Context, handler evs.EventHandler, workQueueName string, matchers ...evs.EventMatcher) error {
+	err := b.addHandler(ctx, handler, workQueueName, matchers...)
+	if err != nil {
+		b.log.Error(err, "Adding worker failed.")
+		return err
+	}
+	b.log.Info("Worker added.", "WorkQueueName", workQueueName)
+	return nil
+}
+
+// addHandler binds the handler to a new queue or the existing work queue
+func (b *memoryEventBus) addHandler(ctx context.Context, handler evs.EventHandler, workQueueName string, matchers ...evs.EventMatcher) error {
+	if handler == nil {
+		return errors.ErrHandlerMustNotBeNil
+	}
+	if matchers == nil {
+		return errors.ErrMatcherMustNotBeNil
+	}
+
+	var memoryMatchers []*memoryMatcher
+	var routingKeys []string
+	for _, matcher := range matchers {
+		memoryMatcher, ok := matcher.(*memoryMatcher)
+		if !ok {
+			return errors.ErrMatcherMustNotBeNil
+		}
+		memoryMatchers = append(memoryMatchers, memoryMatcher)
+		routingKeys = append(routingKeys, memoryMatcher.routingKey())
+	}
+
+	if b.ctx.Err() != nil {
+		return errors.ErrMessageNotConnected
+	}
+
+	handlerName := getHandlerName(workQueueName, routingKeys)
+
+	b.queuesMutex.Lock()
+	defer b.queuesMutex.Unlock()
+
+	// Handlers without work queue get a queue of their own, the key is not used for lookups
+	queueKey := workQueueName
+	if queueKey == "" {
+		queueKey = uuid.New().String()
+	}
+	queue, ok := b.queues[queueKey]
+	if !ok {
+		queue = &memoryQueue{
+			name:   handlerName,
+			notify: make(chan struct{}, 1),
+		}
+		b.queues[queueKey] = queue
+		go b.consume(ctx, queue)
+	}
+	queue.bind(handler, memoryMatchers...)
+
+	return nil
+}
+
+// Matcher returns a new EventMatcher for the in-memory bus
+func (b *memoryEventBus) Matcher() evs.EventMatcher {
+	matcher := &memoryMatcher{}
+	return matcher.Any()
+}
+
+// Close stops delivering events to the handlers.
+func (b *memoryEventBus) Close() error {
+	b.log.Info("Shutting down...")
+	b.cancel()
+	b.log.Info("Shutdown complete.")
+	return nil
+}
+
+// consume hands the events of the queue one after another to its handlers until the bus is closed
+func (b *memoryEventBus) consume(ctx context.Context, queue *memoryQueue) {
+	for {
+		select {
+		case <-b.ctx.Done():
+			return
+		case <-queue.notify:
+		}
+
+		for {
+			event, handler, ok := queue.pop()
+			if !ok {
+				break
+			}
+			b.handleEvent(ctx, queue.name, handler, event)
+			if b.ctx.Err() != nil {
+				return
+			}
+		}
+	}
+}
+
+// handleEvent hands the event to the handler, retries it on failure and dead-letters it if the handler keeps failing.
+func (b *memoryEventBus) handleEvent(ctx context.Context, handlerName string, handler evs.EventHandler, event evs.Event) {
+	// Handlers only handle the latest version of event types
+	upcasted, err := evs.DefaultUpcasterRegistry.Upcast(event)
+	if err != nil {
+		b.log.Error(err, "Failed to upcast event.", "eventType", event.EventType())
+		b.deadLetter(handlerName, handler, event, 0, err)
+		return
+	}
+
+	retries := 0
+	err = backoff.RetryNotify(func() error {
+		return handler.HandleEvent(ctx, upcasted)
+	}, b.newRetryBackOff(), func(err error, next time.Duration) {
+		retries++
+		metrics.RetriedTotalCounter.WithLabelValues(b.name, event.EventType().String(), event.AggregateType().String()).Inc()
+		b.log.Info("Handling event failed, retrying...", "event", event.String(), "handler", handlerName, "error", err.Error(), "backoff", next)
+	})
+	if err != nil {
+		b.log.Error(err, "Handling event failed.", "event", event.String(), "handler", handlerName, "retries", retries)
+		if b.ctx.Err() != nil {
+			return
+		}
+		b.deadLetter(handlerName, handler, event, retries, err)
+	}
+}
+
+// newRetryBackOff creates the backoff for retrying to handle an event
+func (b *memoryEventBus) newRetryBackOff() backoff.BackOff {
+	params := backoff.NewExponentialBackOff()
+	params.InitialInterval = b.retryInterval
+	params.MaxElapsedTime = 0 // retries are limited by count
+	params.Reset()
+	return backoff.WithContext(backoff.WithMaxRetries(params, uint64(b.maxRetries)), b.ctx)
+}
+
+// deadLetter quarantines an event the handler failed to handle.
+func (b *memoryEventBus) deadLetter(handlerName string, handler evs.EventHandler, event evs.Event, retries int, cause error) {
+	b.deadLetterMutex.Lock()
+	defer b.deadLetterMutex.Unlock()
+
+	id := uuid.New().String()
+	b.deadLetterHandlers[id] = handler
+	b.deadLetters = append(b.deadLetters, &evs.DeadLetter{
+		ID:        id,
+		Handler:   handlerName,
+		Event:     event,
+		Error:     cause.Error(),
+		Retries:   retries,
+		Timestamp: time.Now().UTC(),
+	})
+
+	metrics.DeadLetteredTotalCounter.WithLabelValues(b.name, event.EventType().String(), event.AggregateType().String()).Inc()
+	b.log.Info("Event has been dead-lettered.", "handler", handlerName, "eventType", event.EventType(), "aggregateType", event.AggregateType())
+}
+
+// GetDeadLetters implements the GetDeadLetters method of the DeadLetterQueue interface.
+func (b *memoryEventBus) GetDeadLetters(ctx context.Context) ([]*evs.DeadLetter, error) {
+	b.deadLetterMutex.Lock()
+	defer b.deadLetterMutex.Unlock()
+
+	deadLetters := make([]*evs.DeadLetter, len(b.deadLetters))
+	copy(deadLetters, b.deadLetters)
+	return deadLetters, nil
+}
+
+// RetryDeadLetter implements the RetryDeadLetter method of the DeadLetterQueue interface.
+func (b *memoryEventBus) RetryDeadLetter(ctx context.Context, id string) error {
+	return b.withDeadLetter(id, func(deadLetter *evs.DeadLetter) error {
+		handler, ok := b.deadLetterHandlers[deadLetter.ID]
+		if !ok {
+			return errors.ErrDeadLetterHandlerNotFound
+		}
+
+		event, err := evs.DefaultUpcasterRegistry.Upcast(deadLetter.Event)
+		if err != nil {
+			return err
+		}
+		if err := handler.HandleEvent(ctx, event); err != nil {
+			return err
+		}
+
+		metrics.DeadLettersRetriedCounter.WithLabelValues(b.name, deadLetter.Event.EventType().String(), deadLetter.Event.AggregateType().String()).Inc()
+		return nil
+	})
+}
+
+// DiscardDeadLetter implements the DiscardDeadLetter method of the DeadLetterQueue interface.
+func (b *memoryEventBus) DiscardDeadLetter(ctx context.Context, id string) error {
+	return b.withDeadLetter(id, func(deadLetter *evs.DeadLetter) error {
+		metrics.DeadLettersDiscardedCounter.WithLabelValues(b.name, deadLetter.Event.EventType().String(), deadLetter.Event.AggregateType().String()).Inc()
+		return nil
+	})
+}
+
+// withDeadLetter looks up the dead letter with the given id and removes it from the queue if f succeeds.
+func (b *memoryEventBus) withDeadLetter(id string, f func(*evs.DeadLetter) error) error {
+	b.deadLetterMutex.Lock()
+	defer b.deadLetterMutex.Unlock()
+
+	for i, deadLetter := range b.deadLetters {
+		if deadLetter.ID != id {
+			continue
+		}
+		if err := f(deadLetter); err != nil {
+			return err
+		}
+		b.deadLetters = append(b.deadLetters[:i], b.deadLetters[i+1:]...)
+		delete(b.deadLetterHandlers, id)
+		return nil
+	}
+	return errors.ErrDeadLetterNotFound
+}
+
+// bind adds the handler and the matchers to the queue
+func (q *memoryQueue) bind(handler evs.EventHandler, matchers ...*memoryMatcher) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.handlers = append(q.handlers, handler)
+	q.matchers = append(q.matchers, matchers...)
+}
+
+// push appends the event to the queue if any of the matchers matches it
+func (q *memoryQueue) push(event evs.Event) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for _, matcher := range q.matchers {
+		if matcher.matches(event) {
+			q.events = append(q.events, event)
+			select {
+			case q.notify <- struct{}{}:
+			default:
+			}
+			return
+		}
+	}
+}
+
+// pop removes the next event from the queue along with the handler whose turn it is
+func (q *memoryQueue) pop() (evs.Event, evs.EventHandler, bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.events) == 0 {
+		return nil, nil, false
+	}
+	event := q.events[0]
+	q.events = q.events[1:]
+	handler := q.handlers[q.next%len(q.handlers)]
+	q.next++
+	return event, handler, true
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messaging
+
+import (
+	"fmt"
+
+	evs "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+)
+
+// memoryMatcher implements the EventMatcher interface for the in-memory bus
+// with the same semantics as the routing keys of rabbitMatcher.
+type memoryMatcher struct {
+	eventType     string
+	aggregateType string
+}
+
+// Any matches any event.
+func (m *memoryMatcher) Any() evs.EventMatcher {
+	m.eventType = "*"
+	m.aggregateType = "*"
+	return m
+}
+
+// MatchEventType matches a specific event type, nil events never match.
+func (m *memoryMatcher) MatchEventType(eventType evs.EventType) evs.EventMatcher {
+	m.eventType = eventType.String()
+	return m
+}
+
+// MatchAggregateType matches a specific aggregate type, nil events never match.
+func (m *memoryMatcher) MatchAggregateType(aggregateType evs.AggregateType) evs.EventMatcher {
+	m.aggregateType = aggregateType.String()
+	return m
+}
+
+// matches returns whether the event would be routed to a queue bound with this matcher
+func (m *memoryMatcher) matches(event evs.Event) bool {
+	if event == nil {
+		return false
+	}
+	return matchesWord(m.aggregateType, event.AggregateType().String()) && matchesWord(m.eventType, event.EventType().String())
+}
+
+// routingKey returns the routing key rabbitmq would bind a queue with for this matcher
+func (m *memoryMatcher) routingKey() string {
+	return fmt.Sprintf("%s.%s", m.aggregateType, m.eventType)
+}
+
+// matchesWord returns whether the word of a routing key matches the pattern, "*" matches any word
+func matchesWord(pattern, word string) bool {
+	return pattern == "*" || pattern == word
+}
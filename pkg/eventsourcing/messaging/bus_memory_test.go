@@ -0,0 +1,107 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	test_eventbus "github.com/finleap-connect/monoskope/internal/test/eventsourcing/eventbus"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// failingHandler fails to handle events until it has been fixed.
+type failingHandler struct {
+	err     error
+	handled chan eventsourcing.Event
+}
+
+func (h *failingHandler) HandleEvent(_ context.Context, event eventsourcing.Event) error {
+	if h.err != nil {
+		return h.err
+	}
+	h.handled <- event
+	return nil
+}
+
+var _ = Describe("Pkg/Eventsourcing/Messaging/BusMemory", func() {
+	ctx := context.Background()
+
+	newBus := func() InMemoryEventBus {
+		bus, err := NewInMemoryEventBus("test", DefaultMaxRetries, time.Millisecond)
+		Expect(err).ToNot(HaveOccurred())
+		return bus
+	}
+
+	test_eventbus.ItBehavesLikeAnEventBus(func() (eventsourcing.EventBusPublisher, eventsourcing.EventBusConsumer) {
+		bus := newBus()
+		return bus, bus
+	})
+
+	It("validates the configuration", func() {
+		_, err := NewInMemoryEventBus("", DefaultMaxRetries, DefaultRetryInterval)
+		Expect(err).To(Equal(esErrors.ErrConfigNameRequired))
+		_, err = NewInMemoryEventBus("test", -1, DefaultRetryInterval)
+		Expect(err).To(Equal(esErrors.ErrConfigMaxRetriesNegative))
+	})
+	It("rejects matchers of other buses", func() {
+		bus := newBus()
+		defer bus.Close()
+		Expect(bus.AddHandler(ctx, &failingHandler{}, &rabbitMatcher{})).To(Equal(esErrors.ErrMatcherMustNotBeNil))
+	})
+	It("dead-letters events after retrying and can retry and discard them", func() {
+		bus := newBus()
+		defer bus.Close()
+
+		errPoison := errors.New("poison event")
+		handler := &failingHandler{err: errPoison, handled: make(chan eventsourcing.Event, 1)}
+		aggregateType := eventsourcing.AggregateType("TestAggregate")
+		Expect(bus.AddHandler(ctx, handler, bus.Matcher().MatchAggregateType(aggregateType))).To(Succeed())
+
+		for i := 0; i < 2; i++ {
+			event := eventsourcing.NewEvent(ctx, "TestEvent:Created", nil, time.Now().UTC(), aggregateType, uuid.New(), 0)
+			Expect(bus.PublishEvent(ctx, event)).To(Succeed())
+		}
+
+		var deadLetters []*eventsourcing.DeadLetter
+		Eventually(func() ([]*eventsourcing.DeadLetter, error) {
+			var err error
+			deadLetters, err = bus.GetDeadLetters(ctx)
+			return deadLetters, err
+		}, 10).Should(HaveLen(2))
+		Expect(deadLetters[0].Error).To(Equal(errPoison.Error()))
+		Expect(deadLetters[0].Retries).To(Equal(DefaultMaxRetries))
+
+		Expect(bus.RetryDeadLetter(ctx, deadLetters[0].ID)).To(MatchError(errPoison))
+		handler.err = nil
+		Expect(bus.RetryDeadLetter(ctx, deadLetters[0].ID)).To(Succeed())
+		Expect(handler.handled).To(Receive())
+		Expect(bus.RetryDeadLetter(ctx, deadLetters[0].ID)).To(Equal(esErrors.ErrDeadLetterNotFound))
+
+		Expect(bus.DiscardDeadLetter(ctx, deadLetters[1].ID)).To(Succeed())
+		Expect(bus.GetDeadLetters(ctx)).To(BeEmpty())
+	})
+	It("stops publishing when closed", func() {
+		bus := newBus()
+		Expect(bus.Close()).To(Succeed())
+		event := eventsourcing.NewEvent(ctx, "TestEvent:Created", nil, time.Now().UTC(), "TestAggregate", uuid.New(), 0)
+		Expect(bus.PublishEvent(ctx, event)).To(Equal(esErrors.ErrMessageNotConnected))
+	})
+})
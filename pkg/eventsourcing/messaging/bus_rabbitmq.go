@@ -18,8 +18,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/cenkalti/backoff"
 	"github.com/finleap-connect/monoskope/internal/telemetry"
 	evs "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
@@ -38,6 +41,12 @@ type rabbitEventBus struct {
 	publisher *rabbitmq.Publisher
 	consumer  *rabbitmq.Consumer
 	returns   <-chan amqp.Return
+
+	// deadLetterPublisher publishes events the handlers failed to handle to the dead letter exchange
+	deadLetterPublisher *rabbitmq.Publisher
+	deadLetterMutex     sync.Mutex
+	handlersMutex       sync.RWMutex
+	handlers            map[string]evs.EventHandler
 }
 
 func newRabbitEventBus(conf *RabbitEventBusConfig) (*rabbitEventBus, error) {
@@ -47,7 +56,8 @@ func newRabbitEventBus(conf *RabbitEventBusConfig) (*rabbitEventBus, error) {
 	}
 
 	return &rabbitEventBus{
-		conf: conf,
+		conf:     conf,
+		handlers: make(map[string]evs.EventHandler),
 	}, nil
 }
 
@@ -89,6 +99,13 @@ func NewRabbitEventBusConsumer(conf *RabbitEventBusConfig) (evs.EventBusConsumer
 	b.consumer = consumer
 	b.log = logger.WithName("consumer").WithValues("name", conf.Name)
 
+	if conf.DeadLetterExchangeName != "" {
+		if err := b.setupDeadLetterQueue(); err != nil {
+			consumer.Disconnect()
+			return nil, err
+		}
+	}
+
 	return b, nil
 }
 
@@ -195,9 +212,14 @@ func (b *rabbitEventBus) addHandler(ctx context.Context, handler evs.EventHandle
 		options = append(options, rabbitmq.WithConsumeOptionsQueueDurable)
 	}
 
+	handlerName := getHandlerName(workQueueName, routingKeys)
+	b.handlersMutex.Lock()
+	b.handlers[handlerName] = handler
+	b.handlersMutex.Unlock()
+
 	err := b.consumer.StartConsuming(
 		func(d amqp.Delivery) bool {
-			return b.handleIncomingMessages(ctx, d, handler)
+			return b.handleIncomingMessages(ctx, d, handlerName, handler)
 		},
 		workQueueName,
 		routingKeys,
@@ -228,6 +250,9 @@ func (b *rabbitEventBus) Close() error {
 	if b.consumer != nil {
 		b.consumer.Disconnect()
 	}
+	if b.deadLetterPublisher != nil {
+		b.deadLetterPublisher.StopPublishing()
+	}
 
 	b.log.Info("Shutdown complete.")
 
@@ -239,8 +264,17 @@ func (b *rabbitEventBus) generateRoutingKey(event evs.Event) string {
 	return fmt.Sprintf("%s.%s.%s", b.conf.RoutingKeyPrefix, event.AggregateType(), event.EventType())
 }
 
-// handleIncomingMessages handles the routing of the received messages and ack/nack based on handler result
-func (b *rabbitEventBus) handleIncomingMessages(ctx context.Context, d amqp.Delivery, handler evs.EventHandler) bool {
+// getHandlerName identifies a handler by its work queue or the routing keys it is bound to
+func getHandlerName(workQueueName string, routingKeys []string) string {
+	if workQueueName != "" {
+		return workQueueName
+	}
+	return strings.Join(routingKeys, ",")
+}
+
+// handleIncomingMessages handles the routing of the received messages and ack/nack based on handler result.
+// Events the handler fails to handle even after retrying are dead-lettered.
+func (b *rabbitEventBus) handleIncomingMessages(ctx context.Context, d amqp.Delivery, handlerName string, handler evs.EventHandler) bool {
 	ctx, span := telemetry.GetSpan(ctx, "RabbitEventBus.handleIncomingMessages")
 	defer span.End()
 
@@ -248,7 +282,7 @@ func (b *rabbitEventBus) handleIncomingMessages(ctx context.Context, d amqp.Deli
 	err := json.Unmarshal(d.Body, re)
 	if err != nil {
 		b.log.Error(err, "Failed to unmarshal event.", "event", d.Body)
-		return false
+		return b.deadLetter(ctx, d, handlerName, nil, 0, err)
 	}
 
 	span.SetAttributes(
@@ -258,15 +292,41 @@ func (b *rabbitEventBus) handleIncomingMessages(ctx context.Context, d amqp.Deli
 		attribute.Int64("AggregateVersion", int64(re.AggregateVersion())),
 	)
 
-	err = handler.HandleEvent(ctx, re)
+	// Handlers only handle the latest version of event types
+	event, err := evs.DefaultUpcasterRegistry.Upcast(re)
 	if err != nil {
-		b.log.Error(err, "Handling event failed.")
-		return false
+		b.log.Error(err, "Failed to upcast event.", "eventType", re.EventType())
+		return b.deadLetter(ctx, d, handlerName, re, 0, err)
+	}
+
+	retries := 0
+	err = backoff.RetryNotify(func() error {
+		return handler.HandleEvent(ctx, event)
+	}, b.newRetryBackOff(ctx), func(err error, next time.Duration) {
+		retries++
+		metrics.RetriedTotalCounter.WithLabelValues(b.conf.Name, re.EventType().String(), re.AggregateType().String()).Inc()
+		b.log.Info("Handling event failed, retrying...", "event", re.String(), "handler", handlerName, "error", err.Error(), "backoff", next)
+	})
+	if err != nil {
+		b.log.Error(err, "Handling event failed.", "event", re.String(), "handler", handlerName, "retries", retries)
+		if ctx.Err() != nil {
+			return false
+		}
+		return b.deadLetter(ctx, d, handlerName, re, retries, err)
 	}
 
 	return true
 }
 
+// newRetryBackOff creates the backoff for retrying to handle an event
+func (b *rabbitEventBus) newRetryBackOff(ctx context.Context) backoff.BackOff {
+	params := backoff.NewExponentialBackOff()
+	params.InitialInterval = b.conf.RetryInterval
+	params.MaxElapsedTime = 0 // retries are limited by count
+	params.Reset()
+	return backoff.WithContext(backoff.WithMaxRetries(params, uint64(b.conf.MaxRetries)), ctx)
+}
+
 // rabbitEvent implements the message body transferred via rabbitmq
 type rabbitMessage struct {
 	EventType        evs.EventType
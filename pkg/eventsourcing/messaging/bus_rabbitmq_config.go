@@ -15,6 +15,9 @@
 package messaging
 
 import (
+	"fmt"
+	"time"
+
 	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 
 	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
@@ -28,12 +31,22 @@ const (
 	TLSKeyPath          = "/etc/eventstore/certs/buscerts/tls.key"
 )
 
+const (
+	DefaultDeadLetterExchangeName = "m8_events_dead_letter" // name of the exchange events are dead-lettered to
+	DefaultMaxRetries             = 3                       // retries of a failing handler before dead-lettering an event
+	DefaultRetryInterval          = 1 * time.Second         // initial interval between retries, increased exponentially
+)
+
 type RabbitEventBusConfig struct {
 	url              string // Connection string, required
 	Name             string // Name of the client, required
 	RoutingKeyPrefix string // Prefix for routing of messages
 	ExchangeName     string // Name of the exchange to initialize/use
 	AMQPConfig       *amqp.Config
+
+	DeadLetterExchangeName string        // Name of the exchange to dead-letter events to, empty to requeue failing events forever
+	MaxRetries             int           // Retries of a failing handler before an event is dead-lettered
+	RetryInterval          time.Duration // Initial interval between retries
 }
 
 // NewRabbitEventBusConfig creates a new RabbitEventBusConfig with defaults.
@@ -48,6 +61,10 @@ func NewRabbitEventBusConfig(name, url, routingKeyPrefix string) (*RabbitEventBu
 		RoutingKeyPrefix: routingKeyPrefix,
 		ExchangeName:     DefaultExchangeName,
 		AMQPConfig:       &amqp.Config{},
+
+		DeadLetterExchangeName: DefaultDeadLetterExchangeName,
+		MaxRetries:             DefaultMaxRetries,
+		RetryInterval:          DefaultRetryInterval,
 	}
 
 	if err := conf.SetURL(url); err != nil {
@@ -76,6 +93,16 @@ func (conf *RabbitEventBusConfig) SetURL(url string) error {
 	return nil
 }
 
+// DeadLetterRoutingKey is the routing key dead letters of the consumer are published with
+func (conf *RabbitEventBusConfig) DeadLetterRoutingKey() string {
+	return conf.Name
+}
+
+// DeadLetterQueueName is the name of the durable queue shared by all replicas of the consumer which events the handlers failed to handle are dead-lettered to
+func (conf *RabbitEventBusConfig) DeadLetterQueueName() string {
+	return fmt.Sprintf("%s.%s", conf.DeadLetterExchangeName, conf.DeadLetterRoutingKey())
+}
+
 // configureTLS adds the configuration for TLS secured connection/auth
 func (conf *RabbitEventBusConfig) configureTLS() error {
 	loader, err := m8tls.NewTLSConfigLoader()
@@ -111,6 +138,9 @@ func (conf *RabbitEventBusConfig) Validate() error {
 	if conf.url == "" {
 		return errors.ErrConfigUrlRequired
 	}
+	if conf.MaxRetries < 0 {
+		return errors.ErrConfigMaxRetriesNegative
+	}
 	return nil
 }
 
@@ -0,0 +1,235 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	evs "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	rabbitmq "github.com/finleap-connect/monoskope/pkg/rabbitmq"
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	headerDeadLetterHandler   = "x-m8-handler"
+	headerDeadLetterError     = "x-m8-error"
+	headerDeadLetterRetries   = "x-m8-retries"
+	headerDeadLetterTimestamp = "x-m8-dead-lettered-at"
+)
+
+// setupDeadLetterQueue declares the dead letter exchange along with the durable queue of the consumer
+func (b *rabbitEventBus) setupDeadLetterQueue() error {
+	publisher, returns, err := rabbitmq.NewPublisher(b.conf.URL(), b.conf.AMQPConfig)
+	if err != nil {
+		return err
+	}
+	b.deadLetterPublisher = publisher
+
+	go func() {
+		for r := range returns {
+			b.log.Info("dead letter returned from server", "body", string(r.Body))
+		}
+	}()
+
+	err = b.consumer.DeclareQueue(
+		b.conf.DeadLetterQueueName(),
+		[]string{b.conf.DeadLetterRoutingKey()},
+		rabbitmq.WithConsumeOptionsBindingExchangeName(b.conf.DeadLetterExchangeName),
+		rabbitmq.WithConsumeOptionsBindingExchangeKind(amqp.ExchangeDirect),
+		rabbitmq.WithConsumeOptionsBindingExchangeDurable,
+		rabbitmq.WithConsumeOptionsQueueDurable,
+	)
+	if err != nil {
+		publisher.StopPublishing()
+		return err
+	}
+	return nil
+}
+
+// deadLetter publishes a message the handler failed to handle to the dead letter queue of the consumer.
+// It returns whether the message can be acknowledged.
+func (b *rabbitEventBus) deadLetter(ctx context.Context, d amqp.Delivery, handlerName string, event evs.Event, retries int, cause error) bool {
+	if b.deadLetterPublisher == nil {
+		return false
+	}
+
+	err := b.deadLetterPublisher.Publish(
+		ctx,
+		d.Body,
+		[]string{b.conf.DeadLetterRoutingKey()},
+		rabbitmq.WithPublishOptionsExchange(b.conf.DeadLetterExchangeName),
+		rabbitmq.WithPublishOptionsContentType(d.ContentType),
+		rabbitmq.WithPublishOptionsPersistentDelivery,
+		rabbitmq.WithPublishOptionsMessageID(uuid.New().String()),
+		rabbitmq.WithPublishOptionsHeaders(amqp.Table{
+			headerDeadLetterHandler:   handlerName,
+			headerDeadLetterError:     cause.Error(),
+			headerDeadLetterRetries:   int32(retries),
+			headerDeadLetterTimestamp: time.Now().UTC(),
+		}),
+	)
+	if err != nil {
+		b.log.Error(err, "Dead-lettering event failed.", "handler", handlerName)
+		return false
+	}
+
+	eventType, aggregateType := "", ""
+	if event != nil {
+		eventType, aggregateType = event.EventType().String(), event.AggregateType().String()
+	}
+	metrics.DeadLetteredTotalCounter.WithLabelValues(b.conf.Name, eventType, aggregateType).Inc()
+	b.log.Info("Event has been dead-lettered.", "handler", handlerName, "eventType", eventType, "aggregateType", aggregateType)
+
+	return true
+}
+
+// GetDeadLetters implements the GetDeadLetters method of the DeadLetterQueue interface.
+func (b *rabbitEventBus) GetDeadLetters(ctx context.Context) ([]*evs.DeadLetter, error) {
+	var deadLetters []*evs.DeadLetter
+	err := b.browseDeadLetters(func(d amqp.Delivery) bool {
+		deadLetters = append(deadLetters, newDeadLetter(d))
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deadLetters, nil
+}
+
+// RetryDeadLetter implements the RetryDeadLetter method of the DeadLetterQueue interface.
+func (b *rabbitEventBus) RetryDeadLetter(ctx context.Context, id string) error {
+	return b.withDeadLetter(id, func(deadLetter *evs.DeadLetter) error {
+		if deadLetter.Event == nil {
+			return errors.ErrInvalidEventData
+		}
+
+		b.handlersMutex.RLock()
+		handler, ok := b.handlers[deadLetter.Handler]
+		b.handlersMutex.RUnlock()
+		if !ok {
+			return errors.ErrDeadLetterHandlerNotFound
+		}
+
+		event, err := evs.DefaultUpcasterRegistry.Upcast(deadLetter.Event)
+		if err != nil {
+			return err
+		}
+		if err := handler.HandleEvent(ctx, event); err != nil {
+			return err
+		}
+
+		metrics.DeadLettersRetriedCounter.WithLabelValues(b.conf.Name, deadLetter.Event.EventType().String(), deadLetter.Event.AggregateType().String()).Inc()
+		return nil
+	})
+}
+
+// DiscardDeadLetter implements the DiscardDeadLetter method of the DeadLetterQueue interface.
+func (b *rabbitEventBus) DiscardDeadLetter(ctx context.Context, id string) error {
+	return b.withDeadLetter(id, func(deadLetter *evs.DeadLetter) error {
+		eventType, aggregateType := "", ""
+		if deadLetter.Event != nil {
+			eventType, aggregateType = deadLetter.Event.EventType().String(), deadLetter.Event.AggregateType().String()
+		}
+		metrics.DeadLettersDiscardedCounter.WithLabelValues(b.conf.Name, eventType, aggregateType).Inc()
+		return nil
+	})
+}
+
+// withDeadLetter looks up the dead letter with the given id and removes it from the queue
+// if f succeeds, otherwise it is returned to the queue.
+func (b *rabbitEventBus) withDeadLetter(id string, f func(*evs.DeadLetter) error) error {
+	var found *amqp.Delivery
+	err := b.browseDeadLetters(func(d amqp.Delivery) bool {
+		if d.MessageId == id {
+			found = &d
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if found == nil {
+		return errors.ErrDeadLetterNotFound
+	}
+
+	if err := f(newDeadLetter(*found)); err != nil {
+		if nackErr := found.Nack(false, true); nackErr != nil {
+			b.log.Error(nackErr, "can't return dead letter to queue", "id", id)
+		}
+		return err
+	}
+	return found.Ack(false)
+}
+
+// browseDeadLetters fetches the dead letters one by one until visit returns true or the queue is empty.
+// All dead letters except the one visit returned true for are returned to the queue afterwards.
+func (b *rabbitEventBus) browseDeadLetters(visit func(amqp.Delivery) bool) error {
+	if b.deadLetterPublisher == nil {
+		return errors.ErrDeadLetterQueueNotConfigured
+	}
+
+	b.deadLetterMutex.Lock()
+	defer b.deadLetterMutex.Unlock()
+
+	var fetched []amqp.Delivery
+	defer func() {
+		for _, d := range fetched {
+			if err := d.Nack(false, true); err != nil {
+				b.log.Error(err, "can't return dead letter to queue", "id", d.MessageId)
+			}
+		}
+	}()
+
+	for {
+		d, ok, err := b.consumer.Get(b.conf.DeadLetterQueueName())
+		if err != nil {
+			return err
+		}
+		if !ok || visit(d) {
+			return nil
+		}
+		fetched = append(fetched, d)
+	}
+}
+
+// newDeadLetter converts a message of the dead letter queue to evs.DeadLetter
+func newDeadLetter(d amqp.Delivery) *evs.DeadLetter {
+	deadLetter := &evs.DeadLetter{
+		ID: d.MessageId,
+	}
+	if v, ok := d.Headers[headerDeadLetterHandler].(string); ok {
+		deadLetter.Handler = v
+	}
+	if v, ok := d.Headers[headerDeadLetterError].(string); ok {
+		deadLetter.Error = v
+	}
+	if v, ok := d.Headers[headerDeadLetterRetries].(int32); ok {
+		deadLetter.Retries = int(v)
+	}
+	if v, ok := d.Headers[headerDeadLetterTimestamp].(time.Time); ok {
+		deadLetter.Timestamp = v
+	}
+
+	re := &rabbitEvent{}
+	if err := json.Unmarshal(d.Body, re); err == nil {
+		deadLetter.Event = re
+	}
+	return deadLetter
+}
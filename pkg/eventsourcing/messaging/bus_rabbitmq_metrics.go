@@ -0,0 +1,74 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messaging
+
+import (
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// eventBusMetrics represents a collection of metrics registered on the default
+// Prometheus metrics registry for consumers of the event bus.
+type eventBusMetrics struct {
+	RetriedTotalCounter         *prom.CounterVec
+	DeadLetteredTotalCounter    *prom.CounterVec
+	DeadLettersRetriedCounter   *prom.CounterVec
+	DeadLettersDiscardedCounter *prom.CounterVec
+}
+
+var metrics = newEventBusMetrics()
+
+// newEventBusMetrics creates and registers the metrics of the event bus.
+func newEventBusMetrics() *eventBusMetrics {
+	labels := []string{"consumer", "event_type", "aggregate_type"}
+
+	return &eventBusMetrics{
+		RetriedTotalCounter: registerCounterVec(prom.NewCounterVec(
+			prom.CounterOpts{
+				Name: "eventbus_retried_total",
+				Help: "Total number of retries of events a handler failed to handle.",
+			}, labels,
+		)),
+		DeadLetteredTotalCounter: registerCounterVec(prom.NewCounterVec(
+			prom.CounterOpts{
+				Name: "eventbus_dead_lettered_total",
+				Help: "Total number of events dead-lettered since a handler failed to handle them even after retrying.",
+			}, labels,
+		)),
+		DeadLettersRetriedCounter: registerCounterVec(prom.NewCounterVec(
+			prom.CounterOpts{
+				Name: "eventbus_dead_letters_retried_total",
+				Help: "Total number of dead-lettered events handled successfully after retrying them manually.",
+			}, labels,
+		)),
+		DeadLettersDiscardedCounter: registerCounterVec(prom.NewCounterVec(
+			prom.CounterOpts{
+				Name: "eventbus_dead_letters_discarded_total",
+				Help: "Total number of dead-lettered events discarded manually.",
+			}, labels,
+		)),
+	}
+}
+
+// registerCounterVec registers the counter with prometheus default registerer
+// or returns the one already registered.
+func registerCounterVec(c *prom.CounterVec) *prom.CounterVec {
+	if err := prom.Register(c); err != nil {
+		if are, ok := err.(prom.AlreadyRegisteredError); ok {
+			return are.ExistingCollector.(*prom.CounterVec)
+		}
+		panic(err)
+	}
+	return c
+}
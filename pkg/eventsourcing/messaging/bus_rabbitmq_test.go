@@ -16,24 +16,44 @@ package messaging
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	mock_eventsourcing "github.com/finleap-connect/monoskope/internal/test/eventsourcing"
+	test_eventbus "github.com/finleap-connect/monoskope/internal/test/eventsourcing/eventbus"
 	testEd "github.com/finleap-connect/monoskope/pkg/api/eventsourcing/eventdata"
 	"github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
 
+var _ = Describe("Pkg/Eventsourcing/Messaging/BusRabbmitMQ conformance", func() {
+	testCount := 0
+
+	test_eventbus.ItBehavesLikeAnEventBus(func() (eventsourcing.EventBusPublisher, eventsourcing.EventBusConsumer) {
+		conf, err := NewRabbitEventBusConfig(fmt.Sprintf("conformance-%v", testCount), env.AmqpURL, "")
+		Expect(err).ToNot(HaveOccurred())
+		testCount++
+
+		publisher, err := NewRabbitEventBusPublisher(conf)
+		Expect(err).ToNot(HaveOccurred())
+		consumer, err := NewRabbitEventBusConsumer(conf)
+		Expect(err).ToNot(HaveOccurred())
+		return publisher, consumer
+	})
+})
+
 var _ = Describe("Pkg/Eventsourcing/Messaging/BusRabbmitMQ", func() {
 	ctx := context.Background()
 	testCount := 0
 	eventCounter := 0
 	expectedEventType := eventsourcing.EventType("TestEventXYZ")
 	expectedAggregateType := eventsourcing.AggregateType("TestAggregateXYZ")
+	var conf *RabbitEventBusConfig
 	var consumer eventsourcing.EventBusConsumer
 	var publisher eventsourcing.EventBusPublisher
 	var eventHandler *mock_eventsourcing.MockEventHandler
@@ -67,8 +87,9 @@ var _ = Describe("Pkg/Eventsourcing/Messaging/BusRabbmitMQ", func() {
 	BeforeEach(func() {
 		var err error
 
-		conf, err := NewRabbitEventBusConfig(fmt.Sprintf("test-%v", testCount), env.AmqpURL, "")
+		conf, err = NewRabbitEventBusConfig(fmt.Sprintf("test-%v", testCount), env.AmqpURL, "")
 		Expect(err).ToNot(HaveOccurred())
+		conf.RetryInterval = 10 * time.Millisecond
 
 		// init publisher
 		publisher, err = NewRabbitEventBusPublisher(conf)
@@ -161,5 +182,91 @@ var _ = Describe("Pkg/Eventsourcing/Messaging/BusRabbmitMQ", func() {
 				Eventually(doneB, 30).Should(BeClosed())
 			})
 		})
+		When("handler keeps failing", func() {
+			var queue eventsourcing.DeadLetterQueue
+			errPoison := errors.New("poison event")
+
+			publishPoisonEvent := func() (eventsourcing.Event, *eventsourcing.DeadLetter) {
+				var ok bool
+				queue, ok = consumer.(eventsourcing.DeadLetterQueue)
+				Expect(ok).To(BeTrue())
+
+				err := consumer.AddHandler(ctx, eventHandler, consumer.Matcher().MatchAggregateType(expectedAggregateType))
+				Expect(err).ToNot(HaveOccurred())
+
+				event := createEvent()
+				eventHandler.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).Return(errPoison).Times(DefaultMaxRetries + 1)
+				err = publisher.PublishEvent(ctx, event)
+				Expect(err).ToNot(HaveOccurred())
+
+				var deadLetters []*eventsourcing.DeadLetter
+				Eventually(func() ([]*eventsourcing.DeadLetter, error) {
+					deadLetters, err = queue.GetDeadLetters(ctx)
+					return deadLetters, err
+				}, 30).Should(HaveLen(1))
+				return event, deadLetters[0]
+			}
+
+			It("dead-letters the event after retrying", func() {
+				event, deadLetter := publishPoisonEvent()
+				Expect(deadLetter.ID).ToNot(BeEmpty())
+				Expect(deadLetter.Handler).ToNot(BeEmpty())
+				Expect(deadLetter.Error).To(Equal(errPoison.Error()))
+				Expect(deadLetter.Retries).To(Equal(DefaultMaxRetries))
+				Expect(deadLetter.Timestamp).ToNot(BeZero())
+				Expect(deadLetter.Event.AggregateID()).To(Equal(event.AggregateID()))
+
+				// inspecting does not remove dead letters
+				deadLetters, err := queue.GetDeadLetters(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deadLetters).To(HaveLen(1))
+			})
+			It("can retry dead letters", func() {
+				_, deadLetter := publishPoisonEvent()
+
+				eventHandler.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).Return(errPoison)
+				Expect(queue.RetryDeadLetter(ctx, deadLetter.ID)).To(MatchError(errPoison))
+				deadLetters, err := queue.GetDeadLetters(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deadLetters).To(HaveLen(1))
+
+				done := make(chan interface{})
+				eventHandler.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).Do(func(_ context.Context, e eventsourcing.Event) { handleEvent(done, e) }).Return(nil)
+				Expect(queue.RetryDeadLetter(ctx, deadLetter.ID)).To(Succeed())
+				Eventually(done, 30).Should(BeClosed())
+
+				deadLetters, err = queue.GetDeadLetters(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deadLetters).To(BeEmpty())
+			})
+			It("shares dead letters between replicas", func() {
+				_, deadLetter := publishPoisonEvent()
+
+				otherConsumer, err := NewRabbitEventBusConsumer(conf)
+				Expect(err).ToNot(HaveOccurred())
+				defer otherConsumer.Close()
+				otherQueue := otherConsumer.(eventsourcing.DeadLetterQueue)
+
+				deadLetters, err := otherQueue.GetDeadLetters(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deadLetters).To(HaveLen(1))
+				Expect(deadLetters[0].ID).To(Equal(deadLetter.ID))
+
+				Expect(otherQueue.DiscardDeadLetter(ctx, deadLetter.ID)).To(Succeed())
+				deadLetters, err = queue.GetDeadLetters(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deadLetters).To(BeEmpty())
+			})
+			It("can discard dead letters", func() {
+				_, deadLetter := publishPoisonEvent()
+
+				Expect(queue.DiscardDeadLetter(ctx, deadLetter.ID)).To(Succeed())
+				Expect(queue.DiscardDeadLetter(ctx, deadLetter.ID)).To(MatchError(esErrors.ErrDeadLetterNotFound))
+
+				deadLetters, err := queue.GetDeadLetters(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deadLetters).To(BeEmpty())
+			})
+		})
 	})
 })
@@ -31,7 +31,7 @@ type inMemoryRepository[T es.Projection] struct {
 }
 
 // NewInMemoryRepository creates a new repository which stores projections in memory.
-func NewInMemoryRepository[T es.Projection]() es.Repository[T] {
+func NewInMemoryRepository[T es.Projection]() es.SwappableRepository[T] {
 	return &inMemoryRepository[T]{
 		store: make(map[uuid.UUID]T),
 	}
@@ -71,6 +71,28 @@ func (r *inMemoryRepository[T]) Upsert(ctx context.Context, p T) error {
 	return nil
 }
 
+// Swap replaces all projections atomically with the projections of the given repository.
+func (r *inMemoryRepository[T]) Swap(ctx context.Context, other es.Repository[T]) error {
+	projections, err := other.All(ctx)
+	if err != nil {
+		return err
+	}
+
+	store := make(map[uuid.UUID]T, len(projections))
+	for _, p := range projections {
+		store[p.ID()] = p
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.store = store
+	for _, p := range projections {
+		r.notifyAll(ctx, p)
+	}
+	return nil
+}
+
 func (r *inMemoryRepository[T]) RegisterObserver(o es.RepositoryObserver[T]) {
 	r.observer = append(r.observer, o)
 }
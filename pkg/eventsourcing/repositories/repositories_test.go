@@ -15,63 +15,13 @@
 package repositories
 
 import (
-	"context"
-
+	test_repositories "github.com/finleap-connect/monoskope/internal/test/eventsourcing/repositories"
 	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
-	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
-	. "github.com/onsi/gomega"
 )
 
-type testObserver struct {
-	stack []*testProjection
-}
-
-func (t *testObserver) expect(p *testProjection) {
-	t.stack = append(t.stack, p)
-}
-
-func (t *testObserver) Notify(_ context.Context, p *testProjection) {
-	if len(t.stack) < 1 {
-		panic("stack empty")
-	}
-
-	n := len(t.stack) - 1
-	expected := t.stack[n]
-	t.stack = t.stack[:n] // Pop
-
-	if expected != p {
-		panic("unexpected")
-	}
-}
-
 var _ = Describe("repositories/in_memory", func() {
-	tp := newTestProjection(uuid.New())
-	testReadWrite := func(repo es.Repository[*testProjection]) {
-		to := new(testObserver)
-		repo.RegisterObserver(to)
-
-		to.expect(tp)
-		err := repo.Upsert(context.Background(), tp)
-		Expect(err).NotTo(HaveOccurred())
-
-		repo.DeregisterObserver(to)
-		err = repo.Upsert(context.Background(), tp)
-		Expect(err).NotTo(HaveOccurred())
-
-		projection, err := repo.ById(context.Background(), tp.ID())
-		Expect(err).NotTo(HaveOccurred())
-		Expect(projection).To(Equal(tp))
-
-		projections, err := repo.All(context.Background())
-		Expect(err).NotTo(HaveOccurred())
-		Expect(projections).ToNot(BeNil())
-		Expect(len(projections)).To(BeNumerically("==", 1))
-		Expect(projections[0]).To(Equal(tp))
-	}
-
-	It("can read/write projections", func() {
-		testReadWrite(NewInMemoryRepository[*testProjection]())
+	test_repositories.ItBehavesLikeARepository(func() es.SwappableRepository[*test_repositories.TestProjection] {
+		return NewInMemoryRepository[*test_repositories.TestProjection]()
 	})
-
 })
@@ -16,6 +16,7 @@ package eventsourcing
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -43,3 +44,31 @@ type Repository[T Projection] interface {
 	// DeregisterObserver unregisters the given observer with the registry
 	DeregisterObserver(RepositoryObserver[T])
 }
+
+// SwappableRepository is a Repository whose projections can be replaced at once, e.g. by freshly rebuilt ones.
+type SwappableRepository[T Projection] interface {
+	Repository[T]
+
+	// Swap replaces all projections of the repository atomically with the projections of the given repository.
+	Swap(context.Context, Repository[T]) error
+}
+
+// DurableRepository is a Repository which keeps projections across restarts.
+type DurableRepository[T Projection] interface {
+	SwappableRepository[T]
+
+	// Checkpoint returns the timestamp of the latest event projected into the repository, zero if it is empty.
+	Checkpoint(context.Context) (time.Time, error)
+}
+
+// ProjectionCodec converts projections to bytes and back to store them outside of memory.
+type ProjectionCodec[T Projection] interface {
+	// Marshal returns the binary representation of the projection.
+	Marshal(T) ([]byte, error)
+
+	// Unmarshal restores the projection with the given id and version from its binary representation.
+	Unmarshal(id uuid.UUID, version uint64, data []byte) (T, error)
+
+	// Checkpoint returns the timestamp of the latest event projected into the projection.
+	Checkpoint(T) time.Time
+}
@@ -0,0 +1,237 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/go-pg/pg/extra/pgotel/v10"
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/google/uuid"
+)
+
+// postgresRepository implements a DurableRepository for PostgreSQL.
+type postgresRepository[T es.Projection] struct {
+	db             *pg.DB
+	projectionType string
+	codec          es.ProjectionCodec[T]
+	observer       []es.RepositoryObserver[T]
+	mutex          sync.RWMutex
+}
+
+// projectionRecord is the model for entries in the projections table in the database.
+type projectionRecord struct {
+	tableName struct{} `pg:"projections"`
+
+	ProjectionType string    `pg:"projection_type,type:varchar(250),pk"`
+	ID             uuid.UUID `pg:"id,type:uuid,pk"`
+	Version        uint64    `pg:"version,use_zero"`
+	Checkpoint     time.Time `pg:"checkpoint"`
+	Data           []byte    `pg:"data,type:bytea"`
+}
+
+// NewPostgresDB connects to the PostgreSQL database at the given url.
+func NewPostgresDB(ctx context.Context, url string) (*pg.DB, error) {
+	options, err := pg.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	if options.Database == "" {
+		return nil, ErrConfigDbNameRequired
+	}
+
+	db := pg.Connect(options)
+	db.AddQueryHook(pgotel.NewTracingHook())
+	if err := db.Ping(ctx); err != nil {
+		_ = db.Close()
+		return nil, errors.ErrCouldNotConnect
+	}
+	return db, nil
+}
+
+// NewPostgresRepository creates a repository which stores projections of the given type in the projections table of the database.
+func NewPostgresRepository[T es.Projection](ctx context.Context, db *pg.DB, projectionType string, codec es.ProjectionCodec[T]) (es.DurableRepository[T], error) {
+	// Just to silence linter
+	_ = projectionRecord{}.tableName
+
+	err := db.ModelContext(ctx, (*projectionRecord)(nil)).CreateTable(&orm.CreateTableOptions{
+		IfNotExists: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &postgresRepository[T]{
+		db:             db,
+		projectionType: projectionType,
+		codec:          codec,
+	}, nil
+}
+
+// ById returns a projection for an ID.
+func (r *postgresRepository[T]) ById(ctx context.Context, id uuid.UUID) (T, error) {
+	var result T
+
+	record := new(projectionRecord)
+	err := r.db.ModelContext(ctx, record).
+		Where("projection_type = ?", r.projectionType).
+		Where("id = ?", id).
+		Select()
+	if err == pg.ErrNoRows {
+		return result, errors.ErrProjectionNotFound
+	}
+	if err != nil {
+		return result, err
+	}
+
+	return r.codec.Unmarshal(record.ID, record.Version, record.Data)
+}
+
+// All returns all projections in the repository.
+func (r *postgresRepository[T]) All(ctx context.Context) ([]T, error) {
+	var records []projectionRecord
+	err := r.db.ModelContext(ctx, &records).
+		Where("projection_type = ?", r.projectionType).
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	all := make([]T, 0, len(records))
+	for _, record := range records {
+		p, err := r.codec.Unmarshal(record.ID, record.Version, record.Data)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, p)
+	}
+	return all, nil
+}
+
+// Upsert saves a projection in the storage or replaces an existing one.
+// Projections are not replaced by older versions of themselves, e.g. written by another replica concurrently.
+func (r *postgresRepository[T]) Upsert(ctx context.Context, p T) error {
+	record, err := r.newProjectionRecord(p)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ModelContext(ctx, record).
+		OnConflict("(projection_type, id) DO UPDATE").
+		Set("version = EXCLUDED.version, checkpoint = EXCLUDED.checkpoint, data = EXCLUDED.data").
+		Where("?TableAlias.version <= EXCLUDED.version").
+		Returning("NULL").
+		Insert()
+	if err != nil {
+		return err
+	}
+
+	r.notifyAll(ctx, p)
+	return nil
+}
+
+// Swap replaces all projections atomically with the projections of the given repository.
+func (r *postgresRepository[T]) Swap(ctx context.Context, other es.Repository[T]) error {
+	projections, err := other.All(ctx)
+	if err != nil {
+		return err
+	}
+
+	records := make([]*projectionRecord, 0, len(projections))
+	for _, p := range projections {
+		record, err := r.newProjectionRecord(p)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+
+	err = r.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		if _, err := tx.ModelContext(ctx, (*projectionRecord)(nil)).Where("projection_type = ?", r.projectionType).Delete(); err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		_, err := tx.ModelContext(ctx, &records).Returning("NULL").Insert()
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range projections {
+		r.notifyAll(ctx, p)
+	}
+	return nil
+}
+
+// Checkpoint returns the timestamp of the latest event projected into the repository.
+func (r *postgresRepository[T]) Checkpoint(ctx context.Context) (time.Time, error) {
+	var checkpoint pg.NullTime
+	err := r.db.ModelContext(ctx, (*projectionRecord)(nil)).
+		ColumnExpr("max(checkpoint)").
+		Where("projection_type = ?", r.projectionType).
+		Select(pg.Scan(&checkpoint))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return checkpoint.UTC(), nil
+}
+
+func (r *postgresRepository[T]) RegisterObserver(o es.RepositoryObserver[T]) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.observer = append(r.observer, o)
+}
+
+func (r *postgresRepository[T]) DeregisterObserver(o es.RepositoryObserver[T]) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for i, observer := range r.observer {
+		if observer == o {
+			r.observer = append(r.observer[:i], r.observer[i+1:]...)
+			return
+		}
+	}
+}
+
+func (r *postgresRepository[T]) notifyAll(ctx context.Context, p T) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	for _, observer := range r.observer {
+		observer.Notify(ctx, p)
+	}
+}
+
+// newProjectionRecord returns a new projectionRecord for a projection.
+func (r *postgresRepository[T]) newProjectionRecord(p T) (*projectionRecord, error) {
+	data, err := r.codec.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return &projectionRecord{
+		ProjectionType: r.projectionType,
+		ID:             p.ID(),
+		Version:        p.Version(),
+		Checkpoint:     r.codec.Checkpoint(p).UTC(),
+		Data:           data,
+	}, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+
+	test_repositories "github.com/finleap-connect/monoskope/internal/test/eventsourcing/repositories"
+	es "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("storage/postgresRepository", func() {
+	var (
+		db             *pg.DB
+		projectionType string
+	)
+
+	BeforeEach(func() {
+		db = pg.Connect(env.postgresStoreConfig.pgOptions)
+	})
+
+	AfterEach(func() {
+		Expect(db.Close()).To(Succeed())
+	})
+
+	openRepository := func() es.DurableRepository[*test_repositories.TestProjection] {
+		repo, err := NewPostgresRepository[*test_repositories.TestProjection](context.Background(), db, projectionType, test_repositories.TestProjectionCodec{})
+		Expect(err).NotTo(HaveOccurred())
+		return repo
+	}
+
+	newRepository := func() es.DurableRepository[*test_repositories.TestProjection] {
+		// Every repository gets its own projection type to start empty
+		projectionType = uuid.New().String()
+		return openRepository()
+	}
+
+	test_repositories.ItBehavesLikeARepository(func() es.SwappableRepository[*test_repositories.TestProjection] {
+		return newRepository()
+	})
+	test_repositories.ItBehavesLikeADurableRepository(newRepository, openRepository)
+})
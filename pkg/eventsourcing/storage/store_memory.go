@@ -0,0 +1,299 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/finleap-connect/monoskope/internal/gateway/auth"
+	evs "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// memoryEventStore implements an EventStore keeping all events in memory.
+// It is meant for development and tests and loses all events when the process exits.
+type memoryEventStore struct {
+	log         logger.Logger
+	mutex       sync.RWMutex
+	events      []evs.Event
+	versions    map[memoryAggregateKey]map[uint64]struct{}
+	isConnected bool
+}
+
+// memoryAggregateKey identifies an aggregate within the memoryEventStore.
+type memoryAggregateKey struct {
+	aggregateType evs.AggregateType
+	aggregateID   uuid.UUID
+}
+
+// NewInMemoryEventStore creates a new EventStore keeping all events in memory.
+func NewInMemoryEventStore() evs.EventStore {
+	return &memoryEventStore{
+		log:      logger.WithName("memory-store"),
+		versions: make(map[memoryAggregateKey]map[uint64]struct{}),
+	}
+}
+
+// Open implements the Open method of the EventStore interface.
+func (s *memoryEventStore) Open(ctx context.Context) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.isConnected = true
+	return nil
+}
+
+// Save implements the Save method of the EventStore interface.
+func (s *memoryEventStore) Save(ctx context.Context, events []evs.Event) error {
+	if len(events) == 0 {
+		return errors.ErrNoEventsToAppend
+	}
+
+	storedEvents, err := newMemoryEvents(events)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.append(storedEvents)
+}
+
+// SaveAll implements the SaveAll method of the BatchEventStore interface.
+func (s *memoryEventStore) SaveAll(ctx context.Context, events []evs.Event) error {
+	if len(events) == 0 {
+		return errors.ErrNoEventsToAppend
+	}
+
+	groups := evs.GroupEventsByAggregate(events)
+	storedGroups := make([][]evs.Event, len(groups))
+	for i, group := range groups {
+		storedEvents, err := newMemoryEvents(group)
+		if err != nil {
+			return err
+		}
+		storedGroups[i] = storedEvents
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.append(storedGroups...)
+}
+
+// newMemoryEvents validates incoming events of a single aggregate the same way the postgres store does and copies them.
+func newMemoryEvents(events []evs.Event) ([]evs.Event, error) {
+	storedEvents := make([]evs.Event, len(events))
+	aggregateID := events[0].AggregateID()
+	aggregateType := events[0].AggregateType()
+	nextVersion := events[0].AggregateVersion()
+	for i, event := range events {
+		// Only accept events belonging to the same aggregate.
+		if event.AggregateID() != aggregateID || event.AggregateType() != aggregateType {
+			return nil, errors.ErrInvalidAggregateType
+		}
+
+		// Only accept events that apply to the correct aggregate version.
+		if event.AggregateVersion() != nextVersion {
+			return nil, errors.ErrIncorrectAggregateVersion
+		}
+
+		storedEvents[i] = newMemoryEvent(event)
+		nextVersion++
+	}
+	return storedEvents, nil
+}
+
+// append appends the events of each given aggregate to the store, all of them or none. The mutex must be held.
+func (s *memoryEventStore) append(groups ...[]evs.Event) error {
+	if !s.isConnected {
+		return errors.ErrConnectionClosed
+	}
+
+	// Reject all events if any version has been stored before, like the unique constraint of the postgres store.
+	for _, events := range groups {
+		key := memoryAggregateKey{aggregateType: events[0].AggregateType(), aggregateID: events[0].AggregateID()}
+		for _, event := range events {
+			if _, ok := s.versions[key][event.AggregateVersion()]; ok {
+				s.log.Info(errors.ErrAggregateVersionAlreadyExists.Error(), "aggregateType", key.aggregateType, "aggregateID", key.aggregateID, "aggregateVersion", event.AggregateVersion())
+				return errors.ErrAggregateVersionAlreadyExists
+			}
+		}
+	}
+
+	eventCount := 0
+	for _, events := range groups {
+		key := memoryAggregateKey{aggregateType: events[0].AggregateType(), aggregateID: events[0].AggregateID()}
+		versions, ok := s.versions[key]
+		if !ok {
+			versions = make(map[uint64]struct{})
+			s.versions[key] = versions
+		}
+		for _, event := range events {
+			versions[event.AggregateVersion()] = struct{}{}
+		}
+		s.events = append(s.events, events...)
+		eventCount += len(events)
+	}
+
+	s.log.V(logger.DebugLevel).Info("Saved event(s) successfully", "eventCount", eventCount)
+
+	return nil
+}
+
+// Load implements the Load method of the EventStore interface.
+func (s *memoryEventStore) Load(ctx context.Context, storeQuery *evs.StoreQuery) (evs.EventStreamReceiver, error) {
+	return s.doLoad(ctx, func(event evs.Event) bool {
+		return matchStoreQuery(storeQuery, event)
+	})
+}
+
+// LoadOr implements the LoadOr method of the EventStore interface.
+func (s *memoryEventStore) LoadOr(ctx context.Context, storeQueries []*evs.StoreQuery) (evs.EventStreamReceiver, error) {
+	return s.doLoad(ctx, func(event evs.Event) bool {
+		if len(storeQueries) == 0 {
+			return true
+		}
+		for _, storeQuery := range storeQueries {
+			if matchStoreQuery(storeQuery, event) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (s *memoryEventStore) doLoad(ctx context.Context, match func(evs.Event) bool) (evs.EventStreamReceiver, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if !s.isConnected {
+		return nil, errors.ErrConnectionClosed
+	}
+
+	var events []evs.Event
+	for _, event := range s.events {
+		if match(event) {
+			events = append(events, event)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp().Before(events[j].Timestamp())
+	})
+
+	eventStream := evs.NewEventStream()
+	go func() {
+		defer eventStream.Done()
+		for _, event := range events {
+			if ctx.Err() != nil {
+				eventStream.Error(ctx.Err())
+				return
+			}
+			eventStream.Send(event)
+		}
+	}()
+	return eventStream, nil
+}
+
+// Close implements the Close method of the EventStore interface.
+func (s *memoryEventStore) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.isConnected = false
+	return nil
+}
+
+// matchStoreQuery returns whether the event matches all filters of the query like mapStoreQuery does for postgres
+func matchStoreQuery(storeQuery *evs.StoreQuery, event evs.Event) bool {
+	if storeQuery == nil {
+		return true
+	}
+
+	if storeQuery.AggregateId != nil && event.AggregateID() != *storeQuery.AggregateId {
+		return false
+	}
+	if storeQuery.AggregateType != nil && event.AggregateType() != *storeQuery.AggregateType {
+		return false
+	}
+
+	if storeQuery.MinVersion != nil && event.AggregateVersion() < *storeQuery.MinVersion {
+		return false
+	}
+	if storeQuery.MaxVersion != nil && event.AggregateVersion() > *storeQuery.MaxVersion {
+		return false
+	}
+
+	if storeQuery.MinTimestamp != nil && event.Timestamp().Before(*storeQuery.MinTimestamp) {
+		return false
+	}
+	if storeQuery.MaxTimestamp != nil && event.Timestamp().After(*storeQuery.MaxTimestamp) {
+		return false
+	}
+
+	if storeQuery.EventType != nil && event.EventType() != *storeQuery.EventType {
+		return false
+	}
+	if storeQuery.IssuerId != nil && event.Metadata()[auth.HeaderAuthId] != storeQuery.IssuerId.String() {
+		return false
+	}
+	if storeQuery.TenantId != nil && !references(event, *storeQuery.TenantId, "tenantId", "resource") {
+		return false
+	}
+	if storeQuery.UserId != nil && !references(event, *storeQuery.UserId, "userId") {
+		return false
+	}
+
+	return true
+}
+
+// references returns whether the event belongs to the aggregate with the given id itself
+// or references it in one of the given data fields, e.g. role and cluster bindings
+func references(event evs.Event, id uuid.UUID, fields ...string) bool {
+	if event.AggregateID() == id {
+		return true
+	}
+
+	data := make(map[string]interface{})
+	if err := json.Unmarshal(event.Data(), &data); err != nil {
+		return false
+	}
+	for _, field := range fields {
+		if v, ok := data[field].(string); ok && v == id.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// newMemoryEvent copies the event the way the postgres store would return it.
+func newMemoryEvent(event evs.Event) evs.Event {
+	metadata := make(map[string]string, len(event.Metadata()))
+	for k, v := range event.Metadata() {
+		metadata[k] = v
+	}
+	return evs.NewEventWithMetadata(
+		event.EventType(),
+		append(evs.EventData(nil), event.Data()...),
+		event.Timestamp().UTC().Truncate(time.Microsecond), // precision of the database
+		event.AggregateType(),
+		event.AggregateID(),
+		event.AggregateVersion(),
+		metadata,
+	)
+}
@@ -21,6 +21,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/finleap-connect/monoskope/internal/gateway/auth"
 	"github.com/finleap-connect/monoskope/internal/telemetry"
 	evs "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
@@ -53,6 +54,8 @@ type eventRecord struct {
 	Timestamp        time.Time         `pg:""`
 	Metadata         map[string]string `pg:"metadata,type:jsonb"`
 	RawData          json.RawMessage   `pg:"data,type:jsonb"`
+	Hash             string            `pg:"hash,type:varchar(64)"`
+	PreviousHash     string            `pg:"previous_hash,type:varchar(64)"`
 }
 
 var models []interface{}
@@ -61,12 +64,21 @@ func init() {
 	// Just to silence linter
 	eventsTbl := &eventRecord{}
 	_ = eventsTbl.tableName
+	checkpointsTbl := &checkpointRecord{}
+	_ = checkpointsTbl.tableName
 
 	models = []interface{}{
 		(*eventRecord)(nil),
+		(*checkpointRecord)(nil),
 	}
 }
 
+// migrations are applied after creating the tables to update tables created by previous versions.
+var migrations = []string{
+	"ALTER TABLE events ADD COLUMN IF NOT EXISTS hash varchar(64)",
+	"ALTER TABLE events ADD COLUMN IF NOT EXISTS previous_hash varchar(64)",
+}
+
 // createTables creates the event table in the database.
 func (s *postgresEventStore) createTables(ctx context.Context, db *pg.DB) error {
 	for _, model := range models {
@@ -78,6 +90,12 @@ func (s *postgresEventStore) createTables(ctx context.Context, db *pg.DB) error
 		}
 	}
 
+	for _, migration := range migrations {
+		if _, err := db.ExecContext(ctx, migration); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -89,7 +107,7 @@ func (s *postgresEventStore) newEventRecord(ctx context.Context, event evs.Event
 		AggregateType:    event.AggregateType(),
 		EventType:        event.EventType(),
 		RawData:          json.RawMessage(event.Data()),
-		Timestamp:        event.Timestamp(),
+		Timestamp:        event.Timestamp().UTC().Truncate(time.Microsecond), // precision of the database, required to verify the hash
 		AggregateVersion: event.AggregateVersion(),
 		Metadata:         event.Metadata(),
 	}, nil
@@ -137,6 +155,37 @@ func (s *postgresEventStore) Save(ctx context.Context, events []evs.Event) error
 		return errors.ErrNoEventsToAppend
 	}
 
+	eventRecords, err := s.newEventRecords(ctx, events)
+	if err != nil {
+		return err
+	}
+	return s.insert(eventRecords)
+}
+
+// SaveAll implements the SaveAll method of the BatchEventStore interface.
+func (s *postgresEventStore) SaveAll(ctx context.Context, events []evs.Event) error {
+	ctx, span := telemetry.GetSpan(ctx, "save-all")
+	defer span.End()
+
+	if len(events) == 0 {
+		return errors.ErrNoEventsToAppend
+	}
+
+	var eventRecords []eventRecord
+	for _, aggregateEvents := range evs.GroupEventsByAggregate(events) {
+		records, err := s.newEventRecords(ctx, aggregateEvents)
+		if err != nil {
+			return err
+		}
+		eventRecords = append(eventRecords, records...)
+	}
+
+	// All records are inserted with a single statement, so they are stored all or none.
+	return s.insert(eventRecords)
+}
+
+// newEventRecords validates incoming events of a single aggregate and creates their records chained to the stored events.
+func (s *postgresEventStore) newEventRecords(ctx context.Context, events []evs.Event) ([]eventRecord, error) {
 	// Validate incoming events and create all event records.
 	eventRecords := make([]eventRecord, len(events))
 	aggregateID := events[0].AggregateID()
@@ -145,18 +194,18 @@ func (s *postgresEventStore) Save(ctx context.Context, events []evs.Event) error
 	for i, event := range events {
 		// Only accept events belonging to the same aggregate.
 		if event.AggregateID() != aggregateID || event.AggregateType() != aggregateType {
-			return errors.ErrInvalidAggregateType
+			return nil, errors.ErrInvalidAggregateType
 		}
 
 		// Only accept events that apply to the correct aggregate version.
 		if event.AggregateVersion() != nextVersion {
-			return errors.ErrIncorrectAggregateVersion
+			return nil, errors.ErrIncorrectAggregateVersion
 		}
 
 		// Create the event record for the DB.
 		e, err := s.newEventRecord(ctx, event)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		eventRecords[i] = *e
 
@@ -164,7 +213,30 @@ func (s *postgresEventStore) Save(ctx context.Context, events []evs.Event) error
 		nextVersion++
 	}
 
-	// Append events to the store.
+	if !s.isConnected {
+		return nil, errors.ErrConnectionClosed
+	}
+
+	// Chain the events to the hash of their predecessor.
+	// Concurrent writers of the same version are rejected by the unique constraint on the aggregate version.
+	previousHash, err := s.previousHash(ctx, aggregateType, aggregateID, events[0].AggregateVersion())
+	if err != nil {
+		s.log.Error(err, errors.ErrCouldNotSaveEvents.Error())
+		return nil, errors.ErrCouldNotSaveEvents
+	}
+	for i := range eventRecords {
+		eventRecords[i].PreviousHash = previousHash
+		if eventRecords[i].Hash, err = hashRecord(&eventRecords[i]); err != nil {
+			return nil, err
+		}
+		previousHash = eventRecords[i].Hash
+	}
+
+	return eventRecords, nil
+}
+
+// insert appends the event records to the store.
+func (s *postgresEventStore) insert(eventRecords []eventRecord) error {
 	err := retryWithExponentialBackoff(5, 500*time.Millisecond, func() (e error) {
 		if !s.isConnected {
 			return errors.ErrConnectionClosed
@@ -309,6 +381,31 @@ func mapStoreQuery(storeQuery *evs.StoreQuery, dbQuery *orm.Query) {
 	if storeQuery.MaxTimestamp != nil {
 		_ = dbQuery.Where("timestamp <= ?", storeQuery.MaxTimestamp)
 	}
+
+	if storeQuery.EventType != nil {
+		_ = dbQuery.Where("event_type = ?", storeQuery.EventType)
+	}
+	if storeQuery.IssuerId != nil {
+		_ = dbQuery.Where("metadata->>? = ?", auth.HeaderAuthId, storeQuery.IssuerId.String())
+	}
+	if storeQuery.TenantId != nil {
+		// events of the tenant itself or of other aggregates referencing the tenant, e.g. role and cluster bindings
+		tenantId := storeQuery.TenantId.String()
+		_ = dbQuery.WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			return q.
+				WhereOr("aggregate_id = ?", storeQuery.TenantId).
+				WhereOr("data->>'tenantId' = ?", tenantId).
+				WhereOr("data->>'resource' = ?", tenantId), nil
+		})
+	}
+	if storeQuery.UserId != nil {
+		// events of the user itself or of other aggregates referencing the user, e.g. role bindings
+		_ = dbQuery.WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			return q.
+				WhereOr("aggregate_id = ?", storeQuery.UserId).
+				WhereOr("data->>'userId' = ?", storeQuery.UserId.String()), nil
+		})
+	}
 }
 
 // Clear clears the event storage. This is only for testing purposes.
@@ -317,6 +414,10 @@ func (s *postgresEventStore) clear(ctx context.Context) error {
 	return s.db.
 		RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
 			_, err = tx.Model((*eventRecord)(nil)).Where("1=1").Delete()
+			if err != nil {
+				return err
+			}
+			_, err = tx.Model((*checkpointRecord)(nil)).Where("1=1").Delete()
 			return err
 		})
 }
@@ -15,10 +15,15 @@
 package storage
 
 import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
+	"os"
 	"strings"
 	"time"
 
+	esErrors "github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
 	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 
 	"github.com/go-pg/pg/v10"
@@ -32,6 +37,7 @@ const (
 	CACertPath            = "/etc/eventstore/certs/db/ca.crt"
 	TLSCertPath           = "/etc/eventstore/certs/db/tls.crt"
 	TLSKeyPath            = "/etc/eventstore/certs/db/tls.key"
+	CheckpointKeyPath     = "/etc/eventstore/checkpoint/tls.key"
 )
 
 type postgresStoreConfig struct {
@@ -40,6 +46,8 @@ type postgresStoreConfig struct {
 	RetryDelay     time.Duration // When retrying to read/write
 	MaxRetries     int           // How many times retrying read/write
 	pgOptions      *pg.Options
+	checkpointKey  ed25519.PrivateKey // Signs checkpoints
+	verifyKey      ed25519.PublicKey  // Verifies checkpoint signatures
 }
 
 // ErrConfigDbNameRequired is when the config doesn't include a name.
@@ -92,6 +100,57 @@ func (conf *postgresStoreConfig) ConfigureTLS() error {
 	return nil
 }
 
+// ConfigureCheckpointSigning loads the PEM encoded ed25519 private key used to sign and verify checkpoints
+func (conf *postgresStoreConfig) ConfigureCheckpointSigning(keyPath string) error {
+	block, err := readPEMFile(keyPath)
+	if err != nil {
+		return err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return esErrors.ErrInvalidCheckpointKey
+	}
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return esErrors.ErrInvalidCheckpointKey
+	}
+
+	conf.checkpointKey = privateKey
+	conf.verifyKey = privateKey.Public().(ed25519.PublicKey)
+	return nil
+}
+
+// ConfigureCheckpointVerification loads the PEM encoded ed25519 public key used to verify checkpoints
+func (conf *postgresStoreConfig) ConfigureCheckpointVerification(keyPath string) error {
+	block, err := readPEMFile(keyPath)
+	if err != nil {
+		return err
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return esErrors.ErrInvalidCheckpointKey
+	}
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return esErrors.ErrInvalidCheckpointKey
+	}
+
+	conf.verifyKey = publicKey
+	return nil
+}
+
+func readPEMFile(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, esErrors.ErrInvalidCheckpointKey
+	}
+	return block, nil
+}
+
 // Validate validates the configuration
 func (conf *postgresStoreConfig) Validate() error {
 	if conf.pgOptions.Database == "" {
@@ -0,0 +1,421 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"strconv"
+	"time"
+
+	"github.com/finleap-connect/monoskope/internal/telemetry"
+	evs "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+)
+
+// checkpointRecord is the model for entries in the event_checkpoints table in the database.
+type checkpointRecord struct {
+	tableName struct{} `sql:"event_checkpoints"`
+
+	ID        uuid.UUID        `pg:"id,type:uuid,pk"`
+	Timestamp time.Time        `pg:""`
+	Heads     []checkpointHead `pg:"heads,type:jsonb"`
+	Digest    string           `pg:"digest,type:varchar(64)"`
+	Signature string           `pg:"signature,type:varchar(128)"`
+}
+
+// checkpointHead is the last event of an aggregate at the time of a checkpoint.
+type checkpointHead struct {
+	AggregateType    evs.AggregateType `json:"aggregateType"`
+	AggregateID      uuid.UUID         `json:"aggregateId"`
+	AggregateVersion uint64            `json:"aggregateVersion"`
+	Hash             string            `json:"hash"`
+}
+
+// previousHash returns the hash of the event preceding the given version of an aggregate.
+// An empty string is returned if there is no such event or it has been stored before hash chaining was enabled.
+func (s *postgresEventStore) previousHash(ctx context.Context, aggregateType evs.AggregateType, aggregateID uuid.UUID, version uint64) (string, error) {
+	if version == 0 {
+		return "", nil
+	}
+
+	prev := new(eventRecord)
+	err := s.db.ModelContext(ctx, prev).
+		Column("hash").
+		Where("aggregate_id = ?", aggregateID).
+		Where("aggregate_type = ?", aggregateType).
+		Where("aggregate_version = ?", version-1).
+		Select()
+	if err == pg.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return prev.Hash, nil
+}
+
+// Checkpoint implements the Checkpoint method of the IntegrityVerifier interface.
+func (s *postgresEventStore) Checkpoint(ctx context.Context) (*evs.Checkpoint, error) {
+	ctx, span := telemetry.GetSpan(ctx, "checkpoint")
+	defer span.End()
+
+	if s.conf.checkpointKey == nil {
+		return nil, errors.ErrCheckpointKeyNotConfigured
+	}
+	if !s.isConnected {
+		return nil, errors.ErrConnectionClosed
+	}
+
+	var heads []eventRecord
+	err := s.db.ModelContext(ctx, &heads).
+		Column("aggregate_type", "aggregate_id", "aggregate_version", "hash").
+		DistinctOn("aggregate_type, aggregate_id").
+		Order("aggregate_type ASC", "aggregate_id ASC", "aggregate_version DESC").
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &checkpointRecord{
+		ID:        uuid.New(),
+		Timestamp: time.Now().UTC().Truncate(time.Microsecond),
+		Heads:     make([]checkpointHead, len(heads)),
+	}
+	for i, head := range heads {
+		checkpoint.Heads[i] = checkpointHead{
+			AggregateType:    head.AggregateType,
+			AggregateID:      head.AggregateID,
+			AggregateVersion: head.AggregateVersion,
+			Hash:             head.Hash,
+		}
+	}
+	checkpoint.sign(s.conf.checkpointKey)
+
+	if _, err := s.db.ModelContext(ctx, checkpoint).Insert(); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Checkpoint created.", "aggregates", len(checkpoint.Heads), "digest", checkpoint.Digest)
+	return checkpoint.toCheckpoint(), nil
+}
+
+// VerifyIntegrity implements the VerifyIntegrity method of the IntegrityVerifier interface.
+func (s *postgresEventStore) VerifyIntegrity(ctx context.Context) (*evs.IntegrityReport, error) {
+	ctx, span := telemetry.GetSpan(ctx, "verify-integrity")
+	defer span.End()
+
+	if !s.isConnected {
+		return nil, errors.ErrConnectionClosed
+	}
+
+	report := new(evs.IntegrityReport)
+	latest, err := s.verifyCheckpoints(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil {
+		report.LatestCheckpoint = latest.toCheckpoint()
+	}
+
+	verifier := newChainVerifier(report, latest)
+	err = s.db.ModelContext(ctx, (*eventRecord)(nil)).
+		Order("aggregate_type ASC", "aggregate_id ASC", "aggregate_version ASC").
+		ForEach(verifier.verify)
+	if err != nil {
+		return nil, err
+	}
+	verifier.finish()
+
+	s.log.V(logger.DebugLevel).Info("Verified integrity.", "verifiedEvents", report.VerifiedEvents, "unchainedEvents", report.UnchainedEvents, "brokenLinks", report.BrokenLinks)
+	return report, nil
+}
+
+// verifyCheckpoints verifies all checkpoints and returns the latest valid one.
+func (s *postgresEventStore) verifyCheckpoints(ctx context.Context, report *evs.IntegrityReport) (*checkpointRecord, error) {
+	var latest *checkpointRecord
+	err := s.db.ModelContext(ctx, (*checkpointRecord)(nil)).
+		Order("timestamp ASC").
+		ForEach(func(c *checkpointRecord) error {
+			if reason := c.verify(s.conf.verifyKey); reason != "" {
+				report.AddBrokenLink(&evs.BrokenLink{
+					Timestamp: c.Timestamp,
+					Reason:    reason,
+				})
+				return nil
+			}
+
+			if s.conf.verifyKey == nil {
+				report.UnverifiedCheckpoints++
+			} else {
+				report.VerifiedCheckpoints++
+			}
+			checkpoint := *c
+			latest = &checkpoint
+			return nil
+		})
+	return latest, err
+}
+
+// writeField writes a length prefixed field to the hash to keep the encoding unambiguous.
+func writeField(h hash.Hash, field string) {
+	_, _ = fmt.Fprintf(h, "%d:%s", len(field), field)
+}
+
+// canonicalJSON re-encodes JSON with sorted keys and without insignificant whitespace,
+// so that the hash does not depend on how the database normalizes jsonb values.
+func canonicalJSON(raw []byte) (string, error) {
+	if len(raw) == 0 {
+		return "null", nil
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+	canonical, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(canonical), nil
+}
+
+// hashRecord computes the hash of an event record chained to the hash of its predecessor.
+func hashRecord(r *eventRecord) (string, error) {
+	data, err := canonicalJSON(r.RawData)
+	if err != nil {
+		return "", err
+	}
+	metadata := "{}"
+	if len(r.Metadata) > 0 {
+		raw, err := json.Marshal(r.Metadata) // keys are sorted by encoding/json
+		if err != nil {
+			return "", err
+		}
+		metadata = string(raw)
+	}
+
+	h := sha256.New()
+	writeField(h, r.PreviousHash)
+	writeField(h, r.EventID.String())
+	writeField(h, string(r.EventType))
+	writeField(h, string(r.AggregateType))
+	writeField(h, r.AggregateID.String())
+	writeField(h, strconv.FormatUint(r.AggregateVersion, 10))
+	writeField(h, r.Timestamp.UTC().Format(time.RFC3339Nano))
+	writeField(h, metadata)
+	writeField(h, data)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// digest computes the digest over the timestamp and all heads of the checkpoint.
+func (c *checkpointRecord) digest() string {
+	h := sha256.New()
+	writeField(h, c.Timestamp.UTC().Format(time.RFC3339Nano))
+	for _, head := range c.Heads {
+		writeField(h, string(head.AggregateType))
+		writeField(h, head.AggregateID.String())
+		writeField(h, strconv.FormatUint(head.AggregateVersion, 10))
+		writeField(h, head.Hash)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// sign sets digest and signature of the checkpoint.
+func (c *checkpointRecord) sign(key ed25519.PrivateKey) {
+	c.Digest = c.digest()
+	c.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(key, []byte(c.Digest)))
+}
+
+// verify returns the reason why the checkpoint is invalid or an empty string.
+// The signature is only checked if a key is given.
+func (c *checkpointRecord) verify(key ed25519.PublicKey) string {
+	if c.Digest != c.digest() {
+		return "digest of checkpoint does not match its heads"
+	}
+	if key == nil {
+		return ""
+	}
+	signature, err := base64.StdEncoding.DecodeString(c.Signature)
+	if err != nil || !ed25519.Verify(key, []byte(c.Digest), signature) {
+		return "signature of checkpoint is invalid"
+	}
+	return ""
+}
+
+// toCheckpoint converts the record to an evs.Checkpoint.
+func (c *checkpointRecord) toCheckpoint() *evs.Checkpoint {
+	return &evs.Checkpoint{
+		Timestamp:  c.Timestamp,
+		Aggregates: uint64(len(c.Heads)),
+		Digest:     c.Digest,
+		Signature:  c.Signature,
+	}
+}
+
+// chainVerifier verifies event records ordered by aggregate and version.
+type chainVerifier struct {
+	report *evs.IntegrityReport
+	// heads pinned by the latest valid checkpoint
+	pinned   map[string]checkpointHead
+	pinnedAt time.Time
+	seen     map[string]bool
+	head     *eventRecord
+	isBroken bool
+}
+
+func newChainVerifier(report *evs.IntegrityReport, checkpoint *checkpointRecord) *chainVerifier {
+	v := &chainVerifier{
+		report: report,
+		pinned: make(map[string]checkpointHead),
+		seen:   make(map[string]bool),
+	}
+	if checkpoint != nil {
+		v.pinnedAt = checkpoint.Timestamp
+		for _, head := range checkpoint.Heads {
+			v.pinned[aggregateKey(head.AggregateType, head.AggregateID)] = head
+		}
+	}
+	return v
+}
+
+func aggregateKey(aggregateType evs.AggregateType, aggregateID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s", aggregateType, aggregateID)
+}
+
+// verify verifies the next event record. Records must be passed ordered by aggregate and version.
+func (v *chainVerifier) verify(r *eventRecord) error {
+	prev := v.head
+	if prev != nil && (prev.AggregateID != r.AggregateID || prev.AggregateType != r.AggregateType) {
+		v.finishAggregate()
+		prev = nil
+	}
+	record := *r
+	v.head = &record
+	v.seen[aggregateKey(r.AggregateType, r.AggregateID)] = true
+
+	// Only the first broken link of an aggregate is reported, following ones are a consequence.
+	if v.isBroken {
+		return nil
+	}
+
+	reason, err := v.check(prev, r)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		v.isBroken = true
+		v.report.AddBrokenLink(&evs.BrokenLink{
+			EventID:          r.EventID,
+			AggregateType:    r.AggregateType,
+			AggregateID:      r.AggregateID,
+			AggregateVersion: r.AggregateVersion,
+			Timestamp:        r.Timestamp,
+			Reason:           reason,
+		})
+		return nil
+	}
+
+	if r.Hash == "" {
+		v.report.UnchainedEvents++
+	} else {
+		v.report.VerifiedEvents++
+	}
+	return nil
+}
+
+// check returns the reason why the link between prev and r is broken or an empty string.
+func (v *chainVerifier) check(prev, r *eventRecord) (string, error) {
+	if prev == nil {
+		if r.PreviousHash != "" || r.AggregateVersion > 1 {
+			return "preceding events of the aggregate are missing", nil
+		}
+	} else {
+		if r.AggregateVersion != prev.AggregateVersion+1 {
+			return fmt.Sprintf("events between version %d and %d are missing", prev.AggregateVersion, r.AggregateVersion), nil
+		}
+		if r.Hash == "" && prev.Hash != "" {
+			return "hash is missing although the predecessor is chained", nil
+		}
+		if r.PreviousHash != prev.Hash {
+			return "previous hash does not match the hash of the predecessor", nil
+		}
+	}
+
+	if r.Hash != "" {
+		computed, err := hashRecord(r)
+		if err != nil {
+			return "", err
+		}
+		if computed != r.Hash {
+			return "content of the event does not match its hash", nil
+		}
+	}
+
+	if pinned, ok := v.pinned[aggregateKey(r.AggregateType, r.AggregateID)]; ok && pinned.AggregateVersion == r.AggregateVersion && pinned.Hash != r.Hash {
+		return "hash does not match the latest checkpoint", nil
+	}
+	return "", nil
+}
+
+// finishAggregate reports events pinned by the latest checkpoint beyond the head of the current aggregate.
+func (v *chainVerifier) finishAggregate() {
+	defer func() {
+		v.head = nil
+		v.isBroken = false
+	}()
+
+	if v.head == nil || v.isBroken {
+		return
+	}
+	pinned, ok := v.pinned[aggregateKey(v.head.AggregateType, v.head.AggregateID)]
+	if ok && pinned.AggregateVersion > v.head.AggregateVersion {
+		v.report.AddBrokenLink(&evs.BrokenLink{
+			AggregateType:    pinned.AggregateType,
+			AggregateID:      pinned.AggregateID,
+			AggregateVersion: pinned.AggregateVersion,
+			Timestamp:        v.pinnedAt,
+			Reason:           fmt.Sprintf("events after version %d covered by the latest checkpoint are missing", v.head.AggregateVersion),
+		})
+	}
+}
+
+// finish completes the verification and reports aggregates of the latest checkpoint which are missing entirely.
+func (v *chainVerifier) finish() {
+	v.finishAggregate()
+	for key, pinned := range v.pinned {
+		if !v.seen[key] {
+			v.report.AddBrokenLink(&evs.BrokenLink{
+				AggregateType:    pinned.AggregateType,
+				AggregateID:      pinned.AggregateID,
+				AggregateVersion: pinned.AggregateVersion,
+				Timestamp:        v.pinnedAt,
+				Reason:           "aggregate covered by the latest checkpoint is missing",
+			})
+		}
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"time"
+
+	testEd "github.com/finleap-connect/monoskope/pkg/api/eventsourcing/eventdata"
+	evs "github.com/finleap-connect/monoskope/pkg/eventsourcing"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("storage/postgres/integrity", func() {
+	ctx := context.Background()
+
+	newRecord := func(aggregateId uuid.UUID, version uint64, previousHash string) *eventRecord {
+		r := &eventRecord{
+			EventID:          uuid.New(),
+			EventType:        testEventChanged,
+			AggregateID:      aggregateId,
+			AggregateType:    testAggregate,
+			AggregateVersion: version,
+			Timestamp:        time.Now().UTC().Truncate(time.Microsecond),
+			Metadata:         map[string]string{"b": "2", "a": "1"},
+			RawData:          []byte(`{"hello": "world", "count": 1}`),
+			PreviousHash:     previousHash,
+		}
+		var err error
+		r.Hash, err = hashRecord(r)
+		Expect(err).ToNot(HaveOccurred())
+		return r
+	}
+
+	newChain := func(length int) []*eventRecord {
+		aggregateId := uuid.New()
+		var records []*eventRecord
+		previousHash := ""
+		for i := 0; i < length; i++ {
+			r := newRecord(aggregateId, uint64(i), previousHash)
+			previousHash = r.Hash
+			records = append(records, r)
+		}
+		return records
+	}
+
+	verify := func(checkpoint *checkpointRecord, records ...*eventRecord) *evs.IntegrityReport {
+		report := new(evs.IntegrityReport)
+		verifier := newChainVerifier(report, checkpoint)
+		for _, r := range records {
+			Expect(verifier.verify(r)).To(Succeed())
+		}
+		verifier.finish()
+		return report
+	}
+
+	Context("hashRecord", func() {
+		It("does not depend on the json encoding of the data", func() {
+			r := newRecord(uuid.New(), 0, "")
+			r.RawData = []byte(`{"count":1,"hello":"world"}`)
+			hash, err := hashRecord(r)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hash).To(Equal(r.Hash))
+		})
+		It("depends on the previous hash", func() {
+			r := newRecord(uuid.New(), 1, "")
+			r.PreviousHash = "other"
+			hash, err := hashRecord(r)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hash).ToNot(Equal(r.Hash))
+		})
+	})
+	Context("chainVerifier", func() {
+		It("verifies an intact chain", func() {
+			report := verify(nil, newChain(3)...)
+			Expect(report.Intact()).To(BeTrue())
+			Expect(report.VerifiedEvents).To(BeNumerically("==", 3))
+		})
+		It("counts events stored before hash chaining", func() {
+			records := newChain(3)
+			records[0].Hash = ""
+			records[1].PreviousHash = ""
+			var err error
+			records[1].Hash, err = hashRecord(records[1])
+			Expect(err).ToNot(HaveOccurred())
+			records[2].PreviousHash = records[1].Hash
+			records[2].Hash, err = hashRecord(records[2])
+			Expect(err).ToNot(HaveOccurred())
+
+			report := verify(nil, records...)
+			Expect(report.Intact()).To(BeTrue())
+			Expect(report.UnchainedEvents).To(BeNumerically("==", 1))
+			Expect(report.VerifiedEvents).To(BeNumerically("==", 2))
+		})
+		It("reports modified events", func() {
+			records := newChain(3)
+			records[1].RawData = []byte(`{"hello": "tampered"}`)
+
+			report := verify(nil, records...)
+			Expect(report.Intact()).To(BeFalse())
+			Expect(report.BrokenLinks).To(BeNumerically("==", 1))
+			Expect(report.FirstBrokenLink.EventID).To(Equal(records[1].EventID))
+		})
+		It("reports deleted events", func() {
+			records := newChain(3)
+
+			report := verify(nil, records[0], records[2])
+			Expect(report.Intact()).To(BeFalse())
+			Expect(report.FirstBrokenLink.EventID).To(Equal(records[2].EventID))
+		})
+		It("reports the earliest broken link", func() {
+			first, second := newChain(2), newChain(2)
+			first[1].Timestamp = second[1].Timestamp.Add(time.Second)
+			first[1].Metadata = nil
+			second[1].Metadata = nil
+
+			report := verify(nil, append(first, second...)...)
+			Expect(report.BrokenLinks).To(BeNumerically("==", 2))
+			Expect(report.FirstBrokenLink.EventID).To(Equal(second[1].EventID))
+		})
+		It("reports events covered by the checkpoint which have been deleted", func() {
+			key := newCheckpointKey()
+			records := newChain(3)
+			head := records[2]
+			checkpoint := &checkpointRecord{
+				Timestamp: time.Now().UTC(),
+				Heads: []checkpointHead{
+					{AggregateType: head.AggregateType, AggregateID: head.AggregateID, AggregateVersion: head.AggregateVersion, Hash: head.Hash},
+					{AggregateType: testAggregate, AggregateID: uuid.New(), AggregateVersion: 0, Hash: "deleted"},
+				},
+			}
+			checkpoint.sign(key)
+			Expect(checkpoint.verify(key.Public().(ed25519.PublicKey))).To(BeEmpty())
+
+			report := verify(checkpoint, records...)
+			Expect(report.BrokenLinks).To(BeNumerically("==", 1))
+
+			report = verify(checkpoint, records[:2]...)
+			Expect(report.BrokenLinks).To(BeNumerically("==", 2))
+		})
+	})
+	Context("checkpointRecord", func() {
+		It("detects modified heads and invalid signatures", func() {
+			key := newCheckpointKey()
+			checkpoint := &checkpointRecord{
+				Timestamp: time.Now().UTC(),
+				Heads:     []checkpointHead{{AggregateType: testAggregate, AggregateID: uuid.New(), Hash: "hash"}},
+			}
+			checkpoint.sign(key)
+			Expect(checkpoint.verify(nil)).To(BeEmpty())
+			Expect(checkpoint.verify(newCheckpointKey().Public().(ed25519.PublicKey))).ToNot(BeEmpty())
+
+			checkpoint.Heads[0].Hash = "other"
+			Expect(checkpoint.verify(nil)).ToNot(BeEmpty())
+		})
+	})
+	Context("postgresEventStore", func() {
+		var es *postgresEventStore
+
+		newEvents := func(aggregateId uuid.UUID, versions ...uint64) []evs.Event {
+			var events []evs.Event
+			for _, version := range versions {
+				events = append(events, evs.NewEvent(ctx, testEventChanged, evs.ToEventDataFromProto(&testEd.TestEventData{Hello: "world"}), time.Now().UTC(), testAggregate, aggregateId, version))
+			}
+			return events
+		}
+
+		BeforeEach(func() {
+			store, err := NewPostgresEventStore(env.postgresStoreConfig)
+			Expect(err).ToNot(HaveOccurred())
+			es = store.(*postgresEventStore)
+
+			ctxWithTimeout, cancelFunc := context.WithTimeout(ctx, 10*time.Second)
+			defer cancelFunc()
+			Expect(es.Open(ctxWithTimeout)).To(Succeed())
+		})
+		AfterEach(func() {
+			Expect(es.clear(ctx)).To(Succeed())
+			Expect(es.Close()).To(Succeed())
+		})
+
+		It("chains events across saves and verifies them", func() {
+			aggregateId := uuid.New()
+			Expect(es.Save(ctx, newEvents(aggregateId, 0, 1))).To(Succeed())
+			Expect(es.Save(ctx, newEvents(aggregateId, 2))).To(Succeed())
+
+			report, err := es.VerifyIntegrity(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Intact()).To(BeTrue())
+			Expect(report.VerifiedEvents).To(BeNumerically("==", 3))
+		})
+		It("reports events modified in the database", func() {
+			aggregateId := uuid.New()
+			Expect(es.Save(ctx, newEvents(aggregateId, 0, 1, 2))).To(Succeed())
+
+			_, err := es.db.Model((*eventRecord)(nil)).
+				Set("data = ?", `{"hello":"tampered"}`).
+				Where("aggregate_id = ?", aggregateId).
+				Where("aggregate_version = ?", 1).
+				Update()
+			Expect(err).ToNot(HaveOccurred())
+
+			report, err := es.VerifyIntegrity(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Intact()).To(BeFalse())
+			Expect(report.FirstBrokenLink.AggregateID).To(Equal(aggregateId))
+			Expect(report.FirstBrokenLink.AggregateVersion).To(BeNumerically("==", 1))
+		})
+		It("reports the last event of an aggregate deleted after a checkpoint", func() {
+			key := newCheckpointKey()
+			es.conf.checkpointKey = key
+			es.conf.verifyKey = key.Public().(ed25519.PublicKey)
+			defer func() {
+				es.conf.checkpointKey = nil
+				es.conf.verifyKey = nil
+			}()
+
+			aggregateId := uuid.New()
+			Expect(es.Save(ctx, newEvents(aggregateId, 0, 1))).To(Succeed())
+			checkpoint, err := es.Checkpoint(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpoint.Aggregates).To(BeNumerically("==", 1))
+
+			_, err = es.db.Model((*eventRecord)(nil)).
+				Where("aggregate_id = ?", aggregateId).
+				Where("aggregate_version = ?", 1).
+				Delete()
+			Expect(err).ToNot(HaveOccurred())
+
+			report, err := es.VerifyIntegrity(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.VerifiedCheckpoints).To(BeNumerically("==", 1))
+			Expect(report.Intact()).To(BeFalse())
+			Expect(report.FirstBrokenLink.AggregateVersion).To(BeNumerically("==", 1))
+		})
+	})
+})
+
+func newCheckpointKey() ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	return key
+}
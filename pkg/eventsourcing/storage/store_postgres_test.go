@@ -19,6 +19,7 @@ import (
 	"io"
 	"time"
 
+	test_eventstore "github.com/finleap-connect/monoskope/internal/test/eventsourcing/eventstore"
 	testEd "github.com/finleap-connect/monoskope/pkg/api/eventsourcing/eventdata"
 	evs "github.com/finleap-connect/monoskope/pkg/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
@@ -31,6 +32,14 @@ type metadataVal struct {
 	Val string
 }
 
+var _ = Describe("storage/postgres conformance", func() {
+	test_eventstore.ItBehavesLikeAnEventStore(func() evs.EventStore {
+		store, err := NewPostgresEventStore(env.postgresStoreConfig)
+		Expect(err).ToNot(HaveOccurred())
+		return store
+	})
+})
+
 var _ = Describe("storage/postgres", func() {
 	var userInformationKey = "userInformationKey"
 
@@ -0,0 +1,153 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsourcing
+
+import (
+	"sync"
+
+	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/finleap-connect/monoskope/pkg/logger"
+	"google.golang.org/protobuf/proto"
+)
+
+// Upcaster transforms the data of an event of an old event type into
+// the event type and data of the next newer version.
+type Upcaster func(EventData) (EventType, EventData, error)
+
+// UpcasterRegistry transforms events of old event types into the latest version
+// when they are loaded, so that only the newest shape has to be handled.
+type UpcasterRegistry interface {
+	// RegisterUpcaster registers an upcaster for an event type.
+	RegisterUpcaster(EventType, Upcaster) error
+	// Upcast applies the upcasters registered until the event has the latest version of its event type.
+	Upcast(Event) (Event, error)
+}
+
+type upcasterRegistry struct {
+	log       logger.Logger
+	mutex     sync.RWMutex
+	upcasters map[EventType]Upcaster
+}
+
+var DefaultUpcasterRegistry UpcasterRegistry
+
+func init() {
+	DefaultUpcasterRegistry = NewUpcasterRegistry()
+}
+
+// NewUpcasterRegistry creates a new upcaster registry
+func NewUpcasterRegistry() UpcasterRegistry {
+	return &upcasterRegistry{
+		log:       logger.WithName("upcaster-registry"),
+		upcasters: make(map[EventType]Upcaster),
+	}
+}
+
+// RegisterUpcaster registers an upcaster for an event type. Upcasters are chained,
+// e.g. upcasters from V1 to V2 and from V2 to V3 upcast V1 events to V3.
+// passing an empty event-type will result in errors.ErrEmptyEventType
+// passing a nil upcaster will result in errors.ErrUpcasterInvalid
+// if an upcaster for the event-type is already registered errors.ErrUpcasterAlreadyRegistered is returned
+func (r *upcasterRegistry) RegisterUpcaster(eventType EventType, upcaster Upcaster) error {
+	if eventType.String() == "" {
+		r.log.Info("attempt to register upcaster for empty event type")
+		return errors.ErrEmptyEventType
+	}
+
+	if upcaster == nil {
+		r.log.Info("attempt to register invalid upcaster. Upcaster can't be nil")
+		return errors.ErrUpcasterInvalid
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.upcasters[eventType]; ok {
+		r.log.Info("attempt to register upcaster already registered", "eventType", eventType)
+		return errors.ErrUpcasterAlreadyRegistered
+	}
+	r.upcasters[eventType] = upcaster
+
+	r.log.V(logger.DebugLevel).Info("upcaster has been registered.", "eventType", eventType)
+	return nil
+}
+
+// Upcast implements the Upcast method of the UpcasterRegistry interface.
+func (r *upcasterRegistry) Upcast(event Event) (Event, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	eventType, data := event.EventType(), event.Data()
+	visited := make(map[EventType]bool)
+	for {
+		upcaster, ok := r.upcasters[eventType]
+		if !ok {
+			break
+		}
+		if visited[eventType] {
+			return nil, errors.ErrUpcasterCycle
+		}
+		visited[eventType] = true
+
+		var err error
+		eventType, data, err = upcaster(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(visited) == 0 {
+		return event, nil
+	}
+	return NewEventWithMetadata(eventType, data, event.Timestamp(), event.AggregateType(), event.AggregateID(), event.AggregateVersion(), event.Metadata()), nil
+}
+
+// NewProtoUpcaster creates an Upcaster from a function transforming the proto event data of an old event type
+// into the proto event data of the given event type.
+func NewProtoUpcaster[From proto.Message, To proto.Message](eventType EventType, upcast func(From) To) Upcaster {
+	return func(data EventData) (EventType, EventData, error) {
+		m, err := data.Unmarshal()
+		if err != nil {
+			return "", nil, err
+		}
+		from, ok := m.(From)
+		if !ok {
+			return "", nil, errors.ErrInvalidEventData
+		}
+		return eventType, ToEventDataFromProto(upcast(from)), nil
+	}
+}
+
+// UpcastEventFromProto converts proto events to Event and upcasts them using the DefaultUpcasterRegistry.
+func UpcastEventFromProto(protoEvent *esApi.Event) (Event, error) {
+	event, err := NewEventFromProto(protoEvent)
+	if err != nil {
+		return nil, err
+	}
+	return DefaultUpcasterRegistry.Upcast(event)
+}
+
+// UpcastProtoEvent upcasts proto events using the DefaultUpcasterRegistry.
+func UpcastProtoEvent(protoEvent *esApi.Event) (*esApi.Event, error) {
+	event, err := UpcastEventFromProto(protoEvent)
+	if err != nil {
+		return nil, err
+	}
+	if event.EventType().String() == protoEvent.GetType() {
+		return protoEvent, nil
+	}
+	return NewProtoFromEvent(event), nil
+}
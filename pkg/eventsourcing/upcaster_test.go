@@ -0,0 +1,98 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventsourcing
+
+import (
+	"context"
+	"time"
+
+	testEd "github.com/finleap-connect/monoskope/pkg/api/eventsourcing/eventdata"
+	"github.com/finleap-connect/monoskope/pkg/eventsourcing/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("upcaster_registry", func() {
+	const (
+		testEventV1 EventType = "TestEvent"
+		testEventV2 EventType = "TestEventV2"
+		testEventV3 EventType = "TestEventV3"
+	)
+
+	toV2 := NewProtoUpcaster(testEventV2, func(data *testEd.TestEventData) *wrapperspb.StringValue {
+		return wrapperspb.String(data.Hello)
+	})
+	toV3 := NewProtoUpcaster(testEventV3, func(data *wrapperspb.StringValue) *testEd.TestEventData {
+		return &testEd.TestEventData{Hello: data.Value + "!"}
+	})
+
+	newEvent := func(eventType EventType, data EventData) Event {
+		return NewEvent(context.Background(), eventType, data, time.Now().UTC(), testAggregateType, uuid.New(), 1)
+	}
+
+	It("can't register upcasters for the same event type twice", func() {
+		registry := NewUpcasterRegistry()
+		Expect(registry.RegisterUpcaster(testEventV1, toV2)).To(Succeed())
+		Expect(registry.RegisterUpcaster(testEventV1, toV2)).To(MatchError(errors.ErrUpcasterAlreadyRegistered))
+	})
+	It("can't register invalid upcasters", func() {
+		registry := NewUpcasterRegistry()
+		Expect(registry.RegisterUpcaster("", toV2)).To(MatchError(errors.ErrEmptyEventType))
+		Expect(registry.RegisterUpcaster(testEventV1, nil)).To(MatchError(errors.ErrUpcasterInvalid))
+	})
+	It("returns events without upcaster as they are", func() {
+		registry := NewUpcasterRegistry()
+		event := newEvent(testEventV3, ToEventDataFromProto(&testEd.TestEventData{Hello: "world"}))
+
+		upcasted, err := registry.Upcast(event)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upcasted).To(Equal(event))
+	})
+	It("chains upcasters to the latest version", func() {
+		registry := NewUpcasterRegistry()
+		Expect(registry.RegisterUpcaster(testEventV1, toV2)).To(Succeed())
+		Expect(registry.RegisterUpcaster(testEventV2, toV3)).To(Succeed())
+		event := newEvent(testEventV1, ToEventDataFromProto(&testEd.TestEventData{Hello: "world"}))
+
+		upcasted, err := registry.Upcast(event)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upcasted.EventType()).To(Equal(testEventV3))
+		Expect(upcasted.AggregateID()).To(Equal(event.AggregateID()))
+		Expect(upcasted.Metadata()).To(Equal(event.Metadata()))
+
+		data := new(testEd.TestEventData)
+		Expect(upcasted.Data().ToProto(data)).To(Succeed())
+		Expect(data.Hello).To(Equal("world!"))
+	})
+	It("fails for event data not matching the upcaster", func() {
+		registry := NewUpcasterRegistry()
+		Expect(registry.RegisterUpcaster(testEventV2, toV3)).To(Succeed())
+
+		_, err := registry.Upcast(newEvent(testEventV2, ToEventDataFromProto(&testEd.TestEventData{Hello: "world"})))
+		Expect(err).To(Equal(errors.ErrInvalidEventData))
+	})
+	It("fails for upcasters forming a cycle", func() {
+		registry := NewUpcasterRegistry()
+		Expect(registry.RegisterUpcaster(testEventV1, toV2)).To(Succeed())
+		Expect(registry.RegisterUpcaster(testEventV2, NewProtoUpcaster(testEventV1, func(data *wrapperspb.StringValue) *testEd.TestEventData {
+			return &testEd.TestEventData{Hello: data.Value}
+		}))).To(Succeed())
+
+		_, err := registry.Upcast(newEvent(testEventV1, ToEventDataFromProto(&testEd.TestEventData{Hello: "world"})))
+		Expect(err).To(Equal(errors.ErrUpcasterCycle))
+	})
+})
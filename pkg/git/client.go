@@ -22,6 +22,8 @@ import (
 
 	"github.com/finleap-connect/monoskope/pkg/logger"
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
@@ -93,6 +95,108 @@ func (c *GitClient) Pull(ctx context.Context) error {
 	return nil
 }
 
+// Head returns the hash of the commit HEAD of the local clone points to
+func (c *GitClient) Head(_ context.Context) (string, error) {
+	ref, err := c.repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("failed to get HEAD: %w", err)
+	}
+	return ref.Hash().String(), nil
+}
+
+// Branch returns the name of the branch HEAD of the local clone points to
+func (c *GitClient) Branch(_ context.Context) (string, error) {
+	ref, err := c.repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("failed to get HEAD: %w", err)
+	}
+	if !ref.Name().IsBranch() {
+		return "", fmt.Errorf("HEAD is not a branch: %s", ref.Name())
+	}
+	return ref.Name().Short(), nil
+}
+
+// CheckoutNewBranch creates a new branch at HEAD and checks it out keeping all changes in the working directory
+func (c *GitClient) CheckoutNewBranch(_ context.Context, branch string) error {
+	w, err := c.repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to get git worktree: %w", err)
+	}
+
+	if err := w.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName(branch), Create: true, Keep: true}); err != nil {
+		return fmt.Errorf("failed to create branch `%s`: %w", branch, err)
+	}
+	return nil
+}
+
+// Checkout checks out the given branch discarding all changes in the working directory
+func (c *GitClient) Checkout(_ context.Context, branch string) error {
+	w, err := c.repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to get git worktree: %w", err)
+	}
+
+	if err := w.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName(branch), Force: true}); err != nil {
+		return fmt.Errorf("failed to checkout branch `%s`: %w", branch, err)
+	}
+	if err := w.Clean(&git.CleanOptions{Dir: true}); err != nil {
+		return fmt.Errorf("failed to clean git worktree: %w", err)
+	}
+	return nil
+}
+
+// DeleteBranch deletes the given local branch
+func (c *GitClient) DeleteBranch(_ context.Context, branch string) error {
+	if err := c.repo.Storer.RemoveReference(plumbing.NewBranchReferenceName(branch)); err != nil {
+		return fmt.Errorf("failed to delete branch `%s`: %w", branch, err)
+	}
+	return nil
+}
+
+// RemoteBranchExists checks whether the given branch exists in the remote repo
+func (c *GitClient) RemoteBranchExists(ctx context.Context, branch string) (bool, error) {
+	var cancel context.CancelFunc
+	if c.config.Timeout != nil {
+		ctx, cancel = context.WithTimeout(ctx, *c.config.Timeout)
+		defer cancel()
+	}
+
+	remote, err := c.repo.Remote(git.DefaultRemoteName)
+	if err != nil {
+		return false, fmt.Errorf("failed to get remote: %w", err)
+	}
+
+	lo, err := c.config.getListOptions()
+	if err != nil {
+		return false, err
+	}
+
+	refs, err := remote.ListContext(ctx, lo)
+	if err != nil {
+		return false, fmt.Errorf("failed to list remote references: %w", err)
+	}
+	for _, ref := range refs {
+		if ref.Name() == plumbing.NewBranchReferenceName(branch) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Status returns the status of the working directory
+func (c *GitClient) Status(_ context.Context) (git.Status, error) {
+	w, err := c.repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git worktree: %w", err)
+	}
+
+	status, err := w.Status()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git status: %w", err)
+	}
+	return status, nil
+}
+
 // AddAll stages all changes in the working directory
 func (c *GitClient) AddAll(_ context.Context) error {
 	w, err := c.repo.Worktree()
@@ -225,6 +329,49 @@ func (c *GitClient) Push(ctx context.Context) error {
 	return nil
 }
 
+// PushBranch pushes the given branch only, replacing its history in the remote repo
+func (c *GitClient) PushBranch(ctx context.Context, branch string) error {
+	var cancel context.CancelFunc
+	if c.config.Timeout != nil {
+		ctx, cancel = context.WithTimeout(ctx, *c.config.Timeout)
+		defer cancel()
+	}
+
+	po, err := c.config.getPushOptions()
+	if err != nil {
+		return err
+	}
+
+	ref := plumbing.NewBranchReferenceName(branch)
+	branchPo := *po
+	branchPo.RefSpecs = []config.RefSpec{config.RefSpec(fmt.Sprintf("+%s:%s", ref, ref))}
+	if err := c.repo.PushContext(ctx, &branchPo); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteRemoteBranch deletes the given branch in the remote repo
+func (c *GitClient) DeleteRemoteBranch(ctx context.Context, branch string) error {
+	var cancel context.CancelFunc
+	if c.config.Timeout != nil {
+		ctx, cancel = context.WithTimeout(ctx, *c.config.Timeout)
+		defer cancel()
+	}
+
+	po, err := c.config.getPushOptions()
+	if err != nil {
+		return err
+	}
+
+	branchPo := *po
+	branchPo.RefSpecs = []config.RefSpec{config.RefSpec(fmt.Sprintf(":%s", plumbing.NewBranchReferenceName(branch)))}
+	if err := c.repo.PushContext(ctx, &branchPo); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Close cleans up the clone directory
 func (c *GitClient) Close() error {
 	return os.RemoveAll(c.localDirectory)
@@ -242,6 +242,20 @@ func (c *GitConfig) getPullOptions() (*git.PullOptions, error) {
 	return c.pullOptions, nil
 }
 
+// getListOptions returns the options to list remote references generated from the configuration
+func (c *GitConfig) getListOptions() (*git.ListOptions, error) {
+	authMethod, err := c.getAuthMethod()
+	if err != nil {
+		return nil, err
+	}
+
+	return &git.ListOptions{
+		CABundle:        []byte(c.CABundle),
+		InsecureSkipTLS: c.InsecureSkipTLS,
+		Auth:            authMethod,
+	}, nil
+}
+
 // getPushOptions returns the push options generated from the generation
 func (c *GitConfig) getPushOptions() (*git.PushOptions, error) {
 	if c.pushOptions != nil {
@@ -0,0 +1,70 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fake provides an in-memory implementation of git.Forge to be used
+// in tests of code opening merge requests.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/finleap-connect/monoskope/pkg/git"
+)
+
+// Forge is an in-memory fake of git.Forge. It only records the merge
+// requests opened and keeps them open.
+type Forge struct {
+	mu            sync.Mutex
+	mergeRequests []*git.MergeRequest
+	updates       int
+}
+
+var _ git.Forge = &Forge{}
+
+// NewForge creates an empty fake.
+func NewForge() *Forge {
+	return &Forge{}
+}
+
+// OpenMergeRequest records the merge request or updates the recorded one of the
+// same repository and branches and returns a made up URL.
+func (f *Forge) OpenMergeRequest(ctx context.Context, mr *git.MergeRequest) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, open := range f.mergeRequests {
+		if open.RepositoryURL == mr.RepositoryURL && open.SourceBranch == mr.SourceBranch && open.TargetBranch == mr.TargetBranch {
+			f.mergeRequests[i] = mr
+			f.updates++
+			return fmt.Sprintf("https://forge.local/merge_requests/%d", i+1), nil
+		}
+	}
+	f.mergeRequests = append(f.mergeRequests, mr)
+	return fmt.Sprintf("https://forge.local/merge_requests/%d", len(f.mergeRequests)), nil
+}
+
+// MergeRequests returns all merge requests opened so far in the order they were opened.
+func (f *Forge) MergeRequests() []*git.MergeRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*git.MergeRequest{}, f.mergeRequests...)
+}
+
+// Updates returns how often open merge requests have been updated.
+func (f *Forge) Updates() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.updates
+}
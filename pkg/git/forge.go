@@ -0,0 +1,233 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+const (
+	ForgeTypeGitLab = "gitlab"
+	ForgeTypeGitHub = "github"
+
+	ForgeSuffixToken = ".forge.token"
+
+	DefaultGitLabURL = "https://gitlab.com/api/v4"
+	DefaultGitHubURL = "https://api.github.com"
+)
+
+var (
+	ErrForgeTypeIsUnknown = errors.New("forge type is unknown")
+)
+
+// MergeRequest is a request to merge a branch into another one, called pull request by some forges.
+type MergeRequest struct {
+	// RepositoryURL is the URL of the repository as it is cloned
+	RepositoryURL string
+	// SourceBranch is the branch containing the changes
+	SourceBranch string
+	// TargetBranch is the branch to merge the changes into
+	TargetBranch string
+	// Title is the title of the merge request
+	Title string
+	// Description is the description of the merge request
+	Description string
+}
+
+// Forge opens merge requests on the platform hosting repositories, e.g. GitLab.
+type Forge interface {
+	// OpenMergeRequest opens the merge request or updates the title and description of the open merge request
+	// of the same source and target branch. It returns the web URL of the merge request.
+	OpenMergeRequest(ctx context.Context, mr *MergeRequest) (string, error)
+}
+
+// ForgeConfig configures the Forge hosting repositories.
+type ForgeConfig struct {
+	// Type is the type of the forge, either gitlab or github
+	Type string `yaml:"type"`
+	// URL is the base URL of the API of the forge. Defaults to the API of gitlab.com or github.com.
+	URL string `yaml:"url"`
+	// EnvPrefix is the prefix of the environment variable containing the access token, e.g. `git` for `git.forge.token`
+	EnvPrefix string `yaml:"envPrefix"`
+}
+
+// NewForge creates a new Forge configured via the given config
+func NewForge(config *ForgeConfig) (Forge, error) {
+	tokenKey := fmt.Sprintf("%s%s", config.EnvPrefix, ForgeSuffixToken)
+	token := os.Getenv(tokenKey)
+	if token == "" {
+		return nil, fmt.Errorf("%s must not be empty", tokenKey)
+	}
+
+	switch config.Type {
+	case ForgeTypeGitLab:
+		return &gitLabForge{newForgeClient(config.URL, DefaultGitLabURL, http.Header{"PRIVATE-TOKEN": {token}})}, nil
+	case ForgeTypeGitHub:
+		return &gitHubForge{newForgeClient(config.URL, DefaultGitHubURL, http.Header{
+			"Authorization": {"Bearer " + token},
+			"Accept":        {"application/vnd.github+json"},
+		})}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrForgeTypeIsUnknown, config.Type)
+	}
+}
+
+// gitLabForge opens merge requests via the GitLab API
+type gitLabForge struct {
+	*forgeClient
+}
+
+func (f *gitLabForge) OpenMergeRequest(ctx context.Context, mr *MergeRequest) (string, error) {
+	project, err := repositoryPath(mr.RepositoryURL)
+	if err != nil {
+		return "", err
+	}
+	path := fmt.Sprintf("/projects/%s/merge_requests", url.PathEscape(project))
+
+	type mergeRequest struct {
+		IID    int    `json:"iid"`
+		WebURL string `json:"web_url"`
+	}
+	var open []*mergeRequest
+	query := url.Values{"state": {"opened"}, "source_branch": {mr.SourceBranch}, "target_branch": {mr.TargetBranch}}
+	if err := f.do(ctx, http.MethodGet, path+"?"+query.Encode(), nil, &open); err != nil {
+		return "", err
+	}
+
+	result := new(mergeRequest)
+	body := map[string]interface{}{
+		"title":       mr.Title,
+		"description": mr.Description,
+	}
+	if len(open) > 0 {
+		err = f.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", path, open[0].IID), body, result)
+	} else {
+		body["source_branch"] = mr.SourceBranch
+		body["target_branch"] = mr.TargetBranch
+		body["remove_source_branch"] = true
+		err = f.do(ctx, http.MethodPost, path, body, result)
+	}
+	return result.WebURL, err
+}
+
+// gitHubForge opens pull requests via the GitHub API
+type gitHubForge struct {
+	*forgeClient
+}
+
+func (f *gitHubForge) OpenMergeRequest(ctx context.Context, mr *MergeRequest) (string, error) {
+	repo, err := repositoryPath(mr.RepositoryURL)
+	if err != nil {
+		return "", err
+	}
+	path := fmt.Sprintf("/repos/%s/pulls", repo)
+
+	type pullRequest struct {
+		Number  int    `json:"number"`
+		HTMLURL string `json:"html_url"`
+	}
+	var open []*pullRequest
+	// Branches of the repository itself are referenced by the owner of the repository
+	owner, _, _ := strings.Cut(repo, "/")
+	query := url.Values{"state": {"open"}, "head": {owner + ":" + mr.SourceBranch}, "base": {mr.TargetBranch}}
+	if err := f.do(ctx, http.MethodGet, path+"?"+query.Encode(), nil, &open); err != nil {
+		return "", err
+	}
+
+	result := new(pullRequest)
+	body := map[string]interface{}{
+		"title": mr.Title,
+		"body":  mr.Description,
+	}
+	if len(open) > 0 {
+		err = f.do(ctx, http.MethodPatch, fmt.Sprintf("%s/%d", path, open[0].Number), body, result)
+	} else {
+		body["head"] = mr.SourceBranch
+		body["base"] = mr.TargetBranch
+		err = f.do(ctx, http.MethodPost, path, body, result)
+	}
+	return result.HTMLURL, err
+}
+
+// forgeClient calls the JSON API of a forge
+type forgeClient struct {
+	baseURL string
+	header  http.Header
+	client  *http.Client
+}
+
+func newForgeClient(baseURL, defaultURL string, header http.Header) *forgeClient {
+	if baseURL == "" {
+		baseURL = defaultURL
+	}
+	return &forgeClient{strings.TrimSuffix(baseURL, "/"), header, http.DefaultClient}
+}
+
+// do sends the body as JSON with the given method to the path of the API and decodes the response into result
+func (c *forgeClient) do(ctx context.Context, method, path string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header = c.header.Clone()
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("forge responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// repositoryPath returns the path of the repository within the forge, e.g. `group/repo` for `git@gitlab.com:group/repo.git`
+func repositoryPath(repositoryURL string) (string, error) {
+	path := repositoryURL
+	if u, err := url.Parse(repositoryURL); err == nil && u.Scheme != "" {
+		path = u.Path
+	} else if i := strings.Index(repositoryURL, ":"); i >= 0 {
+		// scp-like syntax of ssh, e.g. git@gitlab.com:group/repo.git
+		path = repositoryURL[i+1:]
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if !strings.Contains(path, "/") {
+		return "", fmt.Errorf("failed to get repository path of `%s`", repositoryURL)
+	}
+	return path, nil
+}
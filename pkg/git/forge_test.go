@@ -0,0 +1,153 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("pkg/git", func() {
+	Context("Forge", func() {
+		ctx := context.Background()
+		mr := &MergeRequest{
+			RepositoryURL: "git@forge.local:platform/rbac.git",
+			SourceBranch:  "m8-k8sauthz/main",
+			TargetBranch:  "main",
+			Title:         "Update RBAC of jane-doe",
+			Description:   "- grant cluster-admin on cluster-a to jane-doe",
+		}
+
+		var server *httptest.Server
+		var requests []string
+		var header http.Header
+		var body map[string]interface{}
+		var open string
+
+		BeforeEach(func() {
+			requests = nil
+			body = nil
+			open = "[]"
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.EscapedPath()+"?"+r.URL.RawQuery)
+				header = r.Header
+				if r.Method == http.MethodGet {
+					_, _ = w.Write([]byte(open))
+					return
+				}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"web_url":"https://forge.local/mr/1","html_url":"https://forge.local/pr/1"}`))
+			}))
+			os.Setenv("test.forge.token", "secret")
+		})
+
+		AfterEach(func() {
+			server.Close()
+			os.Unsetenv("test.forge.token")
+		})
+
+		It("opens merge requests on GitLab", func() {
+			forge, err := NewForge(&ForgeConfig{Type: ForgeTypeGitLab, URL: server.URL, EnvPrefix: "test"})
+			Expect(err).ToNot(HaveOccurred())
+
+			webURL, err := forge.OpenMergeRequest(ctx, mr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(webURL).To(Equal("https://forge.local/mr/1"))
+			Expect(requests).To(Equal([]string{
+				"GET /projects/platform%2Frbac/merge_requests?source_branch=m8-k8sauthz%2Fmain&state=opened&target_branch=main",
+				"POST /projects/platform%2Frbac/merge_requests?",
+			}))
+			Expect(header.Get("PRIVATE-TOKEN")).To(Equal("secret"))
+			Expect(body).To(HaveKeyWithValue("source_branch", mr.SourceBranch))
+			Expect(body).To(HaveKeyWithValue("target_branch", mr.TargetBranch))
+		})
+		It("updates open merge requests on GitLab", func() {
+			open = `[{"iid":7,"web_url":"https://forge.local/mr/7"}]`
+			forge, err := NewForge(&ForgeConfig{Type: ForgeTypeGitLab, URL: server.URL, EnvPrefix: "test"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = forge.OpenMergeRequest(ctx, mr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[1]).To(Equal("PUT /projects/platform%2Frbac/merge_requests/7?"))
+			Expect(body).To(HaveKeyWithValue("description", mr.Description))
+			Expect(body).ToNot(HaveKey("source_branch"))
+		})
+		It("opens pull requests on GitHub", func() {
+			forge, err := NewForge(&ForgeConfig{Type: ForgeTypeGitHub, URL: server.URL, EnvPrefix: "test"})
+			Expect(err).ToNot(HaveOccurred())
+
+			webURL, err := forge.OpenMergeRequest(ctx, &MergeRequest{RepositoryURL: "https://github.com/platform/rbac.git", SourceBranch: "changes", TargetBranch: "main"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(webURL).To(Equal("https://forge.local/pr/1"))
+			Expect(requests).To(Equal([]string{
+				"GET /repos/platform/rbac/pulls?base=main&head=platform%3Achanges&state=open",
+				"POST /repos/platform/rbac/pulls?",
+			}))
+			Expect(header.Get("Authorization")).To(Equal("Bearer secret"))
+			Expect(body).To(HaveKeyWithValue("head", "changes"))
+		})
+		It("updates open pull requests on GitHub", func() {
+			open = `[{"number":3,"html_url":"https://forge.local/pr/3"}]`
+			forge, err := NewForge(&ForgeConfig{Type: ForgeTypeGitHub, URL: server.URL, EnvPrefix: "test"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = forge.OpenMergeRequest(ctx, &MergeRequest{RepositoryURL: "https://github.com/platform/rbac.git", SourceBranch: "changes", TargetBranch: "main", Description: "- changes"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[1]).To(Equal("PATCH /repos/platform/rbac/pulls/3?"))
+			Expect(body).To(HaveKeyWithValue("body", "- changes"))
+		})
+		It("fails for unknown forges and missing tokens", func() {
+			_, err := NewForge(&ForgeConfig{Type: "bitbucket", EnvPrefix: "test"})
+			Expect(err).To(MatchError(ErrForgeTypeIsUnknown))
+
+			_, err = NewForge(&ForgeConfig{Type: ForgeTypeGitLab, EnvPrefix: "missing"})
+			Expect(err).To(HaveOccurred())
+		})
+		It("fails if the forge responds with an error", func() {
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"message":"merge request already exists"}`))
+			})
+			forge, err := NewForge(&ForgeConfig{Type: ForgeTypeGitLab, URL: server.URL, EnvPrefix: "test"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = forge.OpenMergeRequest(ctx, mr)
+			Expect(err).To(MatchError(ContainSubstring("status 409")))
+		})
+		It("gets the path of repositories", func() {
+			for repositoryURL, expected := range map[string]string{
+				"git@gitlab.com:group/sub/repo.git":     "group/sub/repo",
+				"https://gitlab.com/group/repo.git":     "group/repo",
+				"ssh://git@github.com/owner/repo":       "owner/repo",
+				"https://gitlab.local:8443/group/repo/": "group/repo",
+			} {
+				path, err := repositoryPath(repositoryURL)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(path).To(Equal(expected))
+			}
+			_, err := repositoryPath("repo.git")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -16,9 +16,11 @@ package grpc
 
 import (
 	"context"
+	"net"
 	"time"
 
 	"github.com/finleap-connect/monoskope/pkg/domain/errors"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/oauth2"
@@ -48,6 +50,17 @@ func NewGrpcConnectionFactoryWithInsecure(url string) *GrpcConnectionFactory {
 		WithBlock()
 }
 
+// NewInternalGrpcConnectionFactory creates a new factory for gRPC connections between Monoskope services. It connects with mutual TLS if a TLSConfigLoader is given and without transport security otherwise.
+func NewInternalGrpcConnectionFactory(url string, tlsLoader *m8tls.TLSConfigLoader) *GrpcConnectionFactory {
+	if tlsLoader == nil {
+		return NewGrpcConnectionFactoryWithInsecure(url)
+	}
+	return NewGrpcConnectionFactory(url).
+		WithMutualTLS(tlsLoader).
+		WithOpenTelemetry().
+		WithBlock()
+}
+
 // WithInsecure adds a DialOption which disables transport security for this connection. Note that transport security is required unless WithInsecure is set.
 func (factory *GrpcConnectionFactory) WithInsecure() *GrpcConnectionFactory {
 	factory.opts = append(factory.opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -60,6 +73,12 @@ func (factory *GrpcConnectionFactory) WithOSCaTransportCredentials() *GrpcConnec
 	return factory
 }
 
+// WithMutualTLS adds a DialOption which configures mutual TLS using the auto reloading certificates of the given TLSConfigLoader.
+func (factory *GrpcConnectionFactory) WithMutualTLS(tlsLoader *m8tls.TLSConfigLoader) *GrpcConnectionFactory {
+	factory.opts = append(factory.opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsLoader.GetMutualTLSClientConfig())))
+	return factory
+}
+
 // WithPerRPCCredentials adds a DialOption which sets credentials and places auth state on each outbound RPC.
 func (factory *GrpcConnectionFactory) WithPerRPCCredentials(creds credentials.PerRPCCredentials) *GrpcConnectionFactory {
 	factory.opts = append(factory.opts, grpc.WithPerRPCCredentials(creds))
@@ -93,6 +112,12 @@ func (factory *GrpcConnectionFactory) WithRetry() *GrpcConnectionFactory {
 	return factory
 }
 
+// WithContextDialer adds a DialOption which connects using the given dialer instead of TCP, e.g. to connect to a server within the same process.
+func (factory *GrpcConnectionFactory) WithContextDialer(dialer func(context.Context, string) (net.Conn, error)) *GrpcConnectionFactory {
+	factory.opts = append(factory.opts, grpc.WithContextDialer(dialer))
+	return factory
+}
+
 // Connect creates a client connection based on the factory.
 func (factory *GrpcConnectionFactory) WithTransportCredentials(creds credentials.TransportCredentials) *GrpcConnectionFactory {
 	factory.opts = append(factory.opts, grpc.WithTransportCredentials(creds))
@@ -111,12 +136,11 @@ func (factory *GrpcConnectionFactory) ConnectWithTimeout(ctx context.Context, ti
 	return factory.Connect(ctx)
 }
 
-// NewClientWithAuthForward creates a new gRPC client which forwards the authentication bearer token received from the client (TLS optional)
-func NewClientWithAuthForward[T any](ctx context.Context, addr string, requireTransportSecurity bool, clientFactory func(cc grpc.ClientConnInterface) T) (*grpc.ClientConn, T, error) {
-	conn, err := NewGrpcConnectionFactory(addr).
-		WithInsecure().
-		WithPerRPCCredentials(NewForwardedOauthAccess(requireTransportSecurity)).
-		WithBlock().
+// NewClientWithAuthForward creates a new gRPC client to another Monoskope service which forwards the authentication bearer token received from the client.
+// It connects with mutual TLS if a TLSConfigLoader is given and without transport security otherwise.
+func NewClientWithAuthForward[T any](ctx context.Context, addr string, tlsLoader *m8tls.TLSConfigLoader, clientFactory func(cc grpc.ClientConnInterface) T) (*grpc.ClientConn, T, error) {
+	conn, err := NewInternalGrpcConnectionFactory(addr, tlsLoader).
+		WithPerRPCCredentials(NewForwardedOauthAccess(tlsLoader != nil)).
 		ConnectWithTimeout(ctx, 10*time.Second)
 
 	if err != nil {
@@ -143,6 +167,24 @@ func NewClientWithInsecureAuth[T any](ctx context.Context, addr, authToken strin
 	return conn, clientFactory(conn), nil
 }
 
+// NewInternalClientWithAuth creates a new gRPC client to another Monoskope service which sends the auth token.
+// It connects with mutual TLS if a TLSConfigLoader is given and without transport security otherwise (USE ONLY IF SECURED BY SERVICE MESH OR SIMILAR).
+func NewInternalClientWithAuth[T any](ctx context.Context, addr, authToken string, tlsLoader *m8tls.TLSConfigLoader, clientFactory func(cc grpc.ClientConnInterface) T) (*grpc.ClientConn, T, error) {
+	if tlsLoader == nil {
+		return NewClientWithInsecureAuth(ctx, addr, authToken, clientFactory)
+	}
+
+	conn, err := NewInternalGrpcConnectionFactory(addr, tlsLoader).
+		WithPerRPCCredentials(NewOauthAccessFromTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: authToken}), true)).
+		ConnectWithTimeout(ctx, 10*time.Second)
+	if err != nil {
+		var result T
+		return nil, result, err
+	}
+
+	return conn, clientFactory(conn), nil
+}
+
 // NewClientWithInsecure creates a new gRPC client which connects without TLS
 func NewClientWithInsecure[T any](ctx context.Context, addr string, clientFactory func(cc grpc.ClientConnInterface) T) (*grpc.ClientConn, T, error) {
 	conn, err := NewGrpcConnectionFactoryWithInsecure(addr).
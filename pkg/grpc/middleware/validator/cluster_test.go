@@ -15,6 +15,8 @@
 package validator
 
 import (
+	"strings"
+
 	"github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -59,6 +61,24 @@ var _ = Describe("Test validation rules for cluster messages", func() {
 			cd.ApiServerAddress = invalidApiServerAddress
 			ValidateErrorExpected()
 		})
+
+		It("should check for valid Labels", func() {
+			By("not having an invalid key", func() {
+				cd.Labels.Values["in valid"] = "dev"
+				ValidateErrorExpected()
+			})
+
+			By("not having a too long value", func() {
+				cd = NewValidCreateCluster()
+				cd.Labels.Values["env"] = strings.Repeat("a", 64)
+				ValidateErrorExpected()
+			})
+		})
+
+		It("should check for valid Annotations", func() {
+			cd.Annotations.Values["/invalid"] = "value"
+			ValidateErrorExpected()
+		})
 	})
 
 	Context("Updating cluster", func() {
@@ -45,4 +45,10 @@ var _ = Describe("Test validation rules for cluster mapping messages", func() {
 		cd.ClusterId = invalidUUID
 		ValidateErrorExpected()
 	})
+
+	It("should allow a ClusterSelector instead of a ClusterId", func() {
+		cd.ClusterId = ""
+		cd.ClusterSelector = "env=dev"
+		Expect(cd.Validate()).To(Succeed())
+	})
 })
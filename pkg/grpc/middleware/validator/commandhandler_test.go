@@ -17,6 +17,7 @@ package validator
 import (
 	"github.com/finleap-connect/monoskope/pkg/api/domain"
 	"github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
+	"github.com/finleap-connect/monoskope/pkg/api/eventsourcing/commands"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -70,4 +71,40 @@ var _ = Describe("Test validation rules for commandhanlder messages", func() {
 			ValidateErrorExpected()
 		})
 	})
+
+	Context("Command Batch", func() {
+		var cb *eventsourcing.CommandBatch
+		JustBeforeEach(func() {
+			cb = &eventsourcing.CommandBatch{
+				Commands: []*commands.Command{NewValidCommand()},
+			}
+		})
+
+		ValidateErrorExpected := func() {
+			err := cb.Validate()
+			Expect(err).To(HaveOccurred())
+		}
+
+		It("should ensure rules are valid", func() {
+			err := cb.Validate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should check for at least one command", func() {
+			cb.Commands = nil
+			ValidateErrorExpected()
+		})
+
+		It("should check for at most 100 commands", func() {
+			for len(cb.Commands) <= 100 {
+				cb.Commands = append(cb.Commands, NewValidCommand())
+			}
+			ValidateErrorExpected()
+		})
+
+		It("should check for valid commands", func() {
+			cb.Commands[0].Id = invalidUUID
+			ValidateErrorExpected()
+		})
+	})
 })
@@ -0,0 +1,71 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test validation rules for role messages", func() {
+	Context("Creating Role", func() {
+		var cd *commanddata.CreateRoleCommandData
+		JustBeforeEach(func() {
+			cd = NewValidCreateRoleCommandData()
+		})
+
+		ValidateErrorExpected := func() {
+			err := cd.Validate()
+			Expect(err).To(HaveOccurred())
+		}
+
+		It("should ensure rules are valid", func() {
+			err := cd.Validate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should check for a valid Name", func() {
+			By("not containing white spaces", func() {
+				cd.Name = invalidDisplayNameWhiteSpaces
+				ValidateErrorExpected()
+			})
+
+			By("not starting with a number", func() {
+				cd.Name = "1auditor"
+				ValidateErrorExpected()
+			})
+		})
+
+		It("should check for valid Scopes", func() {
+			By("not being empty", func() {
+				cd.Scopes = &commanddata.RoleScopes{}
+				ValidateErrorExpected()
+			})
+
+			By("not containing duplicates", func() {
+				cd.Scopes = &commanddata.RoleScopes{Values: []string{"tenant", "tenant"}}
+				ValidateErrorExpected()
+			})
+		})
+
+		It("should check for a valid K8sClusterRole", func() {
+			By("not containing upper case letters", func() {
+				cd.K8SClusterRole = "View"
+				ValidateErrorExpected()
+			})
+		})
+	})
+})
@@ -107,6 +107,9 @@ func NewValidCreateCluster() *commanddata.CreateCluster {
 		Name:             validName,
 		ApiServerAddress: validApiServerAddress,
 		CaCertBundle:     []byte(validCertificate),
+		Description:      validDisplayName,
+		Labels:           &commanddata.ClusterLabels{Values: map[string]string{"env": "dev", "monoskope.io/region": "eu"}},
+		Annotations:      &commanddata.ClusterAnnotations{Values: map[string]string{"monoskope.io/owner": "Team A"}},
 	}
 }
 
@@ -160,8 +163,11 @@ func NewValidTenantClusterBindingCreated() *eventdata.TenantClusterBindingCreate
 
 func NewValidCreateTenantCommandData() *commanddata.CreateTenantCommandData {
 	return &commanddata.CreateTenantCommandData{
-		Name:   validDisplayName,
-		Prefix: validTenantPrefix,
+		Name:       validDisplayName,
+		Prefix:     validTenantPrefix,
+		ParentId:   validUUID,
+		CostCentre: validString,
+		Contact:    validEmail,
 	}
 }
 
@@ -184,6 +190,14 @@ func NewValidTenantUpdated() *eventdata.TenantUpdated {
 	}
 }
 
+func NewValidCreateRoleCommandData() *commanddata.CreateRoleCommandData {
+	return &commanddata.CreateRoleCommandData{
+		Name:           "auditor",
+		Scopes:         &commanddata.RoleScopes{Values: []string{"tenant"}},
+		K8SClusterRole: "view",
+	}
+}
+
 func NewValidCreateUserCommandData() *commanddata.CreateUserCommandData {
 	return &commanddata.CreateUserCommandData{
 		Name:  validDisplayName,
@@ -15,6 +15,8 @@
 package validator
 
 import (
+	"strings"
+
 	"github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -60,6 +62,20 @@ var _ = Describe("Test validation rules for tenant messages", func() {
 				ValidateErrorExpected()
 			})
 		})
+
+		It("should check for a valid ParentId", func() {
+			By("being a uuid", func() {
+				cd.ParentId = invalidUUID
+				ValidateErrorExpected()
+			})
+		})
+
+		It("should check for a valid Contact", func() {
+			By("not being too long", func() {
+				cd.Contact = strings.Repeat("x", 201)
+				ValidateErrorExpected()
+			})
+		})
 	})
 
 	Context("Updating Tenant", func() {
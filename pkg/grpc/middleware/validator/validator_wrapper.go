@@ -16,6 +16,8 @@ package validator
 
 import (
 	"context"
+
+	"github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
 	"github.com/finleap-connect/monoskope/pkg/api/eventsourcing/commands"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -85,15 +87,27 @@ func validate(req interface{}) error {
 	}
 
 	// unpack command data for validation
-	if c, ok := req.(*commands.Command); ok {
-		if d, err := c.Data.UnmarshalNew(); err == nil {
-			if v, ok := d.ProtoReflect().Interface().(validator); ok {
-				if err := v.Validate(); err != nil {
-					return err
-				}
+	switch c := req.(type) {
+	case *commands.Command:
+		return validateCommandData(c)
+	case *eventsourcing.CommandBatch:
+		for _, command := range c.GetCommands() {
+			if err := validateCommandData(command); err != nil {
+				return err
 			}
 		}
 	}
 
 	return nil
 }
+
+func validateCommandData(c *commands.Command) error {
+	if d, err := c.Data.UnmarshalNew(); err == nil {
+		if v, ok := d.ProtoReflect().Interface().(validator); ok {
+			if err := v.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
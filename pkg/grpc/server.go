@@ -26,6 +26,7 @@ import (
 
 	"github.com/finleap-connect/monoskope/pkg/logger"
 	"github.com/finleap-connect/monoskope/pkg/metrics"
+	m8tls "github.com/finleap-connect/monoskope/pkg/tls"
 	"github.com/finleap-connect/monoskope/pkg/util"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
@@ -33,6 +34,7 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -97,6 +99,7 @@ func NewServerWithOpts(name string, keepAlive bool, unaryServerInterceptors []gr
 			Time:              2 * time.Second,
 		}))
 	}
+	opts = append(opts, opt...)
 	s.grpc = grpc.NewServer(opts...)
 
 	// Add grpc health check service
@@ -112,6 +115,12 @@ func NewServerWithOpts(name string, keepAlive bool, unaryServerInterceptors []gr
 	return s
 }
 
+// MutualTLSServerOption returns a ServerOption which requires clients to authenticate with a certificate of the CA of the given TLSConfigLoader.
+// If allowedPeers are given, only clients with one of them as common name or DNS name are accepted.
+func MutualTLSServerOption(tlsLoader *m8tls.TLSConfigLoader, allowedPeers ...string) grpc.ServerOption {
+	return grpc.Creds(credentials.NewTLS(tlsLoader.GetMutualTLSServerConfig(allowedPeers...)))
+}
+
 // RegisterService registers your gRPC service implementation with the server
 func (s *Server) RegisterService(f func(grpc.ServiceRegistrar)) {
 	f(s.grpc)
@@ -0,0 +1,48 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/credentials"
+)
+
+// tokenSourceOauthAccess supplies PerRPCCredentials from a given token source.
+type tokenSourceOauthAccess struct {
+	tokenSource              oauth2.TokenSource
+	requireTransportSecurity bool
+}
+
+// NewOauthAccessFromTokenSource constructs the PerRPCCredentials which requests a token from the given token source for every call (TLS optional).
+// Wrap the token source with oauth2.ReuseTokenSource to only request a new token once the previous one expired.
+func NewOauthAccessFromTokenSource(tokenSource oauth2.TokenSource, requireTransportSecurity bool) credentials.PerRPCCredentials {
+	return tokenSourceOauthAccess{tokenSource, requireTransportSecurity}
+}
+
+func (oa tokenSourceOauthAccess) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := oa.tokenSource.Token()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		"authorization": token.Type() + " " + token.AccessToken,
+	}, nil
+}
+
+func (oa tokenSourceOauthAccess) RequireTransportSecurity() bool {
+	return oa.requireTransportSecurity
+}
@@ -125,6 +125,33 @@ func (consumer Consumer) StopConsuming(consumerName string, noWait bool) {
 	_ = consumer.chManager.channel.Cancel(consumerName, noWait)
 }
 
+// DeclareQueue declares the queue if it doesn't exist and binds it to the routing key(s)
+// without consuming from it.
+func (consumer Consumer) DeclareQueue(
+	queue string,
+	routingKeys []string,
+	optionFuncs ...func(*ConsumeOptions),
+) error {
+	options := &ConsumeOptions{}
+	for _, optionFunc := range optionFuncs {
+		optionFunc(options)
+	}
+
+	consumer.chManager.channelMux.RLock()
+	defer consumer.chManager.channelMux.RUnlock()
+
+	return consumer.declareQueue(queue, routingKeys, *options)
+}
+
+// Get fetches a single message from the given queue without acknowledging it.
+// The returned bool is false if the queue is empty.
+func (consumer Consumer) Get(queue string) (amqp.Delivery, bool, error) {
+	consumer.chManager.channelMux.RLock()
+	defer consumer.chManager.channelMux.RUnlock()
+
+	return consumer.chManager.channel.Get(queue, false)
+}
+
 // startGoroutinesWithRetries attempts to start consuming on a channel
 // with an exponential backoff
 func (consumer Consumer) startGoroutinesWithRetries(
@@ -160,49 +187,11 @@ func (consumer Consumer) startGoroutines(
 	consumer.chManager.channelMux.RLock()
 	defer consumer.chManager.channelMux.RUnlock()
 
-	_, err := consumer.chManager.channel.QueueDeclare(
-		queue,
-		consumeOptions.QueueDurable,
-		consumeOptions.QueueAutoDelete,
-		consumeOptions.QueueExclusive,
-		consumeOptions.QueueNoWait,
-		consumeOptions.QueueArgs,
-	)
+	err := consumer.declareQueue(queue, routingKeys, consumeOptions)
 	if err != nil {
 		return err
 	}
 
-	if consumeOptions.BindingExchange != nil {
-		exchange := consumeOptions.BindingExchange
-		if exchange.Name == "" {
-			return fmt.Errorf("binding to exchange but name not specified")
-		}
-		err = consumer.chManager.channel.ExchangeDeclare(
-			exchange.Name,
-			exchange.Kind,
-			exchange.Durable,
-			exchange.AutoDelete,
-			exchange.Internal,
-			exchange.NoWait,
-			exchange.ExchangeArgs,
-		)
-		if err != nil {
-			return err
-		}
-		for _, routingKey := range routingKeys {
-			err = consumer.chManager.channel.QueueBind(
-				queue,
-				routingKey,
-				exchange.Name,
-				consumeOptions.BindingNoWait,
-				consumeOptions.BindingArgs,
-			)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
 	err = consumer.chManager.channel.Qos(
 		consumeOptions.QOSPrefetch,
 		0,
@@ -250,3 +239,55 @@ func (consumer Consumer) startGoroutines(
 	consumer.logger.Info("Processing messages on goroutines", "concurrency", consumeOptions.Concurrency)
 	return nil
 }
+
+// declareQueue declares the queue if it doesn't exist and
+// binds the queue to the routing key(s)
+func (consumer Consumer) declareQueue(
+	queue string,
+	routingKeys []string,
+	consumeOptions ConsumeOptions,
+) error {
+	_, err := consumer.chManager.channel.QueueDeclare(
+		queue,
+		consumeOptions.QueueDurable,
+		consumeOptions.QueueAutoDelete,
+		consumeOptions.QueueExclusive,
+		consumeOptions.QueueNoWait,
+		consumeOptions.QueueArgs,
+	)
+	if err != nil {
+		return err
+	}
+
+	if consumeOptions.BindingExchange != nil {
+		exchange := consumeOptions.BindingExchange
+		if exchange.Name == "" {
+			return fmt.Errorf("binding to exchange but name not specified")
+		}
+		err = consumer.chManager.channel.ExchangeDeclare(
+			exchange.Name,
+			exchange.Kind,
+			exchange.Durable,
+			exchange.AutoDelete,
+			exchange.Internal,
+			exchange.NoWait,
+			exchange.ExchangeArgs,
+		)
+		if err != nil {
+			return err
+		}
+		for _, routingKey := range routingKeys {
+			err = consumer.chManager.channel.QueueBind(
+				queue,
+				routingKey,
+				exchange.Name,
+				consumeOptions.BindingNoWait,
+				consumeOptions.BindingArgs,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
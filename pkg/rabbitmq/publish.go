@@ -53,6 +53,7 @@ type PublishOptions struct {
 	// See https://www.rabbitmq.com/ttl.html#per-message-ttl-in-publishers
 	Expiration string
 	Headers    amqp.Table
+	MessageID  string
 }
 
 // WithPublishOptionsExchange returns a function that sets the exchange to publish to
@@ -104,6 +105,13 @@ func WithPublishOptionsHeaders(headers amqp.Table) func(*PublishOptions) {
 	}
 }
 
+// WithPublishOptionsMessageID returns a function that sets the application provided id of the message
+func WithPublishOptionsMessageID(messageID string) func(*PublishOptions) {
+	return func(options *PublishOptions) {
+		options.MessageID = messageID
+	}
+}
+
 // Publisher allows you to publish messages safely across an open connection
 type Publisher struct {
 	chManager *channelManager
@@ -177,6 +185,7 @@ func (publisher *Publisher) Publish(
 		message.Body = data
 		message.Headers = options.Headers
 		message.Expiration = options.Expiration
+		message.MessageId = options.MessageID
 
 		// Actual publish.
 		err := publisher.chManager.channel.PublishWithContext(ctx,
@@ -0,0 +1,138 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MutualTLSCACertificateFile is the name of the CA certificate within the certificates directory
+	MutualTLSCACertificateFile = "ca.crt"
+	// MutualTLSCertificateFile is the name of the certificate within the certificates directory
+	MutualTLSCertificateFile = "tls.crt"
+	// MutualTLSCertificateKeyFile is the name of the key within the certificates directory
+	MutualTLSCertificateKeyFile = "tls.key"
+)
+
+var (
+	// ErrNoPeerCertificate is returned if the peer did not present a certificate
+	ErrNoPeerCertificate = errors.New("peer did not present a certificate")
+	// ErrPeerNotAllowed is returned if the certificate of the peer doesn't match any allowed identity
+	ErrPeerNotAllowed = errors.New("peer is not allowed")
+)
+
+// NewMutualTLSConfigLoader creates a TLSConfigLoader watching the ca.crt, tls.crt and tls.key in certsPath, the layout of secrets issued by cert-manager.
+// The certificate is used both to serve and to connect to other services.
+func NewMutualTLSConfigLoader(certsPath string) (*TLSConfigLoader, error) {
+	loader, err := NewTLSConfigLoader()
+	if err != nil {
+		return nil, err
+	}
+
+	caCertificateFile := filepath.Join(certsPath, MutualTLSCACertificateFile)
+	certificateFile := filepath.Join(certsPath, MutualTLSCertificateFile)
+	keyFile := filepath.Join(certsPath, MutualTLSCertificateKeyFile)
+
+	if err := loader.SetServerCACertificate(caCertificateFile); err != nil {
+		return nil, err
+	}
+	if err := loader.SetClientCACertificate(caCertificateFile); err != nil {
+		return nil, err
+	}
+	if err := loader.SetServerCertificate(certificateFile, keyFile); err != nil {
+		return nil, err
+	}
+	if err := loader.SetClientCertificate(certificateFile, keyFile); err != nil {
+		return nil, err
+	}
+	if err := loader.Watch(); err != nil {
+		return nil, err
+	}
+	return loader, nil
+}
+
+// GetMutualTLSServerConfig returns a tls.Config with auto reloading certs which requires clients to present a certificate signed by the client CA.
+// If allowedPeers are given, only clients with one of them as common name or DNS name in their certificate are accepted.
+func (t *TLSConfigLoader) GetMutualTLSServerConfig(allowedPeers ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: t.GetCertificate,
+		// The client certificate is verified in VerifyConnection instead,
+		// because ClientCAs would not pick up reloaded CAs.
+		ClientAuth: tls.RequireAnyClientCert,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if err := verifyCertificateChain(cs, x509.VerifyOptions{
+				Roots:     t.GetClientCAs(),
+				KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			}); err != nil {
+				return err
+			}
+			return verifyPeerIdentity(cs, allowedPeers)
+		},
+	}
+}
+
+// GetMutualTLSClientConfig returns a tls.Config with auto reloading certs which presents the client certificate and verifies the server against the latest server CAs.
+func (t *TLSConfigLoader) GetMutualTLSClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:           tls.VersionTLS12,
+		GetClientCertificate: t.GetClientCertificate,
+		// The server certificate is verified in VerifyConnection instead,
+		// because RootCAs would not pick up reloaded CAs.
+		InsecureSkipVerify: true, // #nosec G402
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			return verifyCertificateChain(cs, x509.VerifyOptions{
+				Roots:   t.GetRootCAs(),
+				DNSName: cs.ServerName,
+			})
+		},
+	}
+}
+
+// verifyCertificateChain verifies the certificate of the peer using the intermediates it sent along
+func verifyCertificateChain(cs tls.ConnectionState, opts x509.VerifyOptions) error {
+	if len(cs.PeerCertificates) == 0 {
+		return ErrNoPeerCertificate
+	}
+	opts.Intermediates = x509.NewCertPool()
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+// verifyPeerIdentity checks that the common name or one of the DNS names of the peer certificate is allowed
+func verifyPeerIdentity(cs tls.ConnectionState, allowedPeers []string) error {
+	if len(allowedPeers) == 0 {
+		return nil
+	}
+
+	cert := cs.PeerCertificates[0]
+	identities := append([]string{cert.Subject.CommonName}, cert.DNSNames...)
+	for _, identity := range identities {
+		for _, allowed := range allowedPeers {
+			if identity != "" && identity == allowed {
+				return nil
+			}
+		}
+	}
+	return errors.Wrapf(ErrPeerNotAllowed, "identity %s", cert.Subject.CommonName)
+}
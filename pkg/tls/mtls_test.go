@@ -0,0 +1,133 @@
+// Copyright 2022 Monoskope Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("pkg/tls/mtls", func() {
+	var (
+		serverCertsPath string
+		server          *httptest.Server
+		loaders         []*TLSConfigLoader
+	)
+
+	newLoader := func(certsPath string) *TLSConfigLoader {
+		loader, err := NewMutualTLSConfigLoader(certsPath)
+		Expect(err).ToNot(HaveOccurred())
+		loaders = append(loaders, loader)
+		return loader
+	}
+
+	startServer := func(allowedPeers ...string) {
+		server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, "success!")
+		}))
+		// httptest would add its own certificate to the config, so serve TLS without it
+		server.Listener = tls.NewListener(server.Listener, newLoader(serverCertsPath).GetMutualTLSServerConfig(allowedPeers...))
+		server.Start()
+	}
+
+	get := func(clientConfig *tls.Config) (string, error) {
+		client := http.Client{
+			Transport: &http.Transport{TLSClientConfig: clientConfig},
+		}
+		resp, err := client.Get(strings.Replace(server.URL, "http://", "https://", 1))
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return strings.TrimSpace(string(body)), nil
+	}
+
+	BeforeEach(func() {
+		var err error
+		serverCertsPath, err = testEnv.CreateCertificateDir("eventstore")
+		Expect(err).ToNot(HaveOccurred())
+	})
+	AfterEach(func() {
+		for _, loader := range loaders {
+			loader.Stop()
+		}
+		loaders = nil
+		server.Close()
+		Expect(os.RemoveAll(serverCertsPath)).To(Succeed())
+	})
+
+	It("accepts clients with a certificate of the CA", func() {
+		startServer()
+
+		clientCertsPath, err := testEnv.CreateCertificateDir("commandhandler")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(clientCertsPath)
+
+		body, err := get(newLoader(clientCertsPath).GetMutualTLSClientConfig())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(Equal("success!"))
+	})
+	It("accepts allowed peers only", func() {
+		startServer("commandhandler", "queryhandler")
+
+		allowedCertsPath, err := testEnv.CreateCertificateDir("queryhandler")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(allowedCertsPath)
+		_, err = get(newLoader(allowedCertsPath).GetMutualTLSClientConfig())
+		Expect(err).ToNot(HaveOccurred())
+
+		otherCertsPath, err := testEnv.CreateCertificateDir("scimserver")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(otherCertsPath)
+		_, err = get(newLoader(otherCertsPath).GetMutualTLSClientConfig())
+		Expect(err).To(HaveOccurred())
+	})
+	It("rejects clients without certificate", func() {
+		startServer()
+
+		loader, err := NewTLSConfigLoader()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(loader.SetServerCACertificate(testEnv.caCertFile)).To(Succeed())
+		Expect(loader.Watch()).To(Succeed())
+		defer loader.Stop()
+
+		_, err = get(&tls.Config{RootCAs: loader.GetRootCAs()})
+		Expect(err).To(HaveOccurred())
+	})
+	It("rejects servers with a certificate of another CA", func() {
+		startServer()
+
+		otherEnv, err := NewTestEnv(testEnv.TestEnv)
+		Expect(err).ToNot(HaveOccurred())
+		clientCertsPath, err := otherEnv.CreateCertificateDir("commandhandler")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(clientCertsPath)
+
+		_, err = get(newLoader(clientCertsPath).GetMutualTLSClientConfig())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("certificate signed by unknown authority"))
+	})
+})
@@ -26,6 +26,7 @@ import (
 	"math/big"
 	"net"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/finleap-connect/monoskope/internal/test"
@@ -192,3 +193,55 @@ func (t *TestEnv) CreateCertificate() error {
 
 	return nil
 }
+
+// CreateCertificateDir creates a certificate for the given common name signed by the CA and writes it along with the CA to a new directory in the layout of cert-manager secrets.
+func (t *TestEnv) CreateCertificateDir(commonName string) (string, error) {
+	cert := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject: pkix.Name{
+			CommonName:   commonName,
+			Organization: []string{"Monoskope"},
+		},
+		DNSNames:    []string{commonName, "localhost"},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		NotBefore:   time.Now(),
+		NotAfter:    time.Now().AddDate(1, 0, 0),
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+	}
+
+	certPrivKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", err
+	}
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, cert, t.ca, &certPrivKey.PublicKey, t.caPrivKey)
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := os.MkdirTemp("", commonName)
+	if err != nil {
+		return "", err
+	}
+
+	caPEM, err := os.ReadFile(t.caCertFile)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(dir, MutualTLSCACertificateFile), caPEM, 0600); err != nil {
+		return "", err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})
+	if err := os.WriteFile(filepath.Join(dir, MutualTLSCertificateFile), certPEM, 0600); err != nil {
+		return "", err
+	}
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(certPrivKey)})
+	if err := os.WriteFile(filepath.Join(dir, MutualTLSCertificateKeyFile), keyPEM, 0600); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
@@ -37,6 +37,7 @@ type TLSConfigLoader struct {
 	clientCertificateKeyFile string
 	clientCertificate        *tls.Certificate
 	serverCAs                *x509.CertPool
+	clientCAs                *x509.CertPool
 	watcher                  *fsnotify.Watcher
 	watching                 chan struct{}
 	log                      logger.Logger
@@ -213,6 +214,10 @@ func (t *TLSConfigLoader) load() error {
 		} else {
 			t.log.Info("No client CAs appended, using system CAs only")
 		}
+		// Client certificates are verified against the local CA only
+		clientCAs := x509.NewCertPool()
+		clientCAs.AppendCertsFromPEM(certs)
+		t.clientCAs = clientCAs
 	}
 
 	if t.clientCertificateFile != "" {
@@ -286,6 +291,13 @@ func (t *TLSConfigLoader) GetRootCAs() *x509.CertPool {
 	return t.serverCAs
 }
 
+// GetClientCAs returns the cert pool to use to verify client certificates.
+func (t *TLSConfigLoader) GetClientCAs() *x509.CertPool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.clientCAs
+}
+
 // GetCertificate returns the loaded certificate for use by
 // the TLSConfig fields GetCertificate field in a http.Server.
 func (t *TLSConfigLoader) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
@@ -15,7 +15,9 @@
 package audit
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"fmt"
 	"io"
 	"regexp"
@@ -27,7 +29,9 @@ import (
 	cmdData "github.com/finleap-connect/monoskope/pkg/api/domain/commanddata"
 	esApi "github.com/finleap-connect/monoskope/pkg/api/eventsourcing"
 	cmd "github.com/finleap-connect/monoskope/pkg/domain/commands"
+	"github.com/finleap-connect/monoskope/pkg/domain/constants/aggregates"
 	commandTypes "github.com/finleap-connect/monoskope/pkg/domain/constants/commands"
+	eventTypes "github.com/finleap-connect/monoskope/pkg/domain/constants/events"
 	fConsts "github.com/finleap-connect/monoskope/pkg/domain/constants/formatters"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/roles"
 	"github.com/finleap-connect/monoskope/pkg/domain/constants/scopes"
@@ -380,6 +384,65 @@ var _ = Describe("test/audit/audit_log_test", func() {
 			Expect(counter).To(Equal(expectedNumEventsDoneByAdmin))
 		})
 
+		When("querying structured audit events", func() {
+			events, err := auditLogServiceClient().Query(ctx, &domainApi.AuditLogQueryRequest{
+				DateRange: &domainApi.GetAuditLogByDateRangeRequest{
+					MinTimestamp: timestamppb.New(fromTime),
+					MaxTimestamp: timestamppb.New(toTime),
+				},
+				AggregateType: wrapperspb.String(aggregates.Tenant.String()),
+				ActorEmail:    wrapperspb.String(mock.TestAdminUser.Email),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			counter := 0
+			for {
+				e, err := events.Recv()
+				if err == io.EOF {
+					break
+				}
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(e.Actor.Id).To(Equal(mock.TestAdminUser.Id))
+				Expect(e.Actor.Email).To(Equal(mock.TestAdminUser.Email))
+				Expect(e.Target.AggregateType).To(Equal(aggregates.Tenant.String()))
+				Expect(e.Details).ToNot(BeEmpty())
+				if e.EventType == eventTypes.TenantUpdatedV2.String() {
+					Expect(e.Changes).To(HaveLen(1))
+					Expect(e.Changes[0].Field).To(Equal("Name"))
+					Expect(e.Changes[0].NewValue).To(Equal("Tenant Z"))
+				}
+				counter++
+			}
+			Expect(counter).To(Equal(3)) // created, updated and deleted
+		})
+
+		When("exporting audit events", func() {
+			export, err := auditLogServiceClient().Export(ctx, &domainApi.AuditLogExportRequest{
+				DateRange: &domainApi.GetAuditLogByDateRangeRequest{
+					MinTimestamp: timestamppb.New(fromTime),
+					MaxTimestamp: timestamppb.New(toTime),
+				},
+				Format:  domainApi.AuditLogExportRequest_CSV,
+				Content: domainApi.AuditLogExportRequest_EVENTS,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			var data bytes.Buffer
+			for {
+				chunk, err := export.Recv()
+				if err == io.EOF {
+					break
+				}
+				Expect(err).ToNot(HaveOccurred())
+				data.Write(chunk.GetValue())
+			}
+
+			records, err := csv.NewReader(&data).ReadAll()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(HaveLen(expectedNumEventsDoneByAdmin + 1)) // including header
+		})
+
 		When("getting users overview", func() {
 			overviews, err := auditLogServiceClient().GetUsersOverview(ctx, &domainApi.GetUsersOverviewRequest{
 				Timestamp: timestamppb.New(toTime),